@@ -0,0 +1,206 @@
+// Package app monta a aplicação: configuração → pool de conexões → DAOs → services →
+// controllers. Nenhuma camada cria as próprias dependências; cada uma recebe as da
+// camada anterior, o que permite subir várias instâncias ou trocar implementações
+// (ex: DAOs em memória nos testes).
+package app
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"os"
+
+	"upskilling-api/controller"
+	"upskilling-api/dao"
+	"upskilling-api/db"
+	"upskilling-api/service"
+)
+
+// jwtSecretDesenvolvimento só é usado quando JWT_SECRET não está definido e
+// ALLOW_INSECURE_JWT_SECRET=true (ambiente local). Como é público, qualquer pessoa pode
+// forjar tokens assinados com ele.
+const jwtSecretDesenvolvimento = "upskilling-dev-secret"
+
+// Config reúne a configuração da aplicação.
+type Config struct {
+	DB        db.Config
+	Porta     string
+	JWTSecret string
+}
+
+// ConfigFromEnv lê a configuração das variáveis de ambiente (após o carregamento do .env).
+// Retorna erro se JWT_SECRET não estiver definido, a menos que o segredo público de
+// desenvolvimento seja liberado explicitamente com ALLOW_INSECURE_JWT_SECRET=true.
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		DB:        db.ConfigFromEnv(),
+		Porta:     os.Getenv("PORT"),
+		JWTSecret: os.Getenv("JWT_SECRET"),
+	}
+	if cfg.Porta == "" {
+		cfg.Porta = "8080"
+	}
+	if cfg.JWTSecret == "" {
+		if os.Getenv("ALLOW_INSECURE_JWT_SECRET") != "true" {
+			return cfg, errors.New("JWT_SECRET não definido; defina um segredo aleatório (ex: openssl rand -hex 32) ou, apenas em desenvolvimento, ALLOW_INSECURE_JWT_SECRET=true")
+		}
+		log.Println("Aviso: JWT_SECRET não definido. Usando o segredo público de desenvolvimento (ALLOW_INSECURE_JWT_SECRET=true); não use em produção.")
+		cfg.JWTSecret = jwtSecretDesenvolvimento
+	}
+	return cfg, nil
+}
+
+// DAOs reúne as implementações de acesso a dados usadas pelos services.
+type DAOs struct {
+	Transacionador     dao.Transacionador
+	Usuario            dao.UsuarioDAO
+	Organizacao        dao.OrganizacaoDAO
+	RefreshToken       dao.RefreshTokenDAO
+	Trilha             dao.TrilhaDAO
+	Competencia        dao.CompetenciaDAO
+	TrilhaCompetencia  dao.TrilhaCompetenciaDAO
+	Requisito          dao.RequisitoDAO
+	Modulo             dao.ModuloDAO
+	Aula               dao.AulaDAO
+	Matricula          dao.MatriculaDAO
+	SessaoEstudo       dao.SessaoEstudoDAO
+	UsuarioCompetencia dao.UsuarioCompetenciaDAO
+	Cargo              dao.CargoDAO
+	GapCompetencias    dao.GapCompetenciasDAO
+	Recomendacao       dao.RecomendacaoDAO
+	PainelGestor       dao.PainelGestorDAO
+	Busca              dao.BuscaDAO
+}
+
+// NewDAOs cria os DAOs PostgreSQL sobre o pool informado.
+func NewDAOs(pool *sql.DB) DAOs {
+	conexao := dao.NewConexao(pool)
+	return DAOs{
+		Transacionador:     conexao,
+		Usuario:            dao.NewUsuarioDAO(conexao),
+		Organizacao:        dao.NewOrganizacaoDAO(conexao),
+		RefreshToken:       dao.NewRefreshTokenDAO(conexao),
+		Trilha:             dao.NewTrilhaDAO(conexao),
+		Competencia:        dao.NewCompetenciaDAO(conexao),
+		TrilhaCompetencia:  dao.NewTrilhaCompetenciaDAO(conexao),
+		Requisito:          dao.NewRequisitoDAO(conexao),
+		Modulo:             dao.NewModuloDAO(conexao),
+		Aula:               dao.NewAulaDAO(conexao),
+		Matricula:          dao.NewMatriculaDAO(conexao),
+		SessaoEstudo:       dao.NewSessaoEstudoDAO(conexao),
+		UsuarioCompetencia: dao.NewUsuarioCompetenciaDAO(conexao),
+		Cargo:              dao.NewCargoDAO(conexao),
+		GapCompetencias:    dao.NewGapCompetenciasDAO(conexao),
+		Recomendacao:       dao.NewRecomendacaoDAO(conexao),
+		PainelGestor:       dao.NewPainelGestorDAO(conexao),
+		Busca:              dao.NewBuscaDAO(conexao),
+	}
+}
+
+// Services reúne as regras de negócio expostas aos controllers.
+type Services struct {
+	Auth               service.AuthService
+	Organizacao        service.OrganizacaoService
+	Usuario            service.UsuarioService
+	Trilha             service.TrilhaService
+	Competencia        service.CompetenciaService
+	Modulo             service.ModuloService
+	Matricula          service.MatriculaService
+	UsuarioCompetencia service.UsuarioCompetenciaService
+	Cargo              service.CargoService
+	GapCompetencias    service.GapCompetenciasService
+	Recomendacao       service.RecomendacaoService
+	PainelGestor       service.PainelGestorService
+	Busca              service.BuscaService
+}
+
+// NewServices cria os services sobre os DAOs informados.
+func NewServices(cfg Config, d DAOs) Services {
+	return Services{
+		Auth:               service.NewAuthService(d.Transacionador, d.Usuario, d.RefreshToken, []byte(cfg.JWTSecret)),
+		Organizacao:        service.NewOrganizacaoService(d.Transacionador, d.Organizacao, d.Usuario),
+		Usuario:            service.NewUsuarioService(d.Transacionador, d.Usuario, d.Organizacao, d.RefreshToken),
+		Trilha:             service.NewTrilhaService(d.Transacionador, d.Trilha, d.Competencia, d.TrilhaCompetencia, d.Requisito),
+		Competencia:        service.NewCompetenciaService(d.Competencia, d.TrilhaCompetencia),
+		Modulo:             service.NewModuloService(d.Transacionador, d.Modulo, d.Aula, d.Trilha),
+		Matricula:          service.NewMatriculaService(d.Transacionador, d.Matricula, d.Usuario, d.Trilha, d.SessaoEstudo, d.Aula, d.Requisito),
+		UsuarioCompetencia: service.NewUsuarioCompetenciaService(d.Transacionador, d.UsuarioCompetencia, d.Usuario, d.Competencia),
+		Cargo:              service.NewCargoService(d.Transacionador, d.Cargo, d.Competencia),
+		GapCompetencias:    service.NewGapCompetenciasService(d.GapCompetencias, d.Cargo, d.Usuario),
+		Recomendacao:       service.NewRecomendacaoService(d.Recomendacao, d.Usuario),
+		PainelGestor:       service.NewPainelGestorService(d.PainelGestor, d.Usuario),
+		Busca:              service.NewBuscaService(d.Busca),
+	}
+}
+
+// Controllers reúne os handlers HTTP registrados nas rotas.
+type Controllers struct {
+	Auth               *controller.AuthController
+	Organizacao        *controller.OrganizacaoController
+	Usuario            *controller.UsuarioController
+	Trilha             *controller.TrilhaController
+	Competencia        *controller.CompetenciaController
+	Modulo             *controller.ModuloController
+	Matricula          *controller.MatriculaController
+	UsuarioCompetencia *controller.UsuarioCompetenciaController
+	Cargo              *controller.CargoController
+	Recomendacao       *controller.RecomendacaoController
+	PainelGestor       *controller.PainelGestorController
+	Busca              *controller.BuscaController
+}
+
+// NewControllers cria os controllers sobre os services informados.
+func NewControllers(s Services) Controllers {
+	return Controllers{
+		Auth:               controller.NewAuthController(s.Auth),
+		Organizacao:        controller.NewOrganizacaoController(s.Organizacao),
+		Usuario:            controller.NewUsuarioController(s.Usuario),
+		Trilha:             controller.NewTrilhaController(s.Trilha),
+		Competencia:        controller.NewCompetenciaController(s.Competencia),
+		Modulo:             controller.NewModuloController(s.Modulo),
+		Matricula:          controller.NewMatriculaController(s.Matricula),
+		UsuarioCompetencia: controller.NewUsuarioCompetenciaController(s.UsuarioCompetencia),
+		Cargo:              controller.NewCargoController(s.Cargo, s.GapCompetencias),
+		Recomendacao:       controller.NewRecomendacaoController(s.Recomendacao),
+		PainelGestor:       controller.NewPainelGestorController(s.PainelGestor),
+		Busca:              controller.NewBuscaController(s.Busca),
+	}
+}
+
+// App é uma instância da aplicação com todas as dependências montadas.
+type App struct {
+	Config      Config
+	DB          *sql.DB // nil quando montada sem banco (ex: DAOs em memória)
+	Services    Services
+	Controllers Controllers
+}
+
+// New abre o pool de conexões descrito em cfg e monta a aplicação sobre ele.
+func New(cfg Config) (*App, error) {
+	pool, err := db.Open(cfg.DB)
+	if err != nil {
+		return nil, err
+	}
+	a := NewComDAOs(cfg, NewDAOs(pool))
+	a.DB = pool
+	return a, nil
+}
+
+// NewComDAOs monta a aplicação sobre DAOs já construídos, sem abrir conexão com o banco.
+func NewComDAOs(cfg Config, d DAOs) *App {
+	services := NewServices(cfg, d)
+	return &App{
+		Config:      cfg,
+		Services:    services,
+		Controllers: NewControllers(services),
+	}
+}
+
+// Close libera os recursos da aplicação (o pool de conexões, se houver).
+func (a *App) Close() error {
+	if a.DB == nil {
+		return nil
+	}
+	log.Println("Conexão com o banco de dados fechada.")
+	return a.DB.Close()
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Vocabulário usado para compor nomes e atributos realistas.
+var (
+	nomes = []string{
+		"Ana", "Bruno", "Carla", "Daniel", "Eduarda", "Felipe", "Gabriela", "Henrique", "Isabela", "João",
+		"Karina", "Lucas", "Mariana", "Nicolas", "Olívia", "Pedro", "Rafaela", "Samuel", "Tatiana", "Vinícius",
+	}
+	sobrenomes = []string{
+		"Silva", "Costa", "Souza", "Pereira", "Lima", "Rocha", "Martins", "Alves", "Nunes", "Oliveira",
+		"Santos", "Ferreira", "Ribeiro", "Carvalho", "Gomes", "Barbosa", "Araújo", "Melo", "Cardoso", "Teixeira",
+	}
+	areasAtuacao = []string{"TI", "Dados", "Produto", "Marketing", "Finanças", "Recursos Humanos", "Operações", "Vendas"}
+
+	temasTrilha = []string{
+		"Python", "SQL", "Machine Learning", "Engenharia de Dados", "Cloud", "Segurança da Informação",
+		"Liderança", "Comunicação", "Gestão Ágil", "UX Research", "Finanças Corporativas", "Sustentabilidade",
+	}
+	formatosTrilha = []string{"Fundamentos de", "Prática em", "Especialização em", "Imersão em", "Laboratório de"}
+	focos          = []string{"IA", "Dados", "Soft Skills", "Green Tech", "Tecnologia", "Gestão"}
+
+	competenciasBase = []struct{ nome, categoria string }{
+		{"Machine Learning", "Tecnologia"}, {"Estatística", "Dados"}, {"Modelagem de Dados", "Dados"},
+		{"Programação", "Tecnologia"}, {"Arquitetura de Software", "Tecnologia"}, {"Computação em Nuvem", "Tecnologia"},
+		{"Pensamento Crítico", "Humana"}, {"Comunicação", "Humana"}, {"Negociação", "Humana"},
+		{"Gestão de Projetos", "Gestão"}, {"Gestão de Pessoas", "Gestão"}, {"Finanças", "Gestão"},
+	}
+)
+
+// escolherPonderado sorteia uma opção conforme os pesos (somando qualquer total).
+func escolherPonderado(r *rand.Rand, opcoes []string, pesos []int) string {
+	total := 0
+	for _, p := range pesos {
+		total += p
+	}
+	n := r.Intn(total)
+	for i, p := range pesos {
+		if n < p {
+			return opcoes[i]
+		}
+		n -= p
+	}
+	return opcoes[len(opcoes)-1]
+}
+
+// nivelTrilha: a maior parte do catálogo é introdutória.
+func nivelTrilha(r *rand.Rand) string {
+	return escolherPonderado(r, []string{"INICIANTE", "INTERMEDIARIO", "AVANCADO"}, []int{45, 35, 20})
+}
+
+// nivelCarreira segue a pirâmide típica de uma empresa.
+func nivelCarreira(r *rand.Rand) string {
+	return escolherPonderado(r, []string{"Em transição", "Junior", "Pleno", "Senior"}, []int{15, 35, 35, 15})
+}
+
+// statusMatricula: quanto mais antiga a inscrição, maior a chance de já ter sido
+// concluída ou cancelada.
+func statusMatricula(r *rand.Rand, idade time.Duration) string {
+	dias := int(idade.Hours() / 24)
+	switch {
+	case dias < 30:
+		return escolherPonderado(r, []string{"ATIVA", "CONCLUIDA", "CANCELADA"}, []int{85, 5, 10})
+	case dias < 180:
+		return escolherPonderado(r, []string{"ATIVA", "CONCLUIDA", "CANCELADA"}, []int{50, 35, 15})
+	default:
+		return escolherPonderado(r, []string{"ATIVA", "CONCLUIDA", "CANCELADA"}, []int{15, 65, 20})
+	}
+}
+
+// quantidadeMatriculas sorteia quantas trilhas o usuário cursou, em torno da média
+// (distribuição geométrica: muitos usuários com poucas matrículas, poucos com muitas).
+func quantidadeMatriculas(r *rand.Rand, media float64) int {
+	if media <= 0 {
+		return 0
+	}
+	p := 1 / (media + 1)
+	n := 0
+	for r.Float64() > p {
+		n++
+	}
+	return n
+}
+
+// dataEntre sorteia um instante uniforme entre inicio e fim.
+func dataEntre(r *rand.Rand, inicio, fim time.Time) time.Time {
+	if !fim.After(inicio) {
+		return inicio
+	}
+	return inicio.Add(time.Duration(r.Int63n(int64(fim.Sub(inicio)))))
+}
+
+// nomeTrilha compõe um nome único para a i-ésima trilha.
+func nomeTrilha(r *rand.Rand, i int) string {
+	return fmt.Sprintf("%s %s #%d", formatosTrilha[r.Intn(len(formatosTrilha))], temasTrilha[r.Intn(len(temasTrilha))], i+1)
+}
+
+// nomeCompetencia compõe um nome único para a i-ésima competência.
+func nomeCompetencia(i int) (string, string) {
+	base := competenciasBase[i%len(competenciasBase)]
+	if i < len(competenciasBase) {
+		return base.nome + " (sintética)", base.categoria
+	}
+	return fmt.Sprintf("%s %d (sintética)", base.nome, i/len(competenciasBase)+1), base.categoria
+}
+
+// amostra sorteia k índices distintos em [0, n).
+func amostra(r *rand.Rand, n, k int) []int {
+	if k > n {
+		k = n
+	}
+	if k*4 > n {
+		return r.Perm(n)[:k]
+	}
+	escolhidos := make(map[int]bool, k)
+	indices := make([]int, 0, k)
+	for len(indices) < k {
+		i := r.Intn(n)
+		if !escolhidos[i] {
+			escolhidos[i] = true
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// semAcentos converte um nome para uso em e-mails (minúsculas, sem acentos).
+var semAcentos = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "é", "e", "ê", "e", "í", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ú", "u", "ç", "c",
+)
+
+func parteEmail(nome string) string {
+	return semAcentos.Replace(strings.ToLower(nome))
+}
@@ -0,0 +1,369 @@
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"time"
+
+	"upskilling-api/dao"
+	"upskilling-api/db"
+	"upskilling-api/model"
+
+	"github.com/joho/godotenv"
+	"github.com/lib/pq"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// senhaSintetica é a senha de todos os usuários gerados.
+const senhaSintetica = "senha1234"
+
+// configuracao reúne os parâmetros da geração.
+type configuracao struct {
+	usuarios        int
+	trilhas         int
+	competencias    int
+	mediaMatriculas float64
+	semente         int64
+	organizacao     string
+	referencia      time.Time
+	limpar          bool
+}
+
+// usuarioGerado guarda o necessário para gerar as matrículas do usuário.
+type usuarioGerado struct {
+	id           int64
+	nome, email  string
+	area, nivel  string
+	papel        string
+	equipeID     int64
+	gestorID     *int64
+	dataCadastro time.Time
+}
+
+// trilhaGerada guarda o necessário para gerar as matrículas na trilha.
+type trilhaGerada struct {
+	id           int64
+	cargaHoraria int
+}
+
+func main() {
+	cfg := configuracao{}
+	var referencia string
+	flag.IntVar(&cfg.usuarios, "usuarios", 10000, "quantidade de usuários")
+	flag.IntVar(&cfg.trilhas, "trilhas", 200, "quantidade de trilhas")
+	flag.IntVar(&cfg.competencias, "competencias", 60, "quantidade de competências")
+	flag.Float64Var(&cfg.mediaMatriculas, "matriculas", 3, "média de matrículas por usuário")
+	flag.Int64Var(&cfg.semente, "semente", 42, "semente do gerador pseudoaleatório (mesma semente, mesmos dados)")
+	flag.StringVar(&cfg.organizacao, "organizacao", "sintetica", "slug da organização que recebe os dados gerados")
+	flag.StringVar(&referencia, "referencia", "", "data de referência AAAA-MM-DD para as datas geradas (padrão: hoje)")
+	flag.BoolVar(&cfg.limpar, "limpar", false, "remove os dados gerados anteriormente (organização e competências sintéticas)")
+	flag.Parse()
+
+	if cfg.usuarios <= 0 || cfg.trilhas <= 0 || cfg.competencias <= 0 || cfg.mediaMatriculas < 0 {
+		log.Fatal("usuarios, trilhas e competencias devem ser positivos e matriculas não pode ser negativa.")
+	}
+	cfg.referencia = time.Now().Truncate(24 * time.Hour)
+	if referencia != "" {
+		data, err := time.Parse("2006-01-02", referencia)
+		if err != nil {
+			log.Fatalf("Data de referência inválida: %v", err)
+		}
+		cfg.referencia = data
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("Aviso: Não foi possível carregar o arquivo .env. Usando variáveis de ambiente do sistema.")
+	}
+	conexao, err := db.Open(db.ConfigFromEnv())
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	defer conexao.Close()
+
+	inicio := time.Now()
+	if err := gerar(conexao, cfg); err != nil {
+		log.Fatalf("Erro ao gerar os dados: %v", err)
+	}
+	log.Printf("Dados gerados em %s.", time.Since(inicio).Round(time.Millisecond))
+
+	// Atualiza as estatísticas do planejador para refletir o novo volume
+	for _, tabela := range []string{"usuarios", "trilhas", "competencias", "trilha_competencia", "matriculas", "usuario_competencias"} {
+		if _, err := conexao.Exec("ANALYZE " + tabela); err != nil {
+			log.Printf("Erro ao analisar a tabela %s: %v", tabela, err)
+		}
+	}
+}
+
+// gerar grava todos os dados em uma única transação, usando COPY para as tabelas volumosas.
+func gerar(conexao *sql.DB, cfg configuracao) error {
+	r := rand.New(rand.NewSource(cfg.semente))
+	senhaHash, err := bcrypt.GenerateFromPassword([]byte(senhaSintetica), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("erro ao gerar hash da senha: %w", err)
+	}
+
+	tx, err := conexao.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// 1. Organização e equipes (uma por área de atuação)
+	if cfg.limpar {
+		if _, err := tx.Exec("DELETE FROM organizacoes WHERE slug = $1 AND NOT plataforma", cfg.organizacao); err != nil {
+			return fmt.Errorf("erro ao remover a organização: %w", err)
+		}
+		if _, err := tx.Exec("DELETE FROM competencias WHERE nome LIKE '%(sintética)'"); err != nil {
+			return fmt.Errorf("erro ao remover as competências sintéticas: %w", err)
+		}
+	}
+	var organizacaoID int64
+	err = tx.QueryRow(`
+		INSERT INTO organizacoes (nome, slug) VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET nome = organizacoes.nome
+		RETURNING id
+	`, "Organização Sintética ("+cfg.organizacao+")", cfg.organizacao).Scan(&organizacaoID)
+	if err != nil {
+		return fmt.Errorf("erro ao criar a organização: %w", err)
+	}
+	var existentes int
+	if err := tx.QueryRow("SELECT COUNT(*) FROM usuarios WHERE organizacao_id = $1", organizacaoID).Scan(&existentes); err != nil {
+		return err
+	}
+	if existentes > 0 {
+		return fmt.Errorf("a organização %q já possui %d usuários; use -limpar para gerar novamente", cfg.organizacao, existentes)
+	}
+
+	equipes := make(map[string]int64, len(areasAtuacao))
+	for _, area := range areasAtuacao {
+		var id int64
+		err := tx.QueryRow(`
+			INSERT INTO equipes (organizacao_id, nome) VALUES ($1, $2)
+			ON CONFLICT (organizacao_id, nome) DO UPDATE SET nome = EXCLUDED.nome
+			RETURNING id
+		`, organizacaoID, area).Scan(&id)
+		if err != nil {
+			return fmt.Errorf("erro ao criar a equipe %s: %w", area, err)
+		}
+		equipes[area] = id
+	}
+
+	// 2. Competências
+	primeiraCompetencia, err := reservarIDs(tx, "competencias", cfg.competencias)
+	if err != nil {
+		return err
+	}
+	err = copiar(tx, "competencias", []string{"id", "nome", "categoria", "descricao"}, func(emitir func(...any) error) error {
+		for i := 0; i < cfg.competencias; i++ {
+			nome, categoria := nomeCompetencia(i)
+			if err := emitir(primeiraCompetencia+int64(i), nome, categoria, "Competência gerada para testes de carga."); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 3. Trilhas privadas da organização e as competências que cada uma desenvolve (1 a 4)
+	primeiraTrilha, err := reservarIDs(tx, "trilhas", cfg.trilhas)
+	if err != nil {
+		return err
+	}
+	trilhas := make([]trilhaGerada, cfg.trilhas)
+	err = copiar(tx, "trilhas", []string{"id", "nome", "descricao", "nivel", "carga_horaria", "foco_principal", "organizacao_id"}, func(emitir func(...any) error) error {
+		for i := range trilhas {
+			trilhas[i] = trilhaGerada{id: primeiraTrilha + int64(i), cargaHoraria: 4 * (2 + r.Intn(29))}
+			err := emitir(trilhas[i].id, nomeTrilha(r, i), "Trilha gerada para testes de carga.", nivelTrilha(r),
+				trilhas[i].cargaHoraria, focos[r.Intn(len(focos))], organizacaoID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = copiar(tx, "trilha_competencia", []string{"trilha_id", "competencia_id"}, func(emitir func(...any) error) error {
+		for _, t := range trilhas {
+			for _, c := range amostra(r, cfg.competencias, 1+r.Intn(4)) {
+				if err := emitir(t.id, primeiraCompetencia+int64(c)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 4. Usuários: ~7% gestores, ~3% admins; cada aluno tem um gestor da mesma área, se houver
+	primeiroUsuario, err := reservarIDs(tx, "usuarios", cfg.usuarios)
+	if err != nil {
+		return err
+	}
+	inicioCadastros := cfg.referencia.AddDate(-2, 0, 0)
+	usuarios := make([]usuarioGerado, cfg.usuarios)
+	gestoresPorArea := make(map[string][]int64)
+	for i := range usuarios {
+		nome, sobrenome := nomes[r.Intn(len(nomes))], sobrenomes[r.Intn(len(sobrenomes))]
+		u := usuarioGerado{
+			id:           primeiroUsuario + int64(i),
+			nome:         nome + " " + sobrenome,
+			email:        fmt.Sprintf("%s.%s.%d@%s.exemplo.com", parteEmail(nome), parteEmail(sobrenome), i+1, cfg.organizacao),
+			area:         areasAtuacao[r.Intn(len(areasAtuacao))],
+			nivel:        nivelCarreira(r),
+			papel:        escolherPonderado(r, []string{model.PapelLearner, model.PapelManager, model.PapelAdmin}, []int{90, 7, 3}),
+			dataCadastro: dataEntre(r, inicioCadastros, cfg.referencia),
+		}
+		u.equipeID = equipes[u.area]
+		if u.papel == model.PapelManager {
+			gestoresPorArea[u.area] = append(gestoresPorArea[u.area], u.id)
+		}
+		usuarios[i] = u
+	}
+	for i := range usuarios {
+		gestores := gestoresPorArea[usuarios[i].area]
+		if usuarios[i].papel == model.PapelLearner && len(gestores) > 0 {
+			gestorID := gestores[r.Intn(len(gestores))]
+			usuarios[i].gestorID = &gestorID
+		}
+	}
+	colunasUsuario := []string{"id", "nome", "email", "area_atuacao", "nivel_carreira", "data_cadastro",
+		"senha_hash", "papel", "organizacao_id", "equipe_id", "gestor_id"}
+	err = copiar(tx, "usuarios", colunasUsuario, func(emitir func(...any) error) error {
+		for _, u := range usuarios {
+			err := emitir(u.id, u.nome, u.email, u.area, u.nivel, u.dataCadastro, string(senhaHash),
+				u.papel, organizacaoID, u.equipeID, u.gestorID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 5. Matrículas em trilhas distintas, com status, progresso e datas coerentes com a idade
+	colunasMatricula := []string{"usuario_id", "trilha_id", "data_inscricao", "status", "data_conclusao",
+		"data_cancelamento", "horas_estudadas", "data_ultima_atividade", "data_prazo"}
+	err = copiar(tx, "matriculas", colunasMatricula, func(emitir func(...any) error) error {
+		for _, u := range usuarios {
+			for _, t := range amostra(r, len(trilhas), quantidadeMatriculas(r, cfg.mediaMatriculas)) {
+				if err := emitir(gerarMatricula(r, cfg.referencia, u, trilhas[t])...); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 6. Perfil de competências: as trilhas concluídas concedem suas competências
+	_, err = tx.Exec(`
+		INSERT INTO usuario_competencias (usuario_id, competencia_id, origem, nivel, data_atualizacao)
+		SELECT m.usuario_id, tc.competencia_id, $2,
+		    MAX(`+dao.NivelConcedidoPorTrilha+`),
+		    MAX(m.data_conclusao)
+		FROM matriculas m
+		JOIN usuarios u ON u.id = m.usuario_id
+		JOIN trilhas t ON t.id = m.trilha_id
+		JOIN trilha_competencia tc ON tc.trilha_id = m.trilha_id
+		WHERE u.organizacao_id = $1 AND m.status = $3
+		GROUP BY m.usuario_id, tc.competencia_id
+	`, organizacaoID, model.OrigemTrilha, model.StatusMatriculaConcluida)
+	if err != nil {
+		return fmt.Errorf("erro ao conceder as competências das trilhas concluídas: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// gerarMatricula retorna os valores de uma matrícula do usuário na trilha, na ordem de
+// colunasMatricula.
+func gerarMatricula(r *rand.Rand, referencia time.Time, u usuarioGerado, t trilhaGerada) []any {
+	inscricao := dataEntre(r, u.dataCadastro, referencia)
+	status := statusMatricula(r, referencia.Sub(inscricao))
+	carga := float64(t.cargaHoraria)
+
+	var conclusao, cancelamento, prazo *time.Time
+	var horas float64
+	ultimaAtividade := dataEntre(r, inscricao, referencia)
+	switch status {
+	case model.StatusMatriculaConcluida:
+		fim := dataEntre(r, inscricao, minData(inscricao.AddDate(0, 0, 7+r.Intn(114)), referencia))
+		conclusao, ultimaAtividade = &fim, fim
+		horas = carga * (0.8 + 0.4*r.Float64())
+	case model.StatusMatriculaCancelada:
+		fim := dataEntre(r, inscricao, referencia)
+		cancelamento, ultimaAtividade = &fim, fim
+		horas = carga * 0.3 * r.Float64()
+	default:
+		horas = carga * 0.9 * r.Float64()
+		// Parte das matrículas ativas tem prazo; as mais antigas já estão atrasadas
+		if r.Intn(100) < 40 {
+			p := inscricao.AddDate(0, 0, 60+r.Intn(61))
+			prazo = &p
+		}
+	}
+
+	return []any{u.id, t.id, inscricao, status, conclusao, cancelamento,
+		math.Round(horas*100) / 100, ultimaAtividade, prazo}
+}
+
+// reservarIDs reserva na sequência da tabela um bloco de n IDs consecutivos e retorna o
+// primeiro, para que as linhas copiadas possam ser referenciadas por chaves estrangeiras.
+func reservarIDs(tx *sql.Tx, tabela string, n int) (int64, error) {
+	var primeiro int64
+	err := tx.QueryRow("SELECT nextval(pg_get_serial_sequence($1, 'id'))", tabela).Scan(&primeiro)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao reservar IDs de %s: %w", tabela, err)
+	}
+	if _, err := tx.Exec("SELECT setval(pg_get_serial_sequence($1, 'id'), $2)", tabela, primeiro+int64(n)-1); err != nil {
+		return 0, fmt.Errorf("erro ao reservar IDs de %s: %w", tabela, err)
+	}
+	return primeiro, nil
+}
+
+// copiar grava as linhas emitidas por gerarLinhas na tabela via COPY.
+func copiar(tx *sql.Tx, tabela string, colunas []string, gerarLinhas func(emitir func(...any) error) error) error {
+	inicio := time.Now()
+	stmt, err := tx.Prepare(pq.CopyIn(tabela, colunas...))
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar COPY em %s: %w", tabela, err)
+	}
+	defer stmt.Close()
+
+	linhas := 0
+	err = gerarLinhas(func(valores ...any) error {
+		linhas++
+		_, err := stmt.Exec(valores...)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao copiar linhas para %s: %w", tabela, err)
+	}
+	if _, err := stmt.Exec(); err != nil {
+		return fmt.Errorf("erro ao finalizar COPY em %s: %w", tabela, err)
+	}
+
+	log.Printf("%s: %d linhas em %s", tabela, linhas, time.Since(inicio).Round(time.Millisecond))
+	return nil
+}
+
+func minData(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"upskilling-api/db"
+
+	"github.com/joho/godotenv"
+)
+
+const uso = `Uso: migrate <comando>
+
+Comandos:
+  up         aplica todas as migrações pendentes
+  down N     reverte as N últimas migrações aplicadas
+  status     lista as migrações e se já foram aplicadas
+  force V    registra as migrações até a versão V como aplicadas, sem executá-las
+             (V = 0 remove todos os registros)
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, uso)
+		os.Exit(2)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("Aviso: Não foi possível carregar o arquivo .env. Usando variáveis de ambiente do sistema.")
+	}
+	conexao, err := db.Open(db.ConfigFromEnv())
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	defer conexao.Close()
+
+	migrador, err := db.NewMigrador(conexao)
+	if err != nil {
+		log.Fatalf("Erro ao carregar as migrações: %v", err)
+	}
+
+	switch os.Args[1] {
+	case "up":
+		n, err := migrador.Up()
+		if err != nil {
+			log.Fatalf("Erro ao aplicar migrações: %v", err)
+		}
+		log.Printf("%d migração(ões) aplicada(s).", n)
+	case "down":
+		n, err := strconv.Atoi(argumento())
+		if err != nil || n <= 0 {
+			log.Fatalf("Informe a quantidade de migrações a reverter (inteiro positivo).")
+		}
+		revertidas, err := migrador.Down(n)
+		if err != nil {
+			log.Fatalf("Erro ao reverter migrações: %v", err)
+		}
+		log.Printf("%d migração(ões) revertida(s).", revertidas)
+	case "status":
+		status, err := migrador.Status()
+		if err != nil {
+			log.Fatalf("Erro ao consultar migrações: %v", err)
+		}
+		imprimirStatus(status)
+	case "force":
+		versao, err := strconv.ParseInt(argumento(), 10, 64)
+		if err != nil || versao < 0 {
+			log.Fatalf("Informe a versão (inteiro não negativo).")
+		}
+		if err := migrador.Force(versao); err != nil {
+			log.Fatalf("Erro ao forçar a versão: %v", err)
+		}
+		log.Printf("Migrações registradas até a versão %d.", versao)
+	default:
+		fmt.Fprint(os.Stderr, uso)
+		os.Exit(2)
+	}
+}
+
+// argumento retorna o argumento do comando (down N, force V).
+func argumento() string {
+	if len(os.Args) < 3 {
+		return ""
+	}
+	return os.Args[2]
+}
+
+// imprimirStatus escreve a tabela de migrações na saída padrão.
+func imprimirStatus(status []db.StatusMigracao) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSÃO\tNOME\tESTADO\tAPLICADA EM")
+	for _, s := range status {
+		estado, aplicadaEm := "pendente", "-"
+		if s.Aplicada {
+			estado = "aplicada"
+			aplicadaEm = s.DataAplicacao.Format("2006-01-02 15:04:05")
+		}
+		switch {
+		case s.Desconhecida:
+			estado += " (ausente no binário)"
+		case s.ChecksumDivergente:
+			estado += " (checksum divergente)"
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", s.Versao, s.Nome, estado, aplicadaEm)
+	}
+	w.Flush()
+}
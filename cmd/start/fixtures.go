@@ -0,0 +1,495 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// arquivosFixtures contém um arquivo JSON por perfil de dados iniciais (dev, demo, test).
+//
+//go:embed fixtures/*.json
+var arquivosFixtures embed.FS
+
+// fixture descreve os dados iniciais de um perfil. As entidades se referenciam pela
+// chave natural: organização pelo slug, usuário pelo e-mail e trilhas, competências e
+// cargos pelo nome. Um perfil pode incluir outros, aplicados antes dele.
+type fixture struct {
+	Inclui       []string             `json:"inclui"`
+	Organizacoes []organizacaoFixture `json:"organizacoes"`
+	Competencias []competenciaFixture `json:"competencias"`
+	Trilhas      []trilhaFixture      `json:"trilhas"`
+	Usuarios     []usuarioFixture     `json:"usuarios"`
+	Matriculas   []matriculaFixture   `json:"matriculas"`
+	Cargos       []cargoFixture       `json:"cargos"`
+}
+
+type organizacaoFixture struct {
+	Slug    string   `json:"slug"`
+	Nome    string   `json:"nome"`
+	Equipes []string `json:"equipes"`
+}
+
+type competenciaFixture struct {
+	Nome      string `json:"nome"`
+	Categoria string `json:"categoria"`
+	Descricao string `json:"descricao"`
+}
+
+// trilhaFixture é uma trilha do catálogo público ou, com organizacao, privada do tenant.
+type trilhaFixture struct {
+	Nome                string   `json:"nome"`
+	Descricao           string   `json:"descricao"`
+	Nivel               string   `json:"nivel"`
+	CargaHoraria        int      `json:"carga_horaria"`
+	FocoPrincipal       string   `json:"foco_principal"`
+	Organizacao         string   `json:"organizacao"`
+	NivelCarreiraMinimo string   `json:"nivel_carreira_minimo"`
+	Competencias        []string `json:"competencias"`
+}
+
+// usuarioFixture é um usuário da organização informada (padrão: a plataforma). As
+// competências entram no perfil como autoavaliação.
+type usuarioFixture struct {
+	Nome          string                 `json:"nome"`
+	Email         string                 `json:"email"`
+	AreaAtuacao   string                 `json:"area_atuacao"`
+	NivelCarreira string                 `json:"nivel_carreira"`
+	Papel         string                 `json:"papel"`
+	Organizacao   string                 `json:"organizacao"`
+	Equipe        string                 `json:"equipe"`
+	Gestor        string                 `json:"gestor"`
+	Competencias  []autoavaliacaoFixture `json:"competencias"`
+}
+
+type autoavaliacaoFixture struct {
+	Competencia string `json:"competencia"`
+	Nivel       int    `json:"nivel"`
+}
+
+// matriculaFixture usa datas relativas ao dia da execução: a inscrição foi feita há
+// inscricao_dias_atras dias e o prazo (opcional) vence em prazo_dias dias — negativo
+// para uma matrícula atrasada.
+type matriculaFixture struct {
+	Usuario            string `json:"usuario"`
+	Trilha             string `json:"trilha"`
+	Status             string `json:"status"`
+	InscricaoDiasAtras int    `json:"inscricao_dias_atras"`
+	PrazoDias          *int   `json:"prazo_dias"`
+}
+
+type cargoFixture struct {
+	Nome         string             `json:"nome"`
+	Descricao    string             `json:"descricao"`
+	Organizacao  string             `json:"organizacao"`
+	Competencias []exigenciaFixture `json:"competencias"`
+}
+
+type exigenciaFixture struct {
+	Competencia string `json:"competencia"`
+	NivelMinimo int    `json:"nivel_minimo"`
+}
+
+// nomeFixture é uma fixture carregada, com o nome do perfil de origem.
+type nomeFixture struct {
+	nome    string
+	fixture fixture
+}
+
+// carregarPerfil lê a fixture do perfil e, antes dela, as dos perfis incluídos.
+func carregarPerfil(perfil string) ([]nomeFixture, error) {
+	var fixtures []nomeFixture
+	visitados := make(map[string]bool)
+
+	var carregar func(nome string, cadeia []string) error
+	carregar = func(nome string, cadeia []string) error {
+		for _, n := range cadeia {
+			if n == nome {
+				return fmt.Errorf("inclusão circular de perfis: %v -> %s", cadeia, nome)
+			}
+		}
+		if visitados[nome] {
+			return nil
+		}
+
+		conteudo, err := fs.ReadFile(arquivosFixtures, "fixtures/"+nome+".json")
+		if err != nil {
+			return fmt.Errorf("perfil %q não encontrado", nome)
+		}
+		var f fixture
+		decoder := json.NewDecoder(bytes.NewReader(conteudo))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&f); err != nil {
+			return fmt.Errorf("fixture %s.json inválida: %w", nome, err)
+		}
+
+		for _, incluido := range f.Inclui {
+			if err := carregar(incluido, append(cadeia, nome)); err != nil {
+				return err
+			}
+		}
+		visitados[nome] = true
+		fixtures = append(fixtures, nomeFixture{nome: nome, fixture: f})
+		return nil
+	}
+
+	if err := carregar(perfil, nil); err != nil {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+// seeder grava as fixtures com upserts pela chave natural, de modo que reaplicar um
+// perfil atualiza os dados existentes em vez de duplicá-los.
+type seeder struct {
+	tx        *sql.Tx
+	senhaHash string
+}
+
+func newSeeder(tx *sql.Tx) (*seeder, error) {
+	senhaHash, err := bcrypt.GenerateFromPassword([]byte(senhaSeed), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao gerar hash da senha inicial: %w", err)
+	}
+	return &seeder{tx: tx, senhaHash: string(senhaHash)}, nil
+}
+
+// aplicar grava a fixture na ordem das dependências entre as entidades.
+func (s *seeder) aplicar(f *fixture) error {
+	for _, o := range f.Organizacoes {
+		if err := s.seedOrganizacao(o); err != nil {
+			return fmt.Errorf("organização %s: %w", o.Slug, err)
+		}
+	}
+	for _, c := range f.Competencias {
+		if err := s.seedCompetencia(c); err != nil {
+			return fmt.Errorf("competência %s: %w", c.Nome, err)
+		}
+	}
+	for _, t := range f.Trilhas {
+		if err := s.seedTrilha(t); err != nil {
+			return fmt.Errorf("trilha %s: %w", t.Nome, err)
+		}
+	}
+	for _, u := range f.Usuarios {
+		if err := s.seedUsuario(u); err != nil {
+			return fmt.Errorf("usuário %s: %w", u.Email, err)
+		}
+	}
+	// Gestores depois de todos os usuários, que podem se referenciar em qualquer ordem
+	for _, u := range f.Usuarios {
+		if err := s.seedGestor(u); err != nil {
+			return fmt.Errorf("gestor do usuário %s: %w", u.Email, err)
+		}
+	}
+	for _, m := range f.Matriculas {
+		if err := s.seedMatricula(m); err != nil {
+			return fmt.Errorf("matrícula de %s em %s: %w", m.Usuario, m.Trilha, err)
+		}
+	}
+	for _, c := range f.Cargos {
+		if err := s.seedCargo(c); err != nil {
+			return fmt.Errorf("cargo %s: %w", c.Nome, err)
+		}
+	}
+	return nil
+}
+
+func (s *seeder) seedOrganizacao(o organizacaoFixture) error {
+	var organizacaoID int64
+	err := s.tx.QueryRow(`
+		INSERT INTO organizacoes (nome, slug) VALUES ($1, $2)
+		ON CONFLICT (slug) DO UPDATE SET nome = EXCLUDED.nome
+		RETURNING id
+	`, o.Nome, o.Slug).Scan(&organizacaoID)
+	if err != nil {
+		return err
+	}
+
+	for _, equipe := range o.Equipes {
+		_, err := s.tx.Exec(
+			"INSERT INTO equipes (organizacao_id, nome) VALUES ($1, $2) ON CONFLICT (organizacao_id, nome) DO NOTHING",
+			organizacaoID, equipe,
+		)
+		if err != nil {
+			return fmt.Errorf("equipe %s: %w", equipe, err)
+		}
+	}
+	return nil
+}
+
+func (s *seeder) seedCompetencia(c competenciaFixture) error {
+	_, err := s.upsert(
+		"SELECT id FROM competencias WHERE nome = $1 ORDER BY id LIMIT 1", []any{c.Nome},
+		"UPDATE competencias SET nome = $2, categoria = $3, descricao = $4 WHERE id = $1",
+		"INSERT INTO competencias (nome, categoria, descricao) VALUES ($1, $2, $3) RETURNING id",
+		c.Nome, c.Categoria, c.Descricao,
+	)
+	return err
+}
+
+func (s *seeder) seedTrilha(t trilhaFixture) error {
+	organizacaoID, err := s.organizacaoCatalogo(t.Organizacao)
+	if err != nil {
+		return err
+	}
+
+	trilhaID, err := s.upsert(
+		"SELECT id FROM trilhas WHERE nome = $1 AND organizacao_id IS NOT DISTINCT FROM $2 ORDER BY id LIMIT 1",
+		[]any{t.Nome, organizacaoID},
+		`UPDATE trilhas SET nome = $2, descricao = $3, nivel = $4, carga_horaria = $5, foco_principal = $6,
+			organizacao_id = $7, nivel_carreira_minimo = NULLIF($8, '') WHERE id = $1`,
+		`INSERT INTO trilhas (nome, descricao, nivel, carga_horaria, foco_principal, organizacao_id, nivel_carreira_minimo)
+			VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')) RETURNING id`,
+		t.Nome, t.Descricao, t.Nivel, t.CargaHoraria, t.FocoPrincipal, organizacaoID, t.NivelCarreiraMinimo,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, nome := range t.Competencias {
+		competenciaID, err := s.competenciaID(nome)
+		if err != nil {
+			return err
+		}
+		_, err = s.tx.Exec(
+			"INSERT INTO trilha_competencia (trilha_id, competencia_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			trilhaID, competenciaID,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *seeder) seedUsuario(u usuarioFixture) error {
+	organizacaoID, err := s.organizacaoUsuario(u.Organizacao)
+	if err != nil {
+		return err
+	}
+	var equipeID *int64
+	if u.Equipe != "" {
+		var id int64
+		err := s.tx.QueryRow("SELECT id FROM equipes WHERE organizacao_id = $1 AND nome = $2", organizacaoID, u.Equipe).Scan(&id)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("equipe %q não encontrada na organização", u.Equipe)
+		}
+		if err != nil {
+			return err
+		}
+		equipeID = &id
+	}
+	papel := u.Papel
+	if papel == "" {
+		papel = model.PapelLearner
+	}
+
+	// A senha só é definida na criação: reaplicar o perfil não sobrescreve uma senha alterada
+	var usuarioID int64
+	err = s.tx.QueryRow(`
+		INSERT INTO usuarios (nome, email, area_atuacao, nivel_carreira, senha_hash, papel, organizacao_id, equipe_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (email) DO UPDATE
+		SET nome = EXCLUDED.nome, area_atuacao = EXCLUDED.area_atuacao, nivel_carreira = EXCLUDED.nivel_carreira,
+		    papel = EXCLUDED.papel, organizacao_id = EXCLUDED.organizacao_id, equipe_id = EXCLUDED.equipe_id,
+		    senha_hash = COALESCE(usuarios.senha_hash, EXCLUDED.senha_hash)
+		RETURNING id
+	`, u.Nome, u.Email, u.AreaAtuacao, u.NivelCarreira, s.senhaHash, papel, organizacaoID, equipeID).Scan(&usuarioID)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range u.Competencias {
+		competenciaID, err := s.competenciaID(a.Competencia)
+		if err != nil {
+			return err
+		}
+		_, err = s.tx.Exec(`
+			INSERT INTO usuario_competencias (usuario_id, competencia_id, origem, nivel) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (usuario_id, competencia_id, origem) DO UPDATE SET nivel = EXCLUDED.nivel, data_atualizacao = NOW()
+		`, usuarioID, competenciaID, model.OrigemAutoavaliacao, a.Nivel)
+		if err != nil {
+			return fmt.Errorf("competência %s: %w", a.Competencia, err)
+		}
+	}
+	return nil
+}
+
+func (s *seeder) seedGestor(u usuarioFixture) error {
+	var gestorID *int64
+	if u.Gestor != "" {
+		id, _, err := s.usuario(u.Gestor)
+		if err != nil {
+			return err
+		}
+		gestorID = &id
+	}
+	_, err := s.tx.Exec("UPDATE usuarios SET gestor_id = $2 WHERE email = $1", u.Email, gestorID)
+	return err
+}
+
+func (s *seeder) seedMatricula(m matriculaFixture) error {
+	usuarioID, organizacaoID, err := s.usuario(m.Usuario)
+	if err != nil {
+		return err
+	}
+	var trilhaID int64
+	err = s.tx.QueryRow(`
+		SELECT id FROM trilhas
+		WHERE nome = $1 AND (organizacao_id IS NULL OR organizacao_id = $2)
+		ORDER BY organizacao_id NULLS LAST, id LIMIT 1
+	`, m.Trilha, organizacaoID).Scan(&trilhaID)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("trilha %q não encontrada para a organização do usuário", m.Trilha)
+	}
+	if err != nil {
+		return err
+	}
+
+	status := m.Status
+	if status == "" {
+		status = model.StatusMatriculaAtiva
+	}
+	agora := time.Now()
+	var dataConclusao, dataCancelamento, dataPrazo *time.Time
+	switch status {
+	case model.StatusMatriculaAtiva:
+	case model.StatusMatriculaConcluida:
+		dataConclusao = &agora
+	case model.StatusMatriculaCancelada:
+		dataCancelamento = &agora
+	default:
+		return fmt.Errorf("status %q inválido", status)
+	}
+	if m.PrazoDias != nil {
+		prazo := agora.AddDate(0, 0, *m.PrazoDias)
+		dataPrazo = &prazo
+	}
+
+	// Uma matrícula por par usuário/trilha: reaplicar o perfil atualiza a mais recente
+	_, err = s.upsert(
+		"SELECT id FROM matriculas WHERE usuario_id = $1 AND trilha_id = $2 ORDER BY id DESC LIMIT 1",
+		[]any{usuarioID, trilhaID},
+		`UPDATE matriculas SET usuario_id = $2, trilha_id = $3, data_inscricao = $4, status = $5,
+			data_prazo = $6, data_conclusao = $7, data_cancelamento = $8 WHERE id = $1`,
+		`INSERT INTO matriculas (usuario_id, trilha_id, data_inscricao, status, data_prazo, data_conclusao, data_cancelamento)
+			VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+		usuarioID, trilhaID, agora.AddDate(0, 0, -m.InscricaoDiasAtras), status, dataPrazo, dataConclusao, dataCancelamento,
+	)
+	if err != nil {
+		return err
+	}
+
+	if status == model.StatusMatriculaConcluida {
+		return dao.ConcederCompetenciasTrilha(context.Background(), s.tx, usuarioID, trilhaID)
+	}
+	return nil
+}
+
+func (s *seeder) seedCargo(c cargoFixture) error {
+	organizacaoID, err := s.organizacaoCatalogo(c.Organizacao)
+	if err != nil {
+		return err
+	}
+
+	cargoID, err := s.upsert(
+		"SELECT id FROM cargos WHERE nome = $1 AND organizacao_id IS NOT DISTINCT FROM $2 ORDER BY id LIMIT 1",
+		[]any{c.Nome, organizacaoID},
+		"UPDATE cargos SET nome = $2, descricao = $3, organizacao_id = $4 WHERE id = $1",
+		"INSERT INTO cargos (nome, descricao, organizacao_id) VALUES ($1, $2, $3) RETURNING id",
+		c.Nome, c.Descricao, organizacaoID,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range c.Competencias {
+		competenciaID, err := s.competenciaID(e.Competencia)
+		if err != nil {
+			return err
+		}
+		_, err = s.tx.Exec(`
+			INSERT INTO cargo_competencias (cargo_id, competencia_id, nivel_minimo) VALUES ($1, $2, $3)
+			ON CONFLICT (cargo_id, competencia_id) DO UPDATE SET nivel_minimo = EXCLUDED.nivel_minimo
+		`, cargoID, competenciaID, e.NivelMinimo)
+		if err != nil {
+			return fmt.Errorf("competência %s: %w", e.Competencia, err)
+		}
+	}
+	return nil
+}
+
+// upsert atualiza a linha encontrada pela consulta da chave natural ou, se não houver,
+// insere uma nova, e retorna o ID. A atualização recebe o ID em $1 e os valores a partir
+// de $2; a inserção recebe os valores a partir de $1 e deve retornar o ID.
+func (s *seeder) upsert(buscar string, chave []any, atualizar, inserir string, valores ...any) (int64, error) {
+	var id int64
+	err := s.tx.QueryRow(buscar, chave...).Scan(&id)
+	if err == sql.ErrNoRows {
+		err = s.tx.QueryRow(inserir, valores...).Scan(&id)
+		return id, err
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = s.tx.Exec(atualizar, append([]any{id}, valores...)...)
+	return id, err
+}
+
+// organizacaoUsuario retorna o ID da organização pelo slug (padrão: a plataforma).
+func (s *seeder) organizacaoUsuario(slug string) (int64, error) {
+	if slug == "" {
+		slug = "plataforma"
+	}
+	var id int64
+	err := s.tx.QueryRow("SELECT id FROM organizacoes WHERE slug = $1", slug).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("organização %q não encontrada", slug)
+	}
+	return id, err
+}
+
+// organizacaoCatalogo retorna o ID da organização dona de uma trilha ou cargo, ou nil
+// (catálogo público) se o slug não for informado.
+func (s *seeder) organizacaoCatalogo(slug string) (*int64, error) {
+	if slug == "" {
+		return nil, nil
+	}
+	id, err := s.organizacaoUsuario(slug)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// competenciaID retorna o ID da competência pelo nome.
+func (s *seeder) competenciaID(nome string) (int64, error) {
+	var id int64
+	err := s.tx.QueryRow("SELECT id FROM competencias WHERE nome = $1 ORDER BY id LIMIT 1", nome).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("competência %q não encontrada", nome)
+	}
+	return id, err
+}
+
+// usuario retorna o ID e a organização do usuário pelo e-mail.
+func (s *seeder) usuario(email string) (int64, int64, error) {
+	var id, organizacaoID int64
+	err := s.tx.QueryRow("SELECT id, organizacao_id FROM usuarios WHERE email = $1", email).Scan(&id, &organizacaoID)
+	if err == sql.ErrNoRows {
+		return 0, 0, fmt.Errorf("usuário %q não encontrado", email)
+	}
+	return id, organizacaoID, err
+}
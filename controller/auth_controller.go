@@ -0,0 +1,103 @@
+package controller
+
+import (
+	"net/http"
+
+	"upskilling-api/model"
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuthController agrupa os handlers HTTP de autenticação.
+type AuthController struct {
+	authService service.AuthService
+}
+
+// NewAuthController cria uma nova instância de AuthController.
+func NewAuthController(authService service.AuthService) *AuthController {
+	return &AuthController{
+		authService: authService,
+	}
+}
+
+// Login godoc
+// @Summary Autentica um usuário
+// @Description Valida email e senha e emite um access token JWT (Bearer) e um refresh token.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param credenciais body model.LoginRequest true "Email e senha"
+// @Success 200 {object} model.TokenResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /auth/login [post]
+func (ctrl *AuthController) Login(c *gin.Context) {
+	var req model.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.authService.Login(c.Request.Context(), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RefreshToken godoc
+// @Summary Renova os tokens
+// @Description Troca um refresh token válido por um novo par de tokens. O refresh token usado é revogado.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param token body model.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} model.TokenResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /auth/refresh [post]
+func (ctrl *AuthController) RefreshToken(c *gin.Context) {
+	var req model.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.authService.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// Logout godoc
+// @Summary Encerra a sessão
+// @Description Revoga o refresh token informado. O access token permanece válido até expirar.
+// @Tags Auth
+// @Accept json
+// @Param token body model.RefreshTokenRequest true "Refresh token"
+// @Success 204 "No Content"
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /auth/logout [post]
+func (ctrl *AuthController) Logout(c *gin.Context) {
+	var req model.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	if err := ctrl.authService.Logout(c.Request.Context(), req.RefreshToken); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"strings"
+
+	"upskilling-api/model"
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// usuarioContextKey é a chave do usuário autenticado no contexto do Gin.
+const usuarioContextKey = "usuario"
+
+// AuthMiddleware exige um access token válido no cabeçalho "Authorization: Bearer <token>"
+// e anexa o usuário autenticado ao contexto. Sem token válido, responde 401.
+func AuthMiddleware(authService service.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 1. Extração do token
+		header := c.GetHeader("Authorization")
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || strings.TrimSpace(token) == "" {
+			c.Header("WWW-Authenticate", `Bearer realm="upskilling-api"`)
+			handleError(c, &model.UnauthorizedError{Chave: "auth.cabecalho_ausente"})
+			return
+		}
+
+		// 2. Validação e carga do usuário
+		usuario, err := authService.Authenticate(c.Request.Context(), strings.TrimSpace(token))
+		if err != nil {
+			c.Header("WWW-Authenticate", `Bearer realm="upskilling-api", error="invalid_token"`)
+			handleError(c, err)
+			return
+		}
+
+		c.Set(usuarioContextKey, usuario)
+		c.Next()
+	}
+}
+
+// usuarioAutenticado retorna o usuário anexado por AuthMiddleware (nil em rotas públicas).
+func usuarioAutenticado(c *gin.Context) *model.Usuario {
+	if v, ok := c.Get(usuarioContextKey); ok {
+		if usuario, ok := v.(*model.Usuario); ok {
+			return usuario
+		}
+	}
+	return nil
+}
+
+// RequirePermissao restringe a rota a usuários cujo papel concede a permissão. Deve ser
+// usado após AuthMiddleware; sem a permissão, responde 403.
+func RequirePermissao(permissao model.Permissao) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		usuario := usuarioAutenticado(c)
+		if usuario == nil {
+			handleError(c, &model.UnauthorizedError{Chave: "auth.nao_autenticado"})
+			return
+		}
+		if !usuario.TemPermissao(permissao) {
+			handleError(c, &model.ForbiddenError{Chave: "acesso.permissao_papel", Args: []any{usuario.Papel, permissao}})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BuscaController agrupa os handlers HTTP de busca.
+type BuscaController struct {
+	buscaService service.BuscaService
+}
+
+// NewBuscaController cria uma nova instância de BuscaController.
+func NewBuscaController(buscaService service.BuscaService) *BuscaController {
+	return &BuscaController{
+		buscaService: buscaService,
+	}
+}
+
+// Search godoc
+// @Summary Busca trilhas e competências
+// @Description Busca textual em trilhas (nome, descrição e foco principal) e competências (nome e descrição), com stemming em português e sem diferenciar acentos. Os resultados vêm ordenados por relevância, com os termos encontrados destacados em <mark> no trecho. Aceita a sintaxe de busca web ("frase exata", OR, -termo). Metadados de paginação nos cabeçalhos X-Total-Count e Link.
+// @Tags Busca
+// @Produce json
+// @Param q query string true "Termo de busca (ex: python dados)"
+// @Param tipo query string false "Restringe a busca a 'trilha' ou 'competencia'"
+// @Param limit query int false "Itens por página (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento"
+// @Success 200 {array} model.ResultadoBusca
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /search [get]
+func (ctrl *BuscaController) Search(c *gin.Context) {
+	params, ok := bindListParams(c)
+	if !ok {
+		return
+	}
+
+	res, pagina, err := ctrl.buscaService.Buscar(c.Request.Context(), usuarioAutenticado(c), c.Query("q"), c.Query("tipo"), params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	setPaginationHeaders(c, pagina)
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,253 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"upskilling-api/model"
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CargoController agrupa os handlers HTTP de cargos-alvo e análise de lacunas de competências.
+type CargoController struct {
+	cargoService           service.CargoService
+	gapCompetenciasService service.GapCompetenciasService
+}
+
+// NewCargoController cria uma nova instância de CargoController.
+func NewCargoController(cargoService service.CargoService, gapCompetenciasService service.GapCompetenciasService) *CargoController {
+	return &CargoController{
+		cargoService:           cargoService,
+		gapCompetenciasService: gapCompetenciasService,
+	}
+}
+
+// CreateCargo godoc
+// @Summary Cria um cargo-alvo
+// @Description Cria um cargo-alvo com as competências e os níveis mínimos (1 a 5) exigidos, privado da organização do usuário. Com publica=true, o cargo entra no catálogo público (apenas curadores da plataforma).
+// @Tags Cargos
+// @Accept json
+// @Produce json
+// @Param cargo body model.CreateCargoRequest true "Dados do Cargo"
+// @Success 201 {object} model.CargoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /cargos [post]
+func (ctrl *CargoController) CreateCargo(c *gin.Context) {
+	var req model.CreateCargoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.cargoService.Create(c.Request.Context(), usuarioAutenticado(c), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetAllCargos godoc
+// @Summary Lista os cargos-alvo
+// @Description Retorna uma página dos cargos-alvo visíveis para a organização (catálogo público e cargos privados), sem as competências. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.
+// @Tags Cargos
+// @Produce json
+// @Param limit query int false "Itens por página (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento (ignorado quando cursor é informado)"
+// @Param cursor query string false "Cursor da próxima página (X-Next-Cursor)"
+// @Param sort query string false "Ordenação: id, nome (prefixo '-' para decrescente)"
+// @Success 200 {array} model.CargoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /cargos [get]
+func (ctrl *CargoController) GetAllCargos(c *gin.Context) {
+	params, ok := bindListParams(c)
+	if !ok {
+		return
+	}
+
+	res, pagina, err := ctrl.cargoService.FindAll(c.Request.Context(), usuarioAutenticado(c), params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	setPaginationHeaders(c, pagina)
+	c.JSON(http.StatusOK, res)
+}
+
+// GetCargoByID godoc
+// @Summary Busca um cargo-alvo por ID
+// @Description Retorna um cargo-alvo com as competências e os níveis mínimos exigidos.
+// @Tags Cargos
+// @Produce json
+// @Param id path int true "ID do Cargo"
+// @Success 200 {object} model.CargoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /cargos/{id} [get]
+func (ctrl *CargoController) GetCargoByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.cargoService.FindByID(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateCargo godoc
+// @Summary Atualiza um cargo-alvo
+// @Description Atualiza o nome e a descrição de um cargo-alvo.
+// @Tags Cargos
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do Cargo"
+// @Param cargo body model.UpdateCargoRequest true "Dados do Cargo para atualização"
+// @Success 200 {object} model.CargoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /cargos/{id} [put]
+func (ctrl *CargoController) UpdateCargo(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.UpdateCargoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.cargoService.Update(c.Request.Context(), usuarioAutenticado(c), id, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteCargo godoc
+// @Summary Deleta um cargo-alvo
+// @Description Remove um cargo-alvo e suas competências exigidas.
+// @Tags Cargos
+// @Produce json
+// @Param id path int true "ID do Cargo"
+// @Success 204 "No Content"
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /cargos/{id} [delete]
+func (ctrl *CargoController) DeleteCargo(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	err = ctrl.cargoService.Delete(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetCompetenciasCargo godoc
+// @Summary Define as competências de um cargo-alvo
+// @Description Substitui as competências exigidas pelo cargo e seus níveis mínimos (1 a 5).
+// @Tags Cargos
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do Cargo"
+// @Param competencias body model.SetCargoCompetenciasRequest true "Níveis mínimos por competência"
+// @Success 200 {object} model.CargoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /cargos/{id}/competencias [put]
+func (ctrl *CargoController) SetCompetenciasCargo(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.SetCargoCompetenciasRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.cargoService.SetCompetencias(c.Request.Context(), usuarioAutenticado(c), id, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetGapCompetencias godoc
+// @Summary Lacunas de competências para um cargo-alvo
+// @Description Compara o nível efetivo de cada competência do usuário com o mínimo exigido pelo cargo e lista as lacunas, com as trilhas que desenvolvem cada competência e o nível que concedem. Uma trilha é recomendada por lacuna (a de menor carga horária que atinge o nível, reaproveitando recomendações) e carga_horaria_total soma as recomendadas sem repetição. Acessível ao próprio usuário, ao gestor direto e a administradores.
+// @Tags Usuarios
+// @Produce json
+// @Param id path int true "ID do Usuário"
+// @Param cargo query int true "ID do cargo-alvo"
+// @Success 200 {object} model.GapCompetenciasResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /usuarios/{id}/gap [get]
+func (ctrl *CargoController) GetGapCompetencias(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.gapCompetenciasService.Analisar(c.Request.Context(), usuarioAutenticado(c), id, c.Query("cargo"))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"upskilling-api/model"
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CompetenciaController agrupa os handlers HTTP de competências.
+type CompetenciaController struct {
+	competenciaService service.CompetenciaService
+}
+
+// NewCompetenciaController cria uma nova instância de CompetenciaController.
+func NewCompetenciaController(competenciaService service.CompetenciaService) *CompetenciaController {
+	return &CompetenciaController{
+		competenciaService: competenciaService,
+	}
+}
+
+// CreateCompetencia godoc
+// @Summary Cria uma nova competência
+// @Description Cadastra uma nova competência (skill) do futuro do trabalho. As competências são compartilhadas entre as organizações; apenas curadores da plataforma as alteram.
+// @Tags Competencias
+// @Accept json
+// @Produce json
+// @Param competencia body model.CreateCompetenciaRequest true "Dados da Competência"
+// @Success 201 {object} model.CompetenciaResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /competencias [post]
+func (ctrl *CompetenciaController) CreateCompetencia(c *gin.Context) {
+	var req model.CreateCompetenciaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.competenciaService.Create(c.Request.Context(), usuarioAutenticado(c), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetAllCompetencias godoc
+// @Summary Lista as competências
+// @Description Retorna uma página de competências, com filtro opcional por categoria. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.
+// @Tags Competencias
+// @Produce json
+// @Param categoria query string false "Categoria da competência (ex: Tecnologia, Humana, Gestão)"
+// @Param limit query int false "Itens por página (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento (ignorado quando cursor é informado)"
+// @Param cursor query string false "Cursor da próxima página (X-Next-Cursor)"
+// @Param sort query string false "Ordenação: id, nome (prefixo '-' para decrescente)"
+// @Success 200 {array} model.CompetenciaResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /competencias [get]
+func (ctrl *CompetenciaController) GetAllCompetencias(c *gin.Context) {
+	params, ok := bindListParams(c, "categoria")
+	if !ok {
+		return
+	}
+
+	res, pagina, err := ctrl.competenciaService.FindAll(c.Request.Context(), params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	setPaginationHeaders(c, pagina)
+	c.JSON(http.StatusOK, res)
+}
+
+// GetCompetenciaByID godoc
+// @Summary Busca uma competência por ID
+// @Description Retorna os detalhes de uma competência específica.
+// @Tags Competencias
+// @Produce json
+// @Param id path int true "ID da Competência"
+// @Success 200 {object} model.CompetenciaResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /competencias/{id} [get]
+func (ctrl *CompetenciaController) GetCompetenciaByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.competenciaService.FindByID(c.Request.Context(), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateCompetencia godoc
+// @Summary Atualiza uma competência
+// @Description Atualiza os dados de uma competência existente.
+// @Tags Competencias
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Competência"
+// @Param competencia body model.UpdateCompetenciaRequest true "Dados da Competência para atualização"
+// @Success 200 {object} model.CompetenciaResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /competencias/{id} [put]
+func (ctrl *CompetenciaController) UpdateCompetencia(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.UpdateCompetenciaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.competenciaService.Update(c.Request.Context(), usuarioAutenticado(c), id, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteCompetencia godoc
+// @Summary Deleta uma competência
+// @Description Remove uma competência e suas associações com trilhas.
+// @Tags Competencias
+// @Produce json
+// @Param id path int true "ID da Competência"
+// @Success 204 "No Content"
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /competencias/{id} [delete]
+func (ctrl *CompetenciaController) DeleteCompetencia(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	err = ctrl.competenciaService.Delete(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetTrilhasByCompetencia godoc
+// @Summary Lista as trilhas de uma competência
+// @Description Retorna as trilhas que desenvolvem uma competência específica.
+// @Tags Competencias
+// @Produce json
+// @Param id path int true "ID da Competência"
+// @Success 200 {array} model.TrilhaResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /competencias/{id}/trilhas [get]
+func (ctrl *CompetenciaController) GetTrilhasByCompetencia(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.competenciaService.GetTrilhas(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
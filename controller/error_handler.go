@@ -4,12 +4,15 @@ import (
 	"log"
 	"net/http"
 
+	"upskilling-api/i18n"
 	"upskilling-api/model"
 
 	"github.com/gin-gonic/gin"
 )
 
-// ErrorHandlerMiddleware é um middleware para tratamento centralizado de erros.
+// ErrorHandlerMiddleware é um middleware para tratamento centralizado de erros. As respostas
+// seguem o formato Problem Details (RFC 7807), servido como application/problem+json, com
+// título e detalhe no idioma negociado pelo Accept-Language.
 func ErrorHandlerMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Next()
@@ -17,36 +20,60 @@ func ErrorHandlerMiddleware() gin.HandlerFunc {
 		// Verifica se houve algum erro durante o processamento da requisição
 		if len(c.Errors) > 0 {
 			err := c.Errors[0].Err
+			idioma := idiomaRequisicao(c)
+			c.Header("Content-Language", idioma)
+			c.Header("Vary", "Accept-Language")
 
 			// Tenta converter o erro para a interface CustomError
 			if customErr, ok := err.(model.CustomError); ok {
-				c.JSON(customErr.StatusCode(), model.ErrorResponse{
-					Message: customErr.Message(),
-					Details: customErr.Error(),
-				})
+				detalhe := customErr.Error()
+				if traduzivel, ok := err.(model.ErroTraduzivel); ok {
+					detalhe = traduzivel.Detalhe(idioma)
+				}
+				response := problema(c, customErr.StatusCode(), customErr.Code(), customErr.Message(idioma), detalhe)
+				switch e := err.(type) {
+				case *model.BusinessRuleError:
+					response.Violacoes = model.TextosViolacoes(idioma, e.Violacoes)
+				case *model.ValidationError:
+					response.Errors = e.Campos
+				case *model.InvalidParameterError:
+					response.Errors = []model.CampoInvalido{{Campo: e.Param, Motivo: e.Motivo(idioma)}}
+				case *model.TransientError:
+					c.Header("Retry-After", "1")
+				}
+				escreverProblema(c, response)
 				return
 			}
 
-			// Trata erros de validação do Gin (binding)
-			if c.Writer.Status() == http.StatusBadRequest {
-				// O Gin já deve ter retornado o erro 400, apenas garantimos o formato
-				c.JSON(http.StatusBadRequest, model.ErrorResponse{
-					Message: "Dados de entrada inválidos.",
-					Details: err.Error(),
-				})
-				return
-			}
-
-			// Erro interno genérico (500)
-			log.Printf("Erro interno não tratado: %v", err)
-			c.JSON(http.StatusInternalServerError, model.ErrorResponse{
-				Message: "Ocorreu um erro interno no servidor.",
-				Details: err.Error(),
-			})
+			// Erro interno genérico (500): o erro original fica apenas no log, para não expor
+			// detalhes de implementação (SQL, infraestrutura) ao cliente
+			log.Printf("Erro interno não tratado em %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+			escreverProblema(c, problema(c, http.StatusInternalServerError, model.CodigoErroInterno,
+				i18n.Titulo(idioma, model.CodigoErroInterno), i18n.Mensagem(idioma, "erro.interno")))
 		}
 	}
 }
 
+// problema monta o corpo Problem Details de um erro; type é derivado do código e instance
+// é o caminho da requisição.
+func problema(c *gin.Context, status int, code, title, detail string) model.ErrorResponse {
+	return model.ErrorResponse{
+		Type:     model.TipoErroPrefixo + code,
+		Title:    title,
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request.URL.RequestURI(),
+		Code:     code,
+	}
+}
+
+// escreverProblema responde o erro com o Content-Type application/problem+json.
+func escreverProblema(c *gin.Context, response model.ErrorResponse) {
+	// c.JSON só define o Content-Type quando ele ainda não foi informado
+	c.Header("Content-Type", "application/problem+json")
+	c.JSON(response.Status, response)
+}
+
 // handleError é uma função auxiliar para lidar com erros de serviço.
 func handleError(c *gin.Context, err error) {
 	if err == nil {
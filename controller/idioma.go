@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"upskilling-api/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+const chaveIdioma = "idioma"
+
+// idiomaRequisicao retorna o idioma das mensagens da requisição, negociado pelo cabeçalho
+// Accept-Language na primeira chamada e guardado no contexto do Gin.
+func idiomaRequisicao(c *gin.Context) string {
+	if idioma := c.GetString(chaveIdioma); idioma != "" {
+		return idioma
+	}
+	idioma := i18n.Negociar(c.GetHeader("Accept-Language"))
+	c.Set(chaveIdioma, idioma)
+	return idioma
+}
@@ -1,8 +1,10 @@
 package controller
 
 import (
+	"context"
 	"net/http"
 	"strconv"
+	"time"
 
 	"upskilling-api/model"
 	"upskilling-api/service"
@@ -10,34 +12,49 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-var matriculaService = service.NewMatriculaService()
+// MatriculaController agrupa os handlers HTTP de matrículas e progresso.
+type MatriculaController struct {
+	matriculaService service.MatriculaService
+}
+
+// NewMatriculaController cria uma nova instância de MatriculaController.
+func NewMatriculaController(matriculaService service.MatriculaService) *MatriculaController {
+	return &MatriculaController{
+		matriculaService: matriculaService,
+	}
+}
 
 // MatricularRequest é o DTO para a requisição de matrícula.
 type MatricularRequest struct {
-	UsuarioID int64 `json:"usuario_id" binding:"required,gt=0"`
-	TrilhaID  int64 `json:"trilha_id" binding:"required,gt=0"`
+	UsuarioID int64      `json:"usuario_id" binding:"required,gt=0"`
+	TrilhaID  int64      `json:"trilha_id" binding:"required,gt=0"`
+	DataPrazo *time.Time `json:"data_prazo,omitempty"` // prazo opcional para conclusão
 }
 
 // MatricularUsuario godoc
 // @Summary Matricular usuário em uma trilha
-// @Description Realiza a inscrição de um usuário em uma trilha de aprendizagem.
+// @Description Realiza a inscrição de um usuário em uma trilha de aprendizagem, após avaliar os requisitos de elegibilidade da trilha. O prazo (data_prazo) é opcional.
 // @Tags Matriculas
 // @Accept json
 // @Produce json
 // @Param matricula body MatricularRequest true "Dados da Matrícula"
 // @Success 201 {object} model.Matricula
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
 // @Failure 422 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /matriculas [post]
-func MatricularUsuario(c *gin.Context) {
+func (ctrl *MatriculaController) MatricularUsuario(c *gin.Context) {
 	var req MatricularRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "Dados de entrada inválidos.", Details: err.Error()})
+		handleError(c, erroValidacao(c, err))
 		return
 	}
 
-	res, err := matriculaService.Matricular(req.UsuarioID, req.TrilhaID)
+	res, err := ctrl.matriculaService.Matricular(c.Request.Context(), usuarioAutenticado(c), req.UsuarioID, req.TrilhaID, req.DataPrazo)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -48,23 +65,315 @@ func MatricularUsuario(c *gin.Context) {
 
 // GetMatriculasByUsuario godoc
 // @Summary Lista matrículas de um usuário
-// @Description Retorna todas as matrículas de um usuário específico.
+// @Description Retorna uma página das matrículas de um usuário. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.
 // @Tags Matriculas
 // @Produce json
 // @Param id path int true "ID do Usuário"
+// @Param limit query int false "Itens por página (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento (ignorado quando cursor é informado)"
+// @Param cursor query string false "Cursor da próxima página (X-Next-Cursor)"
+// @Param sort query string false "Ordenação: id, data_inscricao, status, horas_estudadas (padrão -data_inscricao)"
+// @Param status query string false "Filtra por status (ATIVA, CONCLUIDA, CANCELADA)"
+// @Param trilha_id query int false "Filtra por trilha"
 // @Success 200 {array} model.Matricula
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 422 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /usuarios/{id}/matriculas [get]
-func GetMatriculasByUsuario(c *gin.Context) {
+func (ctrl *MatriculaController) GetMatriculasByUsuario(c *gin.Context) {
 	usuarioID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "ID de Usuário inválido.", Details: "O ID deve ser um número inteiro."})
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	params, ok := bindListParams(c, "status", "trilha_id")
+	if !ok {
+		return
+	}
+
+	res, pagina, err := ctrl.matriculaService.GetMatriculasByUsuario(c.Request.Context(), usuarioAutenticado(c), usuarioID, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	setPaginationHeaders(c, pagina)
+	c.JSON(http.StatusOK, res)
+}
+
+// GetMatriculaByID godoc
+// @Summary Busca uma matrícula por ID
+// @Description Retorna os detalhes de uma matrícula específica.
+// @Tags Matriculas
+// @Produce json
+// @Param id path int true "ID da Matrícula"
+// @Success 200 {object} model.Matricula
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /matriculas/{id} [get]
+func (ctrl *MatriculaController) GetMatriculaByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.matriculaService.FindByID(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ConcluirMatricula godoc
+// @Summary Conclui uma matrícula
+// @Description Marca uma matrícula ATIVA como CONCLUIDA e registra a data de conclusão. Apenas o gestor direto do usuário ou quem gerencia matrículas pode concluí-la manualmente.
+// @Tags Matriculas
+// @Produce json
+// @Param id path int true "ID da Matrícula"
+// @Success 200 {object} model.Matricula
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /matriculas/{id}/concluir [post]
+func (ctrl *MatriculaController) ConcluirMatricula(c *gin.Context) {
+	transicionarMatricula(c, ctrl.matriculaService.Concluir)
+}
+
+// CancelarMatricula godoc
+// @Summary Cancela uma matrícula
+// @Description Marca uma matrícula ATIVA como CANCELADA e registra a data de cancelamento.
+// @Tags Matriculas
+// @Produce json
+// @Param id path int true "ID da Matrícula"
+// @Success 200 {object} model.Matricula
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /matriculas/{id}/cancelar [post]
+func (ctrl *MatriculaController) CancelarMatricula(c *gin.Context) {
+	transicionarMatricula(c, ctrl.matriculaService.Cancelar)
+}
+
+// ReativarMatricula godoc
+// @Summary Reativa uma matrícula
+// @Description Retorna uma matrícula CANCELADA ao status ATIVA.
+// @Tags Matriculas
+// @Produce json
+// @Param id path int true "ID da Matrícula"
+// @Success 200 {object} model.Matricula
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /matriculas/{id}/reativar [post]
+func (ctrl *MatriculaController) ReativarMatricula(c *gin.Context) {
+	transicionarMatricula(c, ctrl.matriculaService.Reativar)
+}
+
+// RegistrarSessaoEstudo godoc
+// @Summary Registra uma sessão de estudo
+// @Description Registra horas estudadas em uma matrícula ATIVA. Ao atingir a carga horária da trilha, a matrícula é concluída automaticamente.
+// @Tags Matriculas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Matrícula"
+// @Param sessao body model.RegistrarSessaoRequest true "Dados da Sessão de Estudo"
+// @Success 201 {object} model.ProgressoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /matriculas/{id}/sessoes [post]
+func (ctrl *MatriculaController) RegistrarSessaoEstudo(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.RegistrarSessaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.matriculaService.RegistrarSessao(c.Request.Context(), usuarioAutenticado(c), id, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetSessoesEstudo godoc
+// @Summary Lista as sessões de estudo de uma matrícula
+// @Description Retorna as sessões de estudo registradas, da mais recente para a mais antiga.
+// @Tags Matriculas
+// @Produce json
+// @Param id path int true "ID da Matrícula"
+// @Success 200 {array} model.SessaoEstudo
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /matriculas/{id}/sessoes [get]
+func (ctrl *MatriculaController) GetSessoesEstudo(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.matriculaService.GetSessoes(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetProgressoMatricula godoc
+// @Summary Consulta o progresso de uma matrícula
+// @Description Retorna as horas estudadas, a carga horária da trilha, o percentual concluído e a data da última atividade.
+// @Tags Matriculas
+// @Produce json
+// @Param id path int true "ID da Matrícula"
+// @Success 200 {object} model.ProgressoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /matriculas/{id}/progresso [get]
+func (ctrl *MatriculaController) GetProgressoMatricula(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.matriculaService.GetProgresso(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ConcluirAulaMatricula godoc
+// @Summary Conclui uma aula na matrícula
+// @Description Marca uma aula da trilha como concluída; sua duração é somada às horas estudadas e pode concluir a matrícula.
+// @Tags Matriculas
+// @Produce json
+// @Param id path int true "ID da Matrícula"
+// @Param aulaId path int true "ID da Aula"
+// @Success 200 {object} model.ProgressoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /matriculas/{id}/aulas/{aulaId}/concluir [post]
+func (ctrl *MatriculaController) ConcluirAulaMatricula(c *gin.Context) {
+	id, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+	aulaID, ok := parsePathID(c, "aulaId")
+	if !ok {
+		return
+	}
+
+	res, err := ctrl.matriculaService.ConcluirAula(c.Request.Context(), usuarioAutenticado(c), id, aulaID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetElegibilidade godoc
+// @Summary Verifica a elegibilidade de um usuário para uma trilha
+// @Description Avalia os requisitos da trilha para o usuário e lista os que não foram atendidos.
+// @Tags Matriculas
+// @Produce json
+// @Param id path int true "ID do Usuário"
+// @Param trilhaId path int true "ID da Trilha"
+// @Success 200 {object} model.ElegibilidadeResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /usuarios/{id}/elegibilidade/{trilhaId} [get]
+func (ctrl *MatriculaController) GetElegibilidade(c *gin.Context) {
+	usuarioID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+	trilhaID, ok := parsePathID(c, "trilhaId")
+	if !ok {
+		return
+	}
+
+	res, err := ctrl.matriculaService.VerificarElegibilidade(c.Request.Context(), usuarioAutenticado(c), usuarioID, trilhaID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+	res.RequisitosNaoAtendidos = model.TextosViolacoes(idiomaRequisicao(c), res.Violacoes)
+
+	c.JSON(http.StatusOK, res)
+}
+
+// transicionarMatricula extrai o ID da rota e aplica a transição de status informada.
+func transicionarMatricula(c *gin.Context, transicao func(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error)) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
 		return
 	}
 
-	res, err := matriculaService.GetMatriculasByUsuario(usuarioID)
+	res, err := transicao(c.Request.Context(), usuarioAutenticado(c), id)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -0,0 +1,322 @@
+package controller
+
+import (
+	"net/http"
+
+	"upskilling-api/model"
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ModuloController agrupa os handlers HTTP de módulos e aulas das trilhas.
+type ModuloController struct {
+	moduloService service.ModuloService
+}
+
+// NewModuloController cria uma nova instância de ModuloController.
+func NewModuloController(moduloService service.ModuloService) *ModuloController {
+	return &ModuloController{
+		moduloService: moduloService,
+	}
+}
+
+// GetModulosByTrilha godoc
+// @Summary Lista o conteúdo de uma trilha
+// @Description Retorna os módulos da trilha em ordem, cada um com suas aulas/atividades.
+// @Tags Modulos
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Success 200 {array} model.ModuloResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/modulos [get]
+func (ctrl *ModuloController) GetModulosByTrilha(c *gin.Context) {
+	trilhaID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+
+	res, err := ctrl.moduloService.FindByTrilha(c.Request.Context(), usuarioAutenticado(c), trilhaID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// CreateModulo godoc
+// @Summary Cria um módulo na trilha
+// @Description Adiciona um módulo de conteúdo à trilha. Sem ordem informada, o módulo é posicionado após o último.
+// @Tags Modulos
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param modulo body model.CreateModuloRequest true "Dados do Módulo"
+// @Success 201 {object} model.ModuloResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/modulos [post]
+func (ctrl *ModuloController) CreateModulo(c *gin.Context) {
+	trilhaID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+
+	var req model.CreateModuloRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.moduloService.Create(c.Request.Context(), usuarioAutenticado(c), trilhaID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetModuloByID godoc
+// @Summary Busca um módulo da trilha
+// @Description Retorna um módulo com suas aulas/atividades em ordem.
+// @Tags Modulos
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param moduloId path int true "ID do Módulo"
+// @Success 200 {object} model.ModuloResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/modulos/{moduloId} [get]
+func (ctrl *ModuloController) GetModuloByID(c *gin.Context) {
+	trilhaID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+	moduloID, ok := parsePathID(c, "moduloId")
+	if !ok {
+		return
+	}
+
+	res, err := ctrl.moduloService.FindByID(c.Request.Context(), usuarioAutenticado(c), trilhaID, moduloID)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// UpdateModulo godoc
+// @Summary Atualiza um módulo da trilha
+// @Description Atualiza título, descrição ou ordem de um módulo.
+// @Tags Modulos
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param moduloId path int true "ID do Módulo"
+// @Param modulo body model.UpdateModuloRequest true "Dados do Módulo para atualização"
+// @Success 200 {object} model.ModuloResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/modulos/{moduloId} [put]
+func (ctrl *ModuloController) UpdateModulo(c *gin.Context) {
+	trilhaID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+	moduloID, ok := parsePathID(c, "moduloId")
+	if !ok {
+		return
+	}
+
+	var req model.UpdateModuloRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.moduloService.Update(c.Request.Context(), usuarioAutenticado(c), trilhaID, moduloID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteModulo godoc
+// @Summary Deleta um módulo da trilha
+// @Description Remove o módulo e suas aulas; a carga horária da trilha é recalculada.
+// @Tags Modulos
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param moduloId path int true "ID do Módulo"
+// @Success 204 "No Content"
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/modulos/{moduloId} [delete]
+func (ctrl *ModuloController) DeleteModulo(c *gin.Context) {
+	trilhaID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+	moduloID, ok := parsePathID(c, "moduloId")
+	if !ok {
+		return
+	}
+
+	if err := ctrl.moduloService.Delete(c.Request.Context(), usuarioAutenticado(c), trilhaID, moduloID); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateAula godoc
+// @Summary Cria uma aula no módulo
+// @Description Adiciona uma aula/atividade ao módulo; a carga horária da trilha é recalculada a partir das aulas.
+// @Tags Modulos
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param moduloId path int true "ID do Módulo"
+// @Param aula body model.CreateAulaRequest true "Dados da Aula"
+// @Success 201 {object} model.Aula
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/modulos/{moduloId}/aulas [post]
+func (ctrl *ModuloController) CreateAula(c *gin.Context) {
+	trilhaID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+	moduloID, ok := parsePathID(c, "moduloId")
+	if !ok {
+		return
+	}
+
+	var req model.CreateAulaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.moduloService.CreateAula(c.Request.Context(), usuarioAutenticado(c), trilhaID, moduloID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// UpdateAula godoc
+// @Summary Atualiza uma aula do módulo
+// @Description Atualiza os dados de uma aula/atividade; a carga horária da trilha é recalculada.
+// @Tags Modulos
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param moduloId path int true "ID do Módulo"
+// @Param aulaId path int true "ID da Aula"
+// @Param aula body model.UpdateAulaRequest true "Dados da Aula para atualização"
+// @Success 200 {object} model.Aula
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/modulos/{moduloId}/aulas/{aulaId} [put]
+func (ctrl *ModuloController) UpdateAula(c *gin.Context) {
+	trilhaID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+	moduloID, ok := parsePathID(c, "moduloId")
+	if !ok {
+		return
+	}
+	aulaID, ok := parsePathID(c, "aulaId")
+	if !ok {
+		return
+	}
+
+	var req model.UpdateAulaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.moduloService.UpdateAula(c.Request.Context(), usuarioAutenticado(c), trilhaID, moduloID, aulaID, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteAula godoc
+// @Summary Deleta uma aula do módulo
+// @Description Remove uma aula/atividade; a carga horária da trilha é recalculada.
+// @Tags Modulos
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param moduloId path int true "ID do Módulo"
+// @Param aulaId path int true "ID da Aula"
+// @Success 204 "No Content"
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/modulos/{moduloId}/aulas/{aulaId} [delete]
+func (ctrl *ModuloController) DeleteAula(c *gin.Context) {
+	trilhaID, ok := parsePathID(c, "id")
+	if !ok {
+		return
+	}
+	moduloID, ok := parsePathID(c, "moduloId")
+	if !ok {
+		return
+	}
+	aulaID, ok := parsePathID(c, "aulaId")
+	if !ok {
+		return
+	}
+
+	if err := ctrl.moduloService.DeleteAula(c.Request.Context(), usuarioAutenticado(c), trilhaID, moduloID, aulaID); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
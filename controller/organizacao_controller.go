@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"upskilling-api/model"
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OrganizacaoController agrupa os handlers HTTP da organização e suas equipes.
+type OrganizacaoController struct {
+	organizacaoService service.OrganizacaoService
+}
+
+// NewOrganizacaoController cria uma nova instância de OrganizacaoController.
+func NewOrganizacaoController(organizacaoService service.OrganizacaoService) *OrganizacaoController {
+	return &OrganizacaoController{
+		organizacaoService: organizacaoService,
+	}
+}
+
+// RegistrarOrganizacao godoc
+// @Summary Registra uma nova organização
+// @Description Cria uma organização (tenant) e seu primeiro usuário, com papel admin, em uma única operação. Apenas administradores da plataforma.
+// @Tags Organizacoes
+// @Accept json
+// @Produce json
+// @Param organizacao body model.CreateOrganizacaoRequest true "Dados da Organização e do administrador"
+// @Success 201 {object} model.RegistroOrganizacaoResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /organizacoes [post]
+func (ctrl *OrganizacaoController) RegistrarOrganizacao(c *gin.Context) {
+	var req model.CreateOrganizacaoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.organizacaoService.Registrar(c.Request.Context(), usuarioAutenticado(c), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetOrganizacaoAtual godoc
+// @Summary Retorna a organização do usuário autenticado
+// @Description Retorna os dados da organização (tenant) à qual o usuário autenticado pertence.
+// @Tags Organizacoes
+// @Produce json
+// @Success 200 {object} model.Organizacao
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /organizacao [get]
+func (ctrl *OrganizacaoController) GetOrganizacaoAtual(c *gin.Context) {
+	res, err := ctrl.organizacaoService.FindAtual(c.Request.Context(), usuarioAutenticado(c))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetEquipes godoc
+// @Summary Lista as equipes da organização
+// @Description Retorna as equipes da organização do usuário autenticado, em ordem alfabética.
+// @Tags Organizacoes
+// @Produce json
+// @Success 200 {array} model.Equipe
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /organizacao/equipes [get]
+func (ctrl *OrganizacaoController) GetEquipes(c *gin.Context) {
+	res, err := ctrl.organizacaoService.FindEquipes(c.Request.Context(), usuarioAutenticado(c))
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// CreateEquipe godoc
+// @Summary Cria uma equipe
+// @Description Cria uma equipe na organização do usuário autenticado. Apenas administradores.
+// @Tags Organizacoes
+// @Accept json
+// @Produce json
+// @Param equipe body model.EquipeRequest true "Dados da Equipe"
+// @Success 201 {object} model.Equipe
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /organizacao/equipes [post]
+func (ctrl *OrganizacaoController) CreateEquipe(c *gin.Context) {
+	var req model.EquipeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.organizacaoService.CreateEquipe(c.Request.Context(), usuarioAutenticado(c), &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// UpdateEquipe godoc
+// @Summary Renomeia uma equipe
+// @Description Altera o nome de uma equipe da organização. Apenas administradores.
+// @Tags Organizacoes
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Equipe"
+// @Param equipe body model.EquipeRequest true "Dados da Equipe"
+// @Success 200 {object} model.Equipe
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 409 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /organizacao/equipes/{id} [put]
+func (ctrl *OrganizacaoController) UpdateEquipe(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.EquipeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.organizacaoService.UpdateEquipe(c.Request.Context(), usuarioAutenticado(c), id, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteEquipe godoc
+// @Summary Remove uma equipe
+// @Description Remove uma equipe da organização; os membros ficam sem equipe. Apenas administradores.
+// @Tags Organizacoes
+// @Produce json
+// @Param id path int true "ID da Equipe"
+// @Success 204 "No Content"
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /organizacao/equipes/{id} [delete]
+func (ctrl *OrganizacaoController) DeleteEquipe(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	if err := ctrl.organizacaoService.DeleteEquipe(c.Request.Context(), usuarioAutenticado(c), id); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"upskilling-api/model"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindListParams lê limit, offset, cursor, sort e os filtros informados da query string.
+// Em caso de valor inválido, responde 400 e retorna false.
+func bindListParams(c *gin.Context, filtros ...string) (model.ListParams, bool) {
+	params := model.ListParams{
+		Cursor:  c.Query("cursor"),
+		Sort:    c.Query("sort"),
+		Filtros: make(map[string]string, len(filtros)),
+	}
+
+	for _, campo := range []string{"limit", "offset"} {
+		raw := c.Query(campo)
+		if raw == "" {
+			continue
+		}
+		valor, err := strconv.Atoi(raw)
+		if err != nil || valor < 0 {
+			handleError(c, &model.InvalidParameterError{Param: campo, Chave: "parametro.inteiro_nao_negativo"})
+			return params, false
+		}
+		if campo == "limit" {
+			params.Limit = valor
+		} else {
+			params.Offset = valor
+		}
+	}
+
+	for _, filtro := range filtros {
+		if valor := strings.TrimSpace(c.Query(filtro)); valor != "" {
+			params.Filtros[filtro] = valor
+		}
+	}
+	return params, true
+}
+
+// setPaginationHeaders publica os metadados da página: X-Total-Count, X-Next-Cursor e
+// o cabeçalho Link (RFC 8288) com as relações first, prev, next e last.
+func setPaginationHeaders(c *gin.Context, pagina *model.Pagina) {
+	if pagina == nil {
+		return
+	}
+	c.Header("X-Total-Count", strconv.Itoa(pagina.Total))
+
+	links := make([]string, 0, 4)
+	addLink := func(rel string, ajustar func(q url.Values)) {
+		u := *c.Request.URL
+		q := u.Query()
+		q.Set("limit", strconv.Itoa(pagina.Limit))
+		ajustar(q)
+		u.RawQuery = q.Encode()
+		links = append(links, fmt.Sprintf("<%s>; rel=\"%s\"", u.RequestURI(), rel))
+	}
+
+	// Paginação por cursor: apenas a próxima página é navegável
+	if c.Query("cursor") != "" {
+		if pagina.ProximoCursor != "" {
+			c.Header("X-Next-Cursor", pagina.ProximoCursor)
+			addLink("next", func(q url.Values) { q.Set("cursor", pagina.ProximoCursor) })
+		}
+		if len(links) > 0 {
+			c.Header("Link", strings.Join(links, ", "))
+		}
+		return
+	}
+
+	// Paginação por offset
+	if pagina.ProximoCursor != "" {
+		c.Header("X-Next-Cursor", pagina.ProximoCursor)
+	}
+	setOffset := func(offset int) func(q url.Values) {
+		return func(q url.Values) {
+			q.Del("cursor")
+			q.Set("offset", strconv.Itoa(offset))
+		}
+	}
+	ultimo := 0
+	if pagina.Total > 0 {
+		ultimo = ((pagina.Total - 1) / pagina.Limit) * pagina.Limit
+	}
+	addLink("first", setOffset(0))
+	if pagina.Offset > 0 {
+		anterior := pagina.Offset - pagina.Limit
+		if anterior < 0 {
+			anterior = 0
+		}
+		addLink("prev", setOffset(anterior))
+	}
+	if pagina.Offset+pagina.Limit < pagina.Total {
+		addLink("next", setOffset(pagina.Offset+pagina.Limit))
+	}
+	addLink("last", setOffset(ultimo))
+	c.Header("Link", strings.Join(links, ", "))
+}
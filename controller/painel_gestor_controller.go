@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PainelGestorController agrupa os handlers HTTP do painel do gestor.
+type PainelGestorController struct {
+	painelGestorService service.PainelGestorService
+}
+
+// NewPainelGestorController cria uma nova instância de PainelGestorController.
+func NewPainelGestorController(painelGestorService service.PainelGestorService) *PainelGestorController {
+	return &PainelGestorController{
+		painelGestorService: painelGestorService,
+	}
+}
+
+// GetPainelGestor godoc
+// @Summary Painel do gestor
+// @Description Retorna, para cada liderado direto do gestor, matrículas por status, atrasadas (ATIVAS com prazo vencido), taxa de conclusão, horas estudadas e progresso médio, além dos totais da equipe. Acessível ao próprio gestor e a administradores.
+// @Tags Gestores
+// @Produce json
+// @Param id path int true "ID do Gestor"
+// @Success 200 {object} model.PainelGestorResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /usuarios/{id}/liderados [get]
+func (ctrl *PainelGestorController) GetPainelGestor(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.painelGestorService.Resumo(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetMatriculasLiderados godoc
+// @Summary Lista as matrículas dos liderados
+// @Description Retorna uma página das matrículas dos liderados diretos do gestor, com trilha, progresso e indicação de atraso. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.
+// @Tags Gestores
+// @Produce json
+// @Param id path int true "ID do Gestor"
+// @Param limit query int false "Itens por página (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento (ignorado quando cursor é informado)"
+// @Param cursor query string false "Cursor da próxima página (X-Next-Cursor)"
+// @Param sort query string false "Ordenação: id, data_inscricao, horas_estudadas, nome (prefixo '-' para decrescente)"
+// @Param status query string false "Filtra por status (ATIVA, CONCLUIDA, CANCELADA)"
+// @Param usuario_id query int false "Filtra por liderado"
+// @Param trilha_id query int false "Filtra por trilha"
+// @Param atrasada query bool false "Filtra matrículas atrasadas (true) ou em dia (false)"
+// @Success 200 {array} model.MatriculaLiderado
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /usuarios/{id}/liderados/matriculas [get]
+func (ctrl *PainelGestorController) GetMatriculasLiderados(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	params, ok := bindListParams(c, "status", "usuario_id", "trilha_id", "atrasada")
+	if !ok {
+		return
+	}
+
+	res, pagina, err := ctrl.painelGestorService.GetMatriculas(c.Request.Context(), usuarioAutenticado(c), id, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	setPaginationHeaders(c, pagina)
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"upskilling-api/model"
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RecomendacaoController agrupa os handlers HTTP de recomendações de trilhas.
+type RecomendacaoController struct {
+	recomendacaoService service.RecomendacaoService
+}
+
+// NewRecomendacaoController cria uma nova instância de RecomendacaoController.
+func NewRecomendacaoController(recomendacaoService service.RecomendacaoService) *RecomendacaoController {
+	return &RecomendacaoController{
+		recomendacaoService: recomendacaoService,
+	}
+}
+
+// GetRecomendacoes godoc
+// @Summary Recomenda trilhas para o usuário
+// @Description Retorna as trilhas recomendadas ao usuário, da maior para a menor pontuação. A pontuação combina as competências da trilha que o usuário ainda não possui, a adequação do nível da trilha ao nível de carreira, as matrículas de colegas da mesma área de atuação, a co-inscrição (usuários que fizeram as mesmas trilhas) e a popularidade na organização. Trilhas em que o usuário já se matriculou ficam de fora; cada recomendação traz os motivos e os sinais usados. Acessível ao próprio usuário, ao gestor direto e a administradores. Metadados de paginação nos cabeçalhos X-Total-Count e Link.
+// @Tags Usuarios
+// @Produce json
+// @Param id path int true "ID do Usuário"
+// @Param limit query int false "Itens por página (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento"
+// @Success 200 {array} model.RecomendacaoTrilha
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /usuarios/{id}/recomendacoes [get]
+func (ctrl *RecomendacaoController) GetRecomendacoes(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	params, ok := bindListParams(c)
+	if !ok {
+		return
+	}
+
+	res, pagina, err := ctrl.recomendacaoService.Recomendar(c.Request.Context(), usuarioAutenticado(c), id, params)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	idioma := idiomaRequisicao(c)
+	for i := range res {
+		res[i].Motivos = model.TextosMotivos(idioma, res[i].Razoes)
+	}
+
+	setPaginationHeaders(c, pagina)
+	c.JSON(http.StatusOK, res)
+}
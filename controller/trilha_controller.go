@@ -10,27 +10,40 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-var trilhaService = service.NewTrilhaService()
+// TrilhaController agrupa os handlers HTTP de trilhas.
+type TrilhaController struct {
+	trilhaService service.TrilhaService
+}
+
+// NewTrilhaController cria uma nova instância de TrilhaController.
+func NewTrilhaController(trilhaService service.TrilhaService) *TrilhaController {
+	return &TrilhaController{
+		trilhaService: trilhaService,
+	}
+}
 
 // CreateTrilha godoc
 // @Summary Cria uma nova trilha de aprendizagem
-// @Description Cria uma nova trilha de upskilling/reskilling.
+// @Description Cria uma nova trilha de upskilling/reskilling, privada da organização do usuário. Com publica=true, a trilha entra no catálogo público (apenas curadores da plataforma).
 // @Tags Trilhas
 // @Accept json
 // @Produce json
 // @Param trilha body model.CreateTrilhaRequest true "Dados da Trilha"
 // @Success 201 {object} model.TrilhaResponse
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /trilhas [post]
-func CreateTrilha(c *gin.Context) {
+func (ctrl *TrilhaController) CreateTrilha(c *gin.Context) {
 	var req model.CreateTrilhaRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "Dados de entrada inválidos.", Details: err.Error()})
+		handleError(c, erroValidacao(c, err))
 		return
 	}
 
-	res, err := trilhaService.Create(&req)
+	res, err := ctrl.trilhaService.Create(c.Request.Context(), usuarioAutenticado(c), &req)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -41,19 +54,35 @@ func CreateTrilha(c *gin.Context) {
 
 // GetAllTrilhas godoc
 // @Summary Lista todas as trilhas de aprendizagem
-// @Description Retorna uma lista de todas as trilhas cadastradas.
+// @Description Retorna uma página das trilhas visíveis para a organização (catálogo público e trilhas privadas). Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.
 // @Tags Trilhas
 // @Produce json
+// @Param incluir query string false "Use 'competencias' para incluir as competências de cada trilha"
+// @Param limit query int false "Itens por página (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento (ignorado quando cursor é informado)"
+// @Param cursor query string false "Cursor da próxima página (X-Next-Cursor)"
+// @Param sort query string false "Ordenação: id, nome, nivel, carga_horaria (prefixo '-' para decrescente)"
+// @Param nivel query string false "Filtra por nível (INICIANTE, INTERMEDIARIO, AVANCADO)"
+// @Param foco_principal query string false "Filtra por foco principal"
 // @Success 200 {array} model.TrilhaResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /trilhas [get]
-func GetAllTrilhas(c *gin.Context) {
-	res, err := trilhaService.FindAll()
+func (ctrl *TrilhaController) GetAllTrilhas(c *gin.Context) {
+	params, ok := bindListParams(c, "nivel", "foco_principal")
+	if !ok {
+		return
+	}
+
+	res, pagina, err := ctrl.trilhaService.FindAll(c.Request.Context(), usuarioAutenticado(c), params, incluirCompetencias(c))
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 
+	setPaginationHeaders(c, pagina)
 	c.JSON(http.StatusOK, res)
 }
 
@@ -63,18 +92,21 @@ func GetAllTrilhas(c *gin.Context) {
 // @Tags Trilhas
 // @Produce json
 // @Param id path int true "ID da Trilha"
+// @Param incluir query string false "Use 'competencias' para incluir as competências da trilha"
 // @Success 200 {object} model.TrilhaResponse
+// @Failure 401 {object} model.ErrorResponse
 // @Failure 404 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /trilhas/{id} [get]
-func GetTrilhaByID(c *gin.Context) {
+func (ctrl *TrilhaController) GetTrilhaByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "ID inválido.", Details: "O ID deve ser um número inteiro."})
+		handleError(c, idInvalido("id"))
 		return
 	}
 
-	res, err := trilhaService.FindByID(id)
+	res, err := ctrl.trilhaService.FindByID(c.Request.Context(), usuarioAutenticado(c), id, incluirCompetencias(c))
 	if err != nil {
 		handleError(c, err)
 		return
@@ -93,23 +125,26 @@ func GetTrilhaByID(c *gin.Context) {
 // @Param trilha body model.UpdateTrilhaRequest true "Dados da Trilha para atualização"
 // @Success 200 {object} model.TrilhaResponse
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 404 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /trilhas/{id} [put]
-func UpdateTrilha(c *gin.Context) {
+func (ctrl *TrilhaController) UpdateTrilha(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "ID inválido.", Details: "O ID deve ser um número inteiro."})
+		handleError(c, idInvalido("id"))
 		return
 	}
 
 	var req model.UpdateTrilhaRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "Dados de entrada inválidos.", Details: err.Error()})
+		handleError(c, erroValidacao(c, err))
 		return
 	}
 
-	res, err := trilhaService.Update(id, &req)
+	res, err := ctrl.trilhaService.Update(c.Request.Context(), usuarioAutenticado(c), id, &req)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -125,21 +160,235 @@ func UpdateTrilha(c *gin.Context) {
 // @Produce json
 // @Param id path int true "ID da Trilha"
 // @Success 204 "No Content"
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 404 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /trilhas/{id} [delete]
-func DeleteTrilha(c *gin.Context) {
+func (ctrl *TrilhaController) DeleteTrilha(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	err = ctrl.trilhaService.Delete(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetCompetenciasByTrilha godoc
+// @Summary Lista as competências de uma trilha
+// @Description Retorna as competências desenvolvidas por uma trilha.
+// @Tags Trilhas
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Success 200 {array} model.CompetenciaResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/competencias [get]
+func (ctrl *TrilhaController) GetCompetenciasByTrilha(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.trilhaService.GetCompetencias(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// SetCompetenciasTrilha godoc
+// @Summary Define as competências de uma trilha
+// @Description Substitui todas as competências associadas a uma trilha pela lista informada.
+// @Tags Trilhas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param competencias body model.SetTrilhaCompetenciasRequest true "IDs das Competências"
+// @Success 200 {array} model.CompetenciaResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/competencias [put]
+func (ctrl *TrilhaController) SetCompetenciasTrilha(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.SetTrilhaCompetenciasRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.trilhaService.SetCompetencias(c.Request.Context(), usuarioAutenticado(c), id, req.CompetenciaIDs)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// AddCompetenciaTrilha godoc
+// @Summary Associa uma competência a uma trilha
+// @Description Adiciona uma competência ao conjunto de competências desenvolvidas pela trilha.
+// @Tags Trilhas
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param competenciaId path int true "ID da Competência"
+// @Success 204 "No Content"
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/competencias/{competenciaId} [post]
+func (ctrl *TrilhaController) AddCompetenciaTrilha(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "ID inválido.", Details: "O ID deve ser um número inteiro."})
+		handleError(c, idInvalido("id"))
 		return
 	}
+	competenciaID, err := strconv.ParseInt(c.Param("competenciaId"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("competenciaId"))
+		return
+	}
+
+	if err := ctrl.trilhaService.AddCompetencia(c.Request.Context(), usuarioAutenticado(c), id, competenciaID); err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
 
-	err = trilhaService.Delete(id)
+// RemoveCompetenciaTrilha godoc
+// @Summary Remove uma competência de uma trilha
+// @Description Desfaz a associação entre a trilha e a competência.
+// @Tags Trilhas
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param competenciaId path int true "ID da Competência"
+// @Success 204 "No Content"
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/competencias/{competenciaId} [delete]
+func (ctrl *TrilhaController) RemoveCompetenciaTrilha(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+	competenciaID, err := strconv.ParseInt(c.Param("competenciaId"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("competenciaId"))
+		return
+	}
+
+	if err := ctrl.trilhaService.RemoveCompetencia(c.Request.Context(), usuarioAutenticado(c), id, competenciaID); err != nil {
 		handleError(c, err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
+
+// GetRequisitosTrilha godoc
+// @Summary Lista os requisitos de uma trilha
+// @Description Retorna o nível de carreira mínimo, as trilhas pré-requisito e as competências requeridas para a inscrição.
+// @Tags Trilhas
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Success 200 {object} model.RequisitosResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/requisitos [get]
+func (ctrl *TrilhaController) GetRequisitosTrilha(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.trilhaService.GetRequisitos(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// SetRequisitosTrilha godoc
+// @Summary Define os requisitos de uma trilha
+// @Description Substitui o nível de carreira mínimo, as trilhas pré-requisito e as competências requeridas da trilha.
+// @Tags Trilhas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da Trilha"
+// @Param requisitos body model.SetRequisitosRequest true "Requisitos da Trilha"
+// @Success 200 {object} model.RequisitosResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /trilhas/{id}/requisitos [put]
+func (ctrl *TrilhaController) SetRequisitosTrilha(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.SetRequisitosRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.trilhaService.SetRequisitos(c.Request.Context(), usuarioAutenticado(c), id, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// incluirCompetencias indica se a requisição pediu as competências embutidas na resposta.
+func incluirCompetencias(c *gin.Context) bool {
+	return c.Query("incluir") == "competencias"
+}
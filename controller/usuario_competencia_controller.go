@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"upskilling-api/model"
+	"upskilling-api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UsuarioCompetenciaController agrupa os handlers HTTP do perfil de competências dos usuários.
+type UsuarioCompetenciaController struct {
+	usuarioCompetenciaService service.UsuarioCompetenciaService
+}
+
+// NewUsuarioCompetenciaController cria uma nova instância de UsuarioCompetenciaController.
+func NewUsuarioCompetenciaController(usuarioCompetenciaService service.UsuarioCompetenciaService) *UsuarioCompetenciaController {
+	return &UsuarioCompetenciaController{
+		usuarioCompetenciaService: usuarioCompetenciaService,
+	}
+}
+
+// GetCompetenciasUsuario godoc
+// @Summary Perfil de competências do usuário
+// @Description Lista as competências do usuário com o nível de proficiência efetivo (1 a 5) e as avaliações de cada origem: AUTOAVALIACAO, TRILHA (concedida ao concluir uma trilha) e GESTOR (validação). A validação do gestor prevalece sobre a trilha, que prevalece sobre a autoavaliação. Acessível ao próprio usuário, ao gestor direto e a administradores.
+// @Tags Usuarios
+// @Produce json
+// @Param id path int true "ID do Usuário"
+// @Success 200 {array} model.CompetenciaUsuario
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /usuarios/{id}/competencias [get]
+func (ctrl *UsuarioCompetenciaController) GetCompetenciasUsuario(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	res, err := ctrl.usuarioCompetenciaService.FindByUsuario(c.Request.Context(), usuarioAutenticado(c), id)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// SetCompetenciasUsuario godoc
+// @Summary Avalia as competências do usuário
+// @Description Substitui as avaliações de competências feitas por quem envia a requisição: o próprio usuário registra sua autoavaliação; o gestor direto ou um administrador registra a validação (origem GESTOR). Competências concedidas por conclusão de trilha não são alteradas. Uma lista vazia remove as avaliações da origem.
+// @Tags Usuarios
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do Usuário"
+// @Param competencias body model.SetCompetenciasUsuarioRequest true "Níveis de proficiência por competência"
+// @Success 200 {array} model.CompetenciaUsuario
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /usuarios/{id}/competencias [put]
+func (ctrl *UsuarioCompetenciaController) SetCompetenciasUsuario(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.SetCompetenciasUsuarioRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.usuarioCompetenciaService.SetCompetencias(c.Request.Context(), usuarioAutenticado(c), id, &req)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -10,28 +10,42 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-var usuarioService = service.NewUsuarioService()
+// UsuarioController agrupa os handlers HTTP de usuários.
+type UsuarioController struct {
+	usuarioService service.UsuarioService
+}
+
+// NewUsuarioController cria uma nova instância de UsuarioController.
+func NewUsuarioController(usuarioService service.UsuarioService) *UsuarioController {
+	return &UsuarioController{
+		usuarioService: usuarioService,
+	}
+}
 
 // CreateUsuario godoc
 // @Summary Cria um novo usuário
-// @Description Cria um novo usuário na plataforma de upskilling/reskilling.
+// @Description Cadastra um usuário na organização do administrador autenticado, opcionalmente em uma equipe.
 // @Tags Usuarios
 // @Accept json
 // @Produce json
 // @Param usuario body model.CreateUsuarioRequest true "Dados do Usuário"
 // @Success 201 {object} model.UsuarioResponse
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 409 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /usuarios [post]
-func CreateUsuario(c *gin.Context) {
+func (ctrl *UsuarioController) CreateUsuario(c *gin.Context) {
 	var req model.CreateUsuarioRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "Dados de entrada inválidos.", Details: err.Error()})
+		handleError(c, erroValidacao(c, err))
 		return
 	}
 
-	res, err := usuarioService.Create(&req)
+	res, err := ctrl.usuarioService.Create(c.Request.Context(), usuarioAutenticado(c), &req)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -42,19 +56,38 @@ func CreateUsuario(c *gin.Context) {
 
 // GetAllUsuarios godoc
 // @Summary Lista todos os usuários
-// @Description Retorna uma lista de todos os usuários cadastrados.
+// @Description Retorna uma página dos usuários da organização. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.
 // @Tags Usuarios
 // @Produce json
+// @Param limit query int false "Itens por página (padrão 20, máximo 100)"
+// @Param offset query int false "Deslocamento (ignorado quando cursor é informado)"
+// @Param cursor query string false "Cursor da próxima página (X-Next-Cursor)"
+// @Param sort query string false "Ordenação: id, nome, email, data_cadastro (prefixo '-' para decrescente)"
+// @Param area_atuacao query string false "Filtra por área de atuação"
+// @Param nivel_carreira query string false "Filtra por nível de carreira"
+// @Param papel query string false "Filtra por papel (learner, manager, curator, admin)"
+// @Param equipe_id query int false "Filtra por equipe"
+// @Param gestor_id query int false "Filtra por gestor direto"
 // @Success 200 {array} model.UsuarioResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /usuarios [get]
-func GetAllUsuarios(c *gin.Context) {
-	res, err := usuarioService.FindAll()
+func (ctrl *UsuarioController) GetAllUsuarios(c *gin.Context) {
+	params, ok := bindListParams(c, "area_atuacao", "nivel_carreira", "papel", "equipe_id", "gestor_id")
+	if !ok {
+		return
+	}
+
+	res, pagina, err := ctrl.usuarioService.FindAll(c.Request.Context(), usuarioAutenticado(c), params)
 	if err != nil {
 		handleError(c, err)
 		return
 	}
 
+	setPaginationHeaders(c, pagina)
 	c.JSON(http.StatusOK, res)
 }
 
@@ -65,17 +98,20 @@ func GetAllUsuarios(c *gin.Context) {
 // @Produce json
 // @Param id path int true "ID do Usuário"
 // @Success 200 {object} model.UsuarioResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 404 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /usuarios/{id} [get]
-func GetUsuarioByID(c *gin.Context) {
+func (ctrl *UsuarioController) GetUsuarioByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "ID inválido.", Details: "O ID deve ser um número inteiro."})
+		handleError(c, idInvalido("id"))
 		return
 	}
 
-	res, err := usuarioService.FindByID(id)
+	res, err := ctrl.usuarioService.FindByID(c.Request.Context(), usuarioAutenticado(c), id)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -86,7 +122,7 @@ func GetUsuarioByID(c *gin.Context) {
 
 // UpdateUsuario godoc
 // @Summary Atualiza um usuário
-// @Description Atualiza os dados de um usuário existente.
+// @Description Atualiza os dados de um usuário existente. Apenas administradores alteram a equipe e o gestor. Para trocar a própria senha, informe também a senha atual em senha_atual. O nível de carreira só é alterado pelo gestor direto ou por administradores.
 // @Tags Usuarios
 // @Accept json
 // @Produce json
@@ -94,23 +130,27 @@ func GetUsuarioByID(c *gin.Context) {
 // @Param usuario body model.UpdateUsuarioRequest true "Dados do Usuário para atualização"
 // @Success 200 {object} model.UsuarioResponse
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 404 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /usuarios/{id} [put]
-func UpdateUsuario(c *gin.Context) {
+func (ctrl *UsuarioController) UpdateUsuario(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "ID inválido.", Details: "O ID deve ser um número inteiro."})
+		handleError(c, idInvalido("id"))
 		return
 	}
 
 	var req model.UpdateUsuarioRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "Dados de entrada inválidos.", Details: err.Error()})
+		handleError(c, erroValidacao(c, err))
 		return
 	}
 
-	res, err := usuarioService.Update(id, &req)
+	res, err := ctrl.usuarioService.Update(c.Request.Context(), usuarioAutenticado(c), id, &req)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -121,22 +161,25 @@ func UpdateUsuario(c *gin.Context) {
 
 // DeleteUsuario godoc
 // @Summary Deleta um usuário
-// @Description Remove um usuário da plataforma.
+// @Description Remove um usuário da organização.
 // @Tags Usuarios
 // @Produce json
 // @Param id path int true "ID do Usuário"
 // @Success 204 "No Content"
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
 // @Failure 404 {object} model.ErrorResponse
 // @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
 // @Router /usuarios/{id} [delete]
-func DeleteUsuario(c *gin.Context) {
+func (ctrl *UsuarioController) DeleteUsuario(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, model.ErrorResponse{Message: "ID inválido.", Details: "O ID deve ser um número inteiro."})
+		handleError(c, idInvalido("id"))
 		return
 	}
 
-	err = usuarioService.Delete(id)
+	err = ctrl.usuarioService.Delete(c.Request.Context(), usuarioAutenticado(c), id)
 	if err != nil {
 		handleError(c, err)
 		return
@@ -144,3 +187,42 @@ func DeleteUsuario(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// SetPapelUsuario godoc
+// @Summary Altera o papel de um usuário
+// @Description Define o papel (learner, manager, curator ou admin) de um usuário. Apenas administradores.
+// @Tags Usuarios
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do Usuário"
+// @Param papel body model.SetPapelRequest true "Novo papel"
+// @Success 200 {object} model.UsuarioResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 403 {object} model.ErrorResponse
+// @Failure 404 {object} model.ErrorResponse
+// @Failure 422 {object} model.ErrorResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Security BearerAuth
+// @Router /usuarios/{id}/papel [put]
+func (ctrl *UsuarioController) SetPapelUsuario(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido("id"))
+		return
+	}
+
+	var req model.SetPapelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleError(c, erroValidacao(c, err))
+		return
+	}
+
+	res, err := ctrl.usuarioService.SetPapel(c.Request.Context(), usuarioAutenticado(c), id, req.Papel)
+	if err != nil {
+		handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,280 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"upskilling-api/i18n"
+	"upskilling-api/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en_US"
+	"github.com/go-playground/locales/pt_BR"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// textosValidacao traz as mensagens de validação de um idioma: mensagens por chave (com
+// parâmetros {0}, {1}...) e unidades contáveis no singular e no plural.
+type textosValidacao struct {
+	mensagens map[string]string
+	unidades  map[string][2]string
+}
+
+// catalogoValidacao traz os textos dos erros de binding, por idioma.
+var catalogoValidacao = map[string]textosValidacao{
+	i18n.PortuguesBrasil: {
+		mensagens: map[string]string{
+			"required":        "é obrigatório",
+			"email":           "deve ser um email válido",
+			"url":             "deve ser uma URL válida",
+			"oneof":           "deve ser um dos valores: {0}",
+			"min_quantidade":  "deve ter no mínimo {0}",
+			"max_quantidade":  "deve ter no máximo {0}",
+			"min_valor":       "deve ser no mínimo {0}",
+			"max_valor":       "deve ser no máximo {0}",
+			"gt":              "deve ser maior que {0}",
+			"gte":             "deve ser maior ou igual a {0}",
+			"lt":              "deve ser menor que {0}",
+			"lte":             "deve ser menor ou igual a {0}",
+			"regra":           "não atende à regra '{0}'",
+			"tipo":            "deve ser do tipo {0}",
+			"corpo_tipo":      "O corpo da requisição deve ser do tipo {0}.",
+			"json_malformado": "JSON malformado na posição {0}.",
+			"json_incompleto": "JSON incompleto.",
+			"corpo_vazio":     "O corpo da requisição está vazio.",
+			"tipo_texto":      "texto",
+			"tipo_booleano":   "booleano",
+			"tipo_lista":      "lista",
+			"tipo_objeto":     "objeto",
+			"tipo_inteiro":    "número inteiro",
+			"tipo_numero":     "número",
+		},
+		unidades: map[string][2]string{
+			"caracteres": {"{0} caractere", "{0} caracteres"},
+			"itens":      {"{0} item", "{0} itens"},
+		},
+	},
+	i18n.InglesEUA: {
+		mensagens: map[string]string{
+			"required":        "is required",
+			"email":           "must be a valid email address",
+			"url":             "must be a valid URL",
+			"oneof":           "must be one of: {0}",
+			"min_quantidade":  "must have at least {0}",
+			"max_quantidade":  "must have at most {0}",
+			"min_valor":       "must be at least {0}",
+			"max_valor":       "must be at most {0}",
+			"gt":              "must be greater than {0}",
+			"gte":             "must be greater than or equal to {0}",
+			"lt":              "must be less than {0}",
+			"lte":             "must be less than or equal to {0}",
+			"regra":           "does not satisfy the '{0}' rule",
+			"tipo":            "must be of type {0}",
+			"corpo_tipo":      "The request body must be of type {0}.",
+			"json_malformado": "Malformed JSON at offset {0}.",
+			"json_incompleto": "Incomplete JSON.",
+			"corpo_vazio":     "The request body is empty.",
+			"tipo_texto":      "string",
+			"tipo_booleano":   "boolean",
+			"tipo_lista":      "array",
+			"tipo_objeto":     "object",
+			"tipo_inteiro":    "integer",
+			"tipo_numero":     "number",
+		},
+		unidades: map[string][2]string{
+			"caracteres": {"{0} character", "{0} characters"},
+			"itens":      {"{0} item", "{0} items"},
+		},
+	},
+}
+
+// tagsTraduzidas são as regras de binding usadas pelos DTOs; as demais recebem a
+// mensagem genérica "regra".
+var tagsTraduzidas = []string{"required", "email", "url", "oneof", "min", "max", "gt", "gte", "lt", "lte"}
+
+// tradutores resolve o tradutor de cada idioma (locale pt_BR ou en_US); pt_BR é o reserva.
+var tradutores = ut.New(pt_BR.New(), pt_BR.New(), en_US.New())
+
+func init() {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	// Os erros de validação citam os campos pelo nome no JSON (ex: "nivel"), não pelo
+	// nome do campo na struct (ex: "Nivel").
+	v.RegisterTagNameFunc(nomeJSON)
+
+	for idioma, textos := range catalogoValidacao {
+		trans := tradutor(idioma)
+		for chave, texto := range textos.mensagens {
+			if err := trans.Add(chave, texto, false); err != nil {
+				panic(err)
+			}
+		}
+		for chave, formas := range textos.unidades {
+			if err := trans.AddCardinal(chave, formas[0], locales.PluralRuleOne, false); err != nil {
+				panic(err)
+			}
+			if err := trans.AddCardinal(chave, formas[1], locales.PluralRuleOther, false); err != nil {
+				panic(err)
+			}
+		}
+		for _, tag := range tagsTraduzidas {
+			registrar := func(ut.Translator) error { return nil } // textos já adicionados acima
+			if err := v.RegisterTranslation(tag, trans, registrar, traduzirRegra); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// tradutor retorna o tradutor do idioma (ex: "pt-BR" → locale pt_BR).
+func tradutor(idioma string) ut.Translator {
+	trans, _ := tradutores.GetTranslator(strings.ReplaceAll(idioma, "-", "_"))
+	return trans
+}
+
+// traduzir resolve a chave no tradutor; se a chave não existir, retorna a própria chave.
+func traduzir(trans ut.Translator, chave string, params ...string) string {
+	texto, err := trans.T(chave, params...)
+	if err != nil {
+		return chave
+	}
+	return texto
+}
+
+// nomeJSON retorna o nome do campo na tag json, ou o nome Go se a tag não existir.
+func nomeJSON(campo reflect.StructField) string {
+	nome, _, _ := strings.Cut(campo.Tag.Get("json"), ",")
+	switch nome {
+	case "-":
+		return ""
+	case "":
+		return campo.Name
+	}
+	return nome
+}
+
+// erroValidacao converte o erro de ShouldBindJSON em um model.ValidationError, com um
+// motivo legível para cada campo rejeitado, no idioma da requisição.
+func erroValidacao(c *gin.Context, err error) error {
+	trans := tradutor(idiomaRequisicao(c))
+
+	var validacao validator.ValidationErrors
+	var tipo *json.UnmarshalTypeError
+	var sintaxe *json.SyntaxError
+
+	switch {
+	case errors.As(err, &validacao):
+		campos := make([]model.CampoInvalido, len(validacao))
+		for i, fe := range validacao {
+			campos[i] = model.CampoInvalido{Campo: caminhoCampo(fe), Motivo: motivoValidacao(trans, fe)}
+		}
+		return &model.ValidationError{Campos: campos}
+	case errors.As(err, &tipo):
+		nomeTipo := traduzir(trans, tipoJSON(tipo.Type))
+		if tipo.Field == "" {
+			return &model.ValidationError{Msg: traduzir(trans, "corpo_tipo", nomeTipo)}
+		}
+		return &model.ValidationError{Campos: []model.CampoInvalido{{
+			Campo:  tipo.Field,
+			Motivo: traduzir(trans, "tipo", nomeTipo),
+		}}}
+	case errors.As(err, &sintaxe):
+		return &model.ValidationError{Msg: traduzir(trans, "json_malformado", strconv.FormatInt(sintaxe.Offset, 10))}
+	case errors.Is(err, io.EOF):
+		return &model.ValidationError{Msg: traduzir(trans, "corpo_vazio")}
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return &model.ValidationError{Msg: traduzir(trans, "json_incompleto")}
+	}
+	return &model.ValidationError{Msg: err.Error()}
+}
+
+// caminhoCampo retorna o caminho do campo sem a struct raiz (ex: "competencias[0].nivel").
+func caminhoCampo(fe validator.FieldError) string {
+	if _, caminho, ok := strings.Cut(fe.Namespace(), "."); ok {
+		return caminho
+	}
+	return fe.Field()
+}
+
+// motivoValidacao descreve a regra de binding que o campo não atendeu.
+func motivoValidacao(trans ut.Translator, fe validator.FieldError) string {
+	if !slices.Contains(tagsTraduzidas, fe.Tag()) {
+		return traduzir(trans, "regra", fe.Tag())
+	}
+	return fe.Translate(trans)
+}
+
+// traduzirRegra é a tradução registrada no validator para as tagsTraduzidas. min e max
+// descrevem o tamanho de textos e listas ou o valor de números.
+func traduzirRegra(trans ut.Translator, fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "oneof":
+		return traduzir(trans, "oneof", strings.Join(strings.Fields(fe.Param()), ", "))
+	case "min", "max":
+		unidade := ""
+		switch fe.Kind() {
+		case reflect.String:
+			unidade = "caracteres"
+		case reflect.Slice, reflect.Array, reflect.Map:
+			unidade = "itens"
+		default:
+			return traduzir(trans, fe.Tag()+"_valor", fe.Param())
+		}
+		n, err := strconv.ParseFloat(fe.Param(), 64)
+		if err != nil {
+			return traduzir(trans, fe.Tag()+"_valor", fe.Param())
+		}
+		quantidade, err := trans.C(unidade, n, 0, fe.Param())
+		if err != nil {
+			quantidade = fe.Param()
+		}
+		return traduzir(trans, fe.Tag()+"_quantidade", quantidade)
+	}
+	return traduzir(trans, fe.Tag(), fe.Param())
+}
+
+// tipoJSON retorna a chave do nome do tipo JSON esperado para um tipo Go.
+func tipoJSON(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "tipo_texto"
+	case reflect.Bool:
+		return "tipo_booleano"
+	case reflect.Slice, reflect.Array:
+		return "tipo_lista"
+	case reflect.Struct, reflect.Map:
+		return "tipo_objeto"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "tipo_inteiro"
+	case reflect.Float32, reflect.Float64:
+		return "tipo_numero"
+	}
+	return t.String()
+}
+
+// idInvalido monta o erro de um parâmetro de rota que não é um ID numérico.
+func idInvalido(param string) error {
+	return &model.InvalidParameterError{Param: param, Chave: "parametro.inteiro"}
+}
+
+// parsePathID lê um parâmetro numérico da rota; em caso de erro, registra um
+// InvalidParameterError (400) e retorna false.
+func parsePathID(c *gin.Context, param string) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param(param), 10, 64)
+	if err != nil {
+		handleError(c, idInvalido(param))
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,260 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// AulaDAO é a interface para as operações de acesso a dados de Aula.
+type AulaDAO interface {
+	Create(ctx context.Context, aula *model.Aula) error
+	FindByID(ctx context.Context, id int64) (*model.Aula, error)
+	FindByModuloIDs(ctx context.Context, moduloIDs []int64) (map[int64][]model.Aula, error)
+	FindTrilhaID(ctx context.Context, aulaID int64) (int64, error)
+	Update(ctx context.Context, aula *model.Aula) error
+	Delete(ctx context.Context, id int64) error
+	Concluir(ctx context.Context, matriculaID int64, aula *model.Aula, cargaHoraria int) (*model.Matricula, error)
+	CountByTrilhaID(ctx context.Context, trilhaID int64) (int, error)
+	CountConcluidas(ctx context.Context, matriculaID int64) (int, error)
+}
+
+// aulaDAOImpl implementa a interface AulaDAO.
+type aulaDAOImpl struct {
+	*Conexao
+}
+
+// NewAulaDAO cria uma nova instância de AulaDAO.
+func NewAulaDAO(conexao *Conexao) AulaDAO {
+	return &aulaDAOImpl{Conexao: conexao}
+}
+
+// Create insere uma nova aula. Sem ordem informada, a aula é posicionada após a última do módulo.
+func (d *aulaDAOImpl) Create(ctx context.Context, aula *model.Aula) error {
+	query := `
+		INSERT INTO aulas (modulo_id, titulo, tipo, ordem, duracao_minutos, url)
+		VALUES ($1, $2, $3, CASE WHEN $4 > 0 THEN $4 ELSE
+			(SELECT COALESCE(MAX(ordem), 0) + 1 FROM aulas WHERE modulo_id = $1) END, $5, $6)
+		RETURNING id, ordem
+	`
+	err := d.querier(ctx).QueryRowContext(ctx,
+		query,
+		aula.ModuloID,
+		aula.Titulo,
+		aula.Tipo,
+		aula.Ordem,
+		aula.DuracaoMinutos,
+		aula.URL,
+	).Scan(&aula.ID, &aula.Ordem)
+
+	if err != nil {
+		return traduzirErro(err, "criar aula")
+	}
+	return nil
+}
+
+// FindByID busca uma aula pelo ID.
+func (d *aulaDAOImpl) FindByID(ctx context.Context, id int64) (*model.Aula, error) {
+	aula := &model.Aula{}
+	query := `
+		SELECT id, modulo_id, titulo, tipo, ordem, duracao_minutos, COALESCE(url, '')
+		FROM aulas
+		WHERE id = $1
+	`
+	err := d.querier(ctx).QueryRowContext(ctx, query, id).Scan(
+		&aula.ID,
+		&aula.ModuloID,
+		&aula.Titulo,
+		&aula.Tipo,
+		&aula.Ordem,
+		&aula.DuracaoMinutos,
+		&aula.URL,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &model.ResourceNotFoundError{Resource: "Aula", ID: id}
+		}
+		return nil, traduzirErro(err, "buscar aula por ID")
+	}
+	return aula, nil
+}
+
+// FindByModuloIDs busca, em uma única consulta, as aulas de vários módulos agrupadas
+// pelo ID do módulo e ordenadas.
+func (d *aulaDAOImpl) FindByModuloIDs(ctx context.Context, moduloIDs []int64) (map[int64][]model.Aula, error) {
+	result := make(map[int64][]model.Aula)
+	if len(moduloIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT id, modulo_id, titulo, tipo, ordem, duracao_minutos, COALESCE(url, '')
+		FROM aulas
+		WHERE modulo_id = ANY($1)
+		ORDER BY modulo_id, ordem, id
+	`, pq.Array(moduloIDs))
+	if err != nil {
+		return nil, traduzirErro(err, "buscar aulas dos módulos")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		aula := model.Aula{}
+		err := rows.Scan(
+			&aula.ID,
+			&aula.ModuloID,
+			&aula.Titulo,
+			&aula.Tipo,
+			&aula.Ordem,
+			&aula.DuracaoMinutos,
+			&aula.URL,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de aula")
+		}
+		result[aula.ModuloID] = append(result[aula.ModuloID], aula)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar aulas dos módulos")
+	}
+
+	return result, nil
+}
+
+// FindTrilhaID retorna o ID da trilha à qual a aula pertence.
+func (d *aulaDAOImpl) FindTrilhaID(ctx context.Context, aulaID int64) (int64, error) {
+	var trilhaID int64
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		SELECT m.trilha_id
+		FROM aulas a
+		JOIN modulos m ON m.id = a.modulo_id
+		WHERE a.id = $1
+	`, aulaID).Scan(&trilhaID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, &model.ResourceNotFoundError{Resource: "Aula", ID: aulaID}
+		}
+		return 0, traduzirErro(err, "buscar trilha da aula")
+	}
+	return trilhaID, nil
+}
+
+// Update atualiza uma aula existente.
+func (d *aulaDAOImpl) Update(ctx context.Context, aula *model.Aula) error {
+	query := `
+		UPDATE aulas
+		SET titulo = $2, tipo = $3, ordem = $4, duracao_minutos = $5, url = $6
+		WHERE id = $1
+	`
+	result, err := d.querier(ctx).ExecContext(ctx,
+		query,
+		aula.ID,
+		aula.Titulo,
+		aula.Tipo,
+		aula.Ordem,
+		aula.DuracaoMinutos,
+		aula.URL,
+	)
+	if err != nil {
+		return traduzirErro(err, "atualizar aula")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Aula", ID: aula.ID}
+	}
+
+	return nil
+}
+
+// Delete remove uma aula pelo ID.
+func (d *aulaDAOImpl) Delete(ctx context.Context, id int64) error {
+	result, err := d.querier(ctx).ExecContext(ctx, "DELETE FROM aulas WHERE id = $1", id)
+	if err != nil {
+		return traduzirErro(err, "deletar aula")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Aula", ID: id}
+	}
+
+	return nil
+}
+
+// Concluir marca a aula como concluída na matrícula e converte sua duração em horas de
+// estudo. Concluir a mesma aula duas vezes gera ConflictError. Deve ser chamado dentro
+// de uma unidade de trabalho (service.WithTx), para que a conclusão e o progresso sejam
+// gravados juntos.
+func (d *aulaDAOImpl) Concluir(ctx context.Context, matriculaID int64, aula *model.Aula, cargaHoraria int) (*model.Matricula, error) {
+	q := d.querier(ctx)
+
+	// 1. Registra a conclusão da aula (idempotência garantida pela chave primária)
+	agora := time.Now()
+	result, err := q.ExecContext(ctx, `
+		INSERT INTO aulas_concluidas (matricula_id, aula_id, data_conclusao)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (matricula_id, aula_id) DO NOTHING
+	`, matriculaID, aula.ID, agora)
+	if err != nil {
+		return nil, traduzirErro(err, "registrar conclusão de aula")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, traduzirErro(err, "verificar linhas afetadas")
+	}
+	if rowsAffected == 0 {
+		return nil, &model.ConflictError{Chave: "aula.ja_concluida", Args: []any{aula.ID}}
+	}
+
+	// 2. Converte a duração da aula em progresso da matrícula
+	return registrarSessao(ctx, q, &model.SessaoEstudo{
+		MatriculaID: matriculaID,
+		Horas:       float64(aula.DuracaoMinutos) / 60,
+		DataSessao:  agora,
+		Observacao:  "Aula concluída: " + aula.Titulo,
+	}, cargaHoraria)
+}
+
+// CountByTrilhaID conta as aulas de todos os módulos de uma trilha.
+func (d *aulaDAOImpl) CountByTrilhaID(ctx context.Context, trilhaID int64) (int, error) {
+	var total int
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM aulas a
+		JOIN modulos m ON m.id = a.modulo_id
+		WHERE m.trilha_id = $1
+	`, trilhaID).Scan(&total)
+	if err != nil {
+		return 0, traduzirErro(err, "contar aulas da trilha")
+	}
+	return total, nil
+}
+
+// CountConcluidas conta as aulas concluídas em uma matrícula.
+func (d *aulaDAOImpl) CountConcluidas(ctx context.Context, matriculaID int64) (int, error) {
+	var total int
+	err := d.querier(ctx).QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM aulas_concluidas WHERE matricula_id = $1",
+		matriculaID,
+	).Scan(&total)
+	if err != nil {
+		return 0, traduzirErro(err, "contar aulas concluídas")
+	}
+	return total, nil
+}
@@ -0,0 +1,106 @@
+package dao
+
+import (
+	"context"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// BuscaDAO é a interface para a busca textual sobre trilhas e competências.
+type BuscaDAO interface {
+	Buscar(ctx context.Context, organizacaoID int64, termo string, tipos []string, limit, offset int) ([]model.ResultadoBusca, int, error)
+}
+
+// buscaDAOImpl implementa a interface BuscaDAO.
+type buscaDAOImpl struct {
+	*Conexao
+}
+
+// NewBuscaDAO cria uma nova instância de BuscaDAO.
+func NewBuscaDAO(conexao *Conexao) BuscaDAO {
+	return &buscaDAOImpl{Conexao: conexao}
+}
+
+// documentosBusca une os documentos pesquisáveis de trilhas e competências que casam com
+// a consulta ($1, sintaxe de busca web) e pertencem aos tipos pedidos ($2). Apenas as
+// trilhas visíveis para a organização ($3) entram na busca. A configuração pt_unaccent
+// (db/migracoes/0009_busca_textual.up.sql) aplica stemming em português e ignora acentos.
+const documentosBusca = `
+	WITH consulta AS (
+		SELECT websearch_to_tsquery('pt_unaccent', $1) AS q
+	),
+	documentos AS (
+		SELECT 'trilha' AS tipo, t.id, t.nome AS titulo,
+		       CONCAT_WS(' · ', t.foco_principal, t.descricao, t.nome) AS texto,
+		       ts_rank_cd(t.busca, consulta.q) AS relevancia
+		FROM trilhas t, consulta
+		WHERE t.busca @@ consulta.q
+		  AND (t.organizacao_id IS NULL OR t.organizacao_id = $3)
+		UNION ALL
+		SELECT 'competencia', c.id, c.nome,
+		       CONCAT_WS(' · ', c.descricao, c.nome),
+		       ts_rank_cd(c.busca, consulta.q)
+		FROM competencias c, consulta
+		WHERE c.busca @@ consulta.q
+	)
+`
+
+// escaparHTML devolve a expressão SQL que escapa os caracteres especiais de HTML do texto.
+// ts_headline só insere as marcações <mark>: sem o escape, qualquer markup gravado em
+// nomes e descrições chegaria ao cliente como HTML no trecho destacado.
+func escaparHTML(expr string) string {
+	return `REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(` + expr +
+		`, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+}
+
+// Buscar retorna uma página dos documentos que casam com o termo, ordenados por relevância,
+// e o total de documentos encontrados. O trecho destacado é gerado apenas para a página.
+func (d *buscaDAOImpl) Buscar(ctx context.Context, organizacaoID int64, termo string, tipos []string, limit, offset int) ([]model.ResultadoBusca, int, error) {
+	// 1. Total de resultados
+	var total int
+	err := d.querier(ctx).QueryRowContext(ctx,
+		documentosBusca+`SELECT COUNT(*) FROM documentos WHERE tipo = ANY($2)`,
+		termo, pq.Array(tipos), organizacaoID,
+	).Scan(&total)
+	if err != nil {
+		return nil, 0, traduzirErro(err, "contar resultados da busca")
+	}
+	if total == 0 {
+		return []model.ResultadoBusca{}, 0, nil
+	}
+
+	// 2. Página ordenada por relevância, com trecho destacado
+	rows, err := d.querier(ctx).QueryContext(ctx, documentosBusca+`
+		SELECT p.tipo, p.id, p.titulo,
+		       ts_headline('pt_unaccent', `+escaparHTML("p.texto")+`, consulta.q,
+		                   'StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2'),
+		       p.relevancia
+		FROM (
+			SELECT * FROM documentos
+			WHERE tipo = ANY($2)
+			ORDER BY relevancia DESC, tipo, id
+			LIMIT $4 OFFSET $5
+		) p, consulta
+		ORDER BY p.relevancia DESC, p.tipo, p.id
+	`, termo, pq.Array(tipos), organizacaoID, limit, offset)
+	if err != nil {
+		return nil, 0, traduzirErro(err, "executar busca")
+	}
+	defer rows.Close()
+
+	resultados := make([]model.ResultadoBusca, 0, limit)
+	for rows.Next() {
+		r := model.ResultadoBusca{}
+		if err := rows.Scan(&r.Tipo, &r.ID, &r.Titulo, &r.Trecho, &r.Relevancia); err != nil {
+			return nil, 0, traduzirErro(err, "escanear resultado da busca")
+		}
+		resultados = append(resultados, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, traduzirErro(err, "iterar resultados da busca")
+	}
+
+	return resultados, total, nil
+}
@@ -0,0 +1,205 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// CargoDAO é a interface para as operações de acesso a dados de Cargo-alvo.
+// Assim como as trilhas, a organização só enxerga os cargos do catálogo público e os
+// seus próprios.
+type CargoDAO interface {
+	Create(ctx context.Context, cargo *model.Cargo) error
+	FindByID(ctx context.Context, organizacaoID, id int64) (*model.Cargo, error)
+	FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Cargo, *model.Pagina, error)
+	Update(ctx context.Context, organizacaoID int64, cargo *model.Cargo) error
+	Delete(ctx context.Context, organizacaoID, id int64) error
+	ReplaceCompetencias(ctx context.Context, cargoID int64, niveis map[int64]int) error
+}
+
+// cargoDAOImpl implementa a interface CargoDAO.
+type cargoDAOImpl struct {
+	*Conexao
+}
+
+// NewCargoDAO cria uma nova instância de CargoDAO.
+func NewCargoDAO(conexao *Conexao) CargoDAO {
+	return &cargoDAOImpl{Conexao: conexao}
+}
+
+// Create insere um novo cargo e suas competências exigidas. Deve ser chamado dentro de
+// uma unidade de trabalho (service.WithTx).
+func (d *cargoDAOImpl) Create(ctx context.Context, cargo *model.Cargo) error {
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		INSERT INTO cargos (nome, descricao, organizacao_id)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`, cargo.Nome, cargo.Descricao, cargo.OrganizacaoID).Scan(&cargo.ID)
+	if err != nil {
+		return traduzirErro(err, "criar cargo")
+	}
+
+	niveis := make(map[int64]int, len(cargo.Competencias))
+	for _, c := range cargo.Competencias {
+		niveis[c.CompetenciaID] = c.NivelMinimo
+	}
+	return inserirCompetenciasCargo(ctx, d.querier(ctx), cargo.ID, niveis)
+}
+
+// FindByID busca um cargo visível para a organização pelo ID, com as competências
+// exigidas. Cargos privados de outras organizações são tratados como inexistentes.
+func (d *cargoDAOImpl) FindByID(ctx context.Context, organizacaoID, id int64) (*model.Cargo, error) {
+	cargo := &model.Cargo{}
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		SELECT id, nome, COALESCE(descricao, ''), organizacao_id
+		FROM cargos
+		WHERE id = $1 AND `+trilhaVisivel("", 2),
+		id, organizacaoID,
+	).Scan(&cargo.ID, &cargo.Nome, &cargo.Descricao, &cargo.OrganizacaoID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &model.ResourceNotFoundError{Resource: "Cargo", ID: id}
+		}
+		return nil, traduzirErro(err, "buscar cargo por ID")
+	}
+
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT c.id, c.nome, COALESCE(c.categoria, ''), cc.nivel_minimo
+		FROM cargo_competencias cc
+		JOIN competencias c ON c.id = cc.competencia_id
+		WHERE cc.cargo_id = $1
+		ORDER BY c.nome, c.id
+	`, id)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar competências do cargo")
+	}
+	defer rows.Close()
+
+	cargo.Competencias = make([]model.CompetenciaCargo, 0)
+	for rows.Next() {
+		c := model.CompetenciaCargo{}
+		if err := rows.Scan(&c.CompetenciaID, &c.Nome, &c.Categoria, &c.NivelMinimo); err != nil {
+			return nil, traduzirErro(err, "escanear linha de competência do cargo")
+		}
+		cargo.Competencias = append(cargo.Competencias, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar competências do cargo")
+	}
+
+	return cargo, nil
+}
+
+// cargoListSpec define a ordenação aceita na listagem de cargos.
+var cargoListSpec = listSpec{
+	from:        "cargos",
+	columns:     "id, nome, COALESCE(descricao, ''), organizacao_id",
+	idColumn:    "id",
+	defaultSort: "nome",
+	sortable: map[string]string{
+		"id":   "id",
+		"nome": "nome",
+	},
+	filters: map[string]string{},
+}
+
+// FindAll busca uma página dos cargos visíveis para a organização.
+func (d *cargoDAOImpl) FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Cargo, *model.Pagina, error) {
+	spec := cargoListSpec
+	spec.where = []string{trilhaVisivel("", 1)}
+	spec.whereArgs = []any{organizacaoID}
+
+	return listar(ctx, d.querier(ctx), spec, params, func(rows *sql.Rows, sortValue *string, id *int64) (model.Cargo, error) {
+		cargo := model.Cargo{}
+		err := rows.Scan(
+			&cargo.ID,
+			&cargo.Nome,
+			&cargo.Descricao,
+			&cargo.OrganizacaoID,
+			sortValue,
+			id,
+		)
+		return cargo, err
+	})
+}
+
+// Update atualiza o nome e a descrição de um cargo visível para a organização.
+func (d *cargoDAOImpl) Update(ctx context.Context, organizacaoID int64, cargo *model.Cargo) error {
+	result, err := d.querier(ctx).ExecContext(ctx, `
+		UPDATE cargos
+		SET nome = $2, descricao = $3
+		WHERE id = $1 AND `+trilhaVisivel("", 4),
+		cargo.ID, cargo.Nome, cargo.Descricao, organizacaoID,
+	)
+	if err != nil {
+		return traduzirErro(err, "atualizar cargo")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Cargo", ID: cargo.ID}
+	}
+
+	return nil
+}
+
+// Delete remove um cargo visível para a organização pelo ID.
+func (d *cargoDAOImpl) Delete(ctx context.Context, organizacaoID, id int64) error {
+	result, err := d.querier(ctx).ExecContext(ctx, "DELETE FROM cargos WHERE id = $1 AND "+trilhaVisivel("", 2), id, organizacaoID)
+	if err != nil {
+		return traduzirErro(err, "deletar cargo")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Cargo", ID: id}
+	}
+
+	return nil
+}
+
+// ReplaceCompetencias substitui as competências exigidas pelo cargo pelos níveis mínimos
+// informados (competência → nível). Deve ser chamado dentro de uma unidade de trabalho
+// (service.WithTx).
+func (d *cargoDAOImpl) ReplaceCompetencias(ctx context.Context, cargoID int64, niveis map[int64]int) error {
+	if _, err := d.querier(ctx).ExecContext(ctx, "DELETE FROM cargo_competencias WHERE cargo_id = $1", cargoID); err != nil {
+		return traduzirErro(err, "limpar competências do cargo")
+	}
+	return inserirCompetenciasCargo(ctx, d.querier(ctx), cargoID, niveis)
+}
+
+// inserirCompetenciasCargo insere, na transação informada, as competências exigidas
+// pelo cargo com seus níveis mínimos.
+func inserirCompetenciasCargo(ctx context.Context, q Querier, cargoID int64, niveis map[int64]int) error {
+	if len(niveis) == 0 {
+		return nil
+	}
+
+	competenciaIDs := make([]int64, 0, len(niveis))
+	valores := make([]int64, 0, len(niveis))
+	for id, nivel := range niveis {
+		competenciaIDs = append(competenciaIDs, id)
+		valores = append(valores, int64(nivel))
+	}
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO cargo_competencias (cargo_id, competencia_id, nivel_minimo)
+		SELECT $1, n.competencia_id, n.nivel
+		FROM UNNEST($2::BIGINT[], $3::SMALLINT[]) AS n (competencia_id, nivel)
+	`, cargoID, pq.Array(competenciaIDs), pq.Array(valores))
+	if err != nil {
+		return traduzirErro(err, "inserir competências do cargo")
+	}
+	return nil
+}
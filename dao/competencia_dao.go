@@ -0,0 +1,194 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// CompetenciaDAO é a interface para as operações de acesso a dados de Competência.
+type CompetenciaDAO interface {
+	Create(ctx context.Context, competencia *model.Competencia) error
+	FindByID(ctx context.Context, id int64) (*model.Competencia, error)
+	FindAll(ctx context.Context, params model.ListParams) ([]model.Competencia, *model.Pagina, error)
+	FindByIDs(ctx context.Context, ids []int64) ([]model.Competencia, error)
+	Update(ctx context.Context, competencia *model.Competencia) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// competenciaDAOImpl implementa a interface CompetenciaDAO.
+type competenciaDAOImpl struct {
+	*Conexao
+}
+
+// NewCompetenciaDAO cria uma nova instância de CompetenciaDAO.
+func NewCompetenciaDAO(conexao *Conexao) CompetenciaDAO {
+	return &competenciaDAOImpl{Conexao: conexao}
+}
+
+// Create insere uma nova competência no banco de dados.
+func (d *competenciaDAOImpl) Create(ctx context.Context, competencia *model.Competencia) error {
+	query := `
+		INSERT INTO competencias (nome, categoria, descricao)
+		VALUES ($1, $2, $3)
+		RETURNING id
+	`
+	err := d.querier(ctx).QueryRowContext(ctx,
+		query,
+		competencia.Nome,
+		competencia.Categoria,
+		competencia.Descricao,
+	).Scan(&competencia.ID)
+
+	if err != nil {
+		return traduzirErro(err, "criar competência")
+	}
+	return nil
+}
+
+// FindByID busca uma competência pelo ID.
+func (d *competenciaDAOImpl) FindByID(ctx context.Context, id int64) (*model.Competencia, error) {
+	competencia := &model.Competencia{}
+	query := `
+		SELECT id, nome, COALESCE(categoria, ''), COALESCE(descricao, '')
+		FROM competencias
+		WHERE id = $1
+	`
+	err := d.querier(ctx).QueryRowContext(ctx, query, id).Scan(
+		&competencia.ID,
+		&competencia.Nome,
+		&competencia.Categoria,
+		&competencia.Descricao,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &model.ResourceNotFoundError{Resource: "Competência", ID: id}
+		}
+		return nil, traduzirErro(err, "buscar competência por ID")
+	}
+	return competencia, nil
+}
+
+// competenciaListSpec define a ordenação e os filtros aceitos na listagem de competências.
+var competenciaListSpec = listSpec{
+	from:        "competencias",
+	columns:     "id, nome, COALESCE(categoria, ''), COALESCE(descricao, '')",
+	idColumn:    "id",
+	defaultSort: "id",
+	sortable: map[string]string{
+		"id":   "id",
+		"nome": "nome",
+	},
+	filters: map[string]string{
+		"categoria": "categoria",
+	},
+}
+
+// FindAll busca uma página de competências, aplicando filtros e ordenação no SQL.
+func (d *competenciaDAOImpl) FindAll(ctx context.Context, params model.ListParams) ([]model.Competencia, *model.Pagina, error) {
+	return listar(ctx, d.querier(ctx), competenciaListSpec, params, func(rows *sql.Rows, sortValue *string, id *int64) (model.Competencia, error) {
+		competencia := model.Competencia{}
+		err := rows.Scan(
+			&competencia.ID,
+			&competencia.Nome,
+			&competencia.Categoria,
+			&competencia.Descricao,
+			sortValue,
+			id,
+		)
+		return competencia, err
+	})
+}
+
+// FindByIDs busca as competências cujos IDs estão na lista informada.
+func (d *competenciaDAOImpl) FindByIDs(ctx context.Context, ids []int64) ([]model.Competencia, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT id, nome, COALESCE(categoria, ''), COALESCE(descricao, '')
+		FROM competencias
+		WHERE id = ANY($1)
+		ORDER BY id
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, traduzirErro(err, "buscar competências por IDs")
+	}
+	defer rows.Close()
+
+	return scanCompetencias(rows)
+}
+
+// Update atualiza uma competência existente.
+func (d *competenciaDAOImpl) Update(ctx context.Context, competencia *model.Competencia) error {
+	query := `
+		UPDATE competencias
+		SET nome = $2, categoria = $3, descricao = $4
+		WHERE id = $1
+	`
+	result, err := d.querier(ctx).ExecContext(ctx,
+		query,
+		competencia.ID,
+		competencia.Nome,
+		competencia.Categoria,
+		competencia.Descricao,
+	)
+	if err != nil {
+		return traduzirErro(err, "atualizar competência")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Competência", ID: competencia.ID}
+	}
+
+	return nil
+}
+
+// Delete remove uma competência pelo ID.
+func (d *competenciaDAOImpl) Delete(ctx context.Context, id int64) error {
+	result, err := d.querier(ctx).ExecContext(ctx, "DELETE FROM competencias WHERE id = $1", id)
+	if err != nil {
+		return traduzirErro(err, "deletar competência")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Competência", ID: id}
+	}
+
+	return nil
+}
+
+// scanCompetencias converte as linhas retornadas em uma lista de competências.
+func scanCompetencias(rows *sql.Rows) ([]model.Competencia, error) {
+	competencias := make([]model.Competencia, 0)
+	for rows.Next() {
+		competencia := model.Competencia{}
+		err := rows.Scan(
+			&competencia.ID,
+			&competencia.Nome,
+			&competencia.Categoria,
+			&competencia.Descricao,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de competência")
+		}
+		competencias = append(competencias, competencia)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar competências")
+	}
+
+	return competencias, nil
+}
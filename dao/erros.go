@@ -0,0 +1,139 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// Códigos SQLSTATE do PostgreSQL classificados por traduzirErro.
+const (
+	codigoUniqueViolation      = "23505"
+	codigoForeignKeyViolation  = "23503"
+	codigoCheckViolation       = "23514"
+	codigoSerializationFailure = "40001"
+	codigoDeadlockDetected     = "40P01"
+)
+
+// chavesConstraint traz a chave (no catálogo i18n) da mensagem exibida ao cliente quando
+// uma constraint conhecida é violada. Constraints ausentes recebem a mensagem genérica da
+// classe do erro.
+var chavesConstraint = map[string]string{
+	// Unicidade
+	"usuarios_email_key":            "usuario.email_indisponivel",
+	uqOrganizacaoSlug:               "organizacao.slug_duplicado",
+	uqEquipeOrganizacaoNome:         "equipe.nome_em_uso",
+	uqMatriculaAtiva:                "matricula.ativa_existente",
+	"refresh_tokens_token_hash_key": "refresh_token.duplicado",
+
+	// Chaves estrangeiras
+	"usuarios_equipe_id_fkey":                     "equipe.inexistente",
+	"usuarios_gestor_id_fkey":                     "gestor.inexistente",
+	"fk_matricula_usuario":                        "usuario.inexistente",
+	"fk_matricula_trilha":                         "trilha.inexistente",
+	"fk_modulo_trilha":                            "trilha.inexistente",
+	"fk_aula_modulo":                              "modulo.inexistente",
+	"fk_aula_concluida_aula":                      "aula.inexistente",
+	"fk_sessao_estudo_matricula":                  "matricula.inexistente",
+	"fk_trilha_competencia_competencia":           "competencia.inexistente",
+	"fk_trilha_competencia_requerida_competencia": "competencia.inexistente",
+	"fk_trilha_prerequisito_prerequisito":         "trilha.prerequisito_inexistente",
+	"fk_usuario_competencia_competencia":          "competencia.inexistente",
+	"fk_cargo_competencia_competencia":            "competencia.inexistente",
+
+	// Checks
+	"ck_trilha_prerequisito_distinto":   "trilha.prerequisito_de_si_mesma",
+	"ck_usuario_competencias_nivel":     "competencia.nivel_fora_da_escala",
+	"ck_cargo_competencias_nivel":       "cargo.nivel_minimo_fora_da_escala",
+	"ck_usuarios_papel":                 "usuario.papel_invalido",
+	"ck_trilhas_carga_horaria_positiva": "trilha.carga_horaria_invalida",
+}
+
+// codigosConstraint traz o código de erro específico de constraints conhecidas; as demais
+// recebem o código genérico da classe do erro.
+var codigosConstraint = map[string]string{
+	uqMatriculaAtiva: model.CodigoMatriculaAtivaDuplicada,
+}
+
+// erroPostgres extrai o *pq.Error da cadeia de erros, se houver.
+func erroPostgres(err error) (*pq.Error, bool) {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return nil, false
+	}
+	return pqErr, true
+}
+
+// violaConstraint indica se o erro é uma violação da constraint (ou índice único) informada.
+// Usado quando a mensagem depende de dados da operação (ex: o nome da equipe).
+func violaConstraint(err error, constraint string) bool {
+	pqErr, ok := erroPostgres(err)
+	return ok && pqErr.Constraint == constraint
+}
+
+// traduzirErro converte o erro de uma operação no banco no erro exposto às camadas
+// superiores, pelo código SQLSTATE (nunca pelo texto, que depende do driver e do idioma
+// do servidor):
+//   - unique_violation → ConflictError (409), com código específico se a constraint for conhecida;
+//   - foreign_key_violation e check_violation → BusinessRuleError (422);
+//   - serialization_failure e deadlock → TransientError (a transação pode ser repetida);
+//   - demais erros são registrados no log e embrulhados com a operação ("erro ao <operacao>").
+//
+// Erros que já são model.CustomError são devolvidos sem alteração.
+func traduzirErro(err error, operacao string) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(model.CustomError); ok {
+		return err
+	}
+
+	if pqErr, ok := erroPostgres(err); ok {
+		switch pqErr.Code {
+		case codigoUniqueViolation:
+			return &model.ConflictError{
+				Chave:  chaveConstraint(pqErr, "registro.duplicado"),
+				Codigo: codigosConstraint[pqErr.Constraint],
+			}
+		case codigoForeignKeyViolation:
+			return &model.BusinessRuleError{
+				Chave:  chaveConstraint(pqErr, "registro.referencia_invalida"),
+				Codigo: model.CodigoReferenciaInexistente,
+			}
+		case codigoCheckViolation:
+			return &model.BusinessRuleError{Chave: chaveConstraint(pqErr, "registro.fora_do_dominio")}
+		case codigoSerializationFailure, codigoDeadlockDetected:
+			log.Printf("Conflito de concorrência ao %s: %v", operacao, err)
+			return &model.TransientError{Err: fmt.Errorf("erro ao %s: %w", operacao, err)}
+		}
+	}
+
+	log.Printf("Erro ao %s: %v", operacao, err)
+	return fmt.Errorf("erro ao %s: %w", operacao, err)
+}
+
+// chaveConstraint retorna a chave da mensagem da constraint violada ou, se desconhecida,
+// padrao.
+func chaveConstraint(pqErr *pq.Error, padrao string) string {
+	if chave, ok := chavesConstraint[pqErr.Constraint]; ok {
+		return chave
+	}
+	log.Printf("Violação de constraint sem mensagem cadastrada: %s (%s)", pqErr.Constraint, pqErr.Code)
+	return padrao
+}
+
+// ErroTransitorio indica se o erro é uma falha de serialização (40001) ou um deadlock
+// (40P01) do PostgreSQL, já traduzida (TransientError) ou não (ex: erro do commit), casos
+// em que a transação inteira pode ser repetida.
+func ErroTransitorio(err error) bool {
+	var transitorio *model.TransientError
+	if errors.As(err, &transitorio) {
+		return true
+	}
+	pqErr, ok := erroPostgres(err)
+	return ok && (pqErr.Code == codigoSerializationFailure || pqErr.Code == codigoDeadlockDetected)
+}
@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"testing"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// silenciarLog descarta os registros de log do teste (traduzirErro registra os erros
+// inesperados).
+func silenciarLog(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+}
+
+func TestTraduzirErro(t *testing.T) {
+	silenciarLog(t)
+	serializacao := &pq.Error{Code: codigoSerializationFailure}
+	deadlock := &pq.Error{Code: codigoDeadlockDetected}
+	sintaxe := &pq.Error{Code: "42601", Message: "syntax error"}
+	naoEncontrado := &model.ResourceNotFoundError{Resource: "Trilha", ID: 1}
+
+	casos := []struct {
+		nome     string
+		err      error
+		esperado error
+	}{
+		{"sem erro", nil, nil},
+		{"unique com código específico", &pq.Error{Code: codigoUniqueViolation, Constraint: uqMatriculaAtiva},
+			&model.ConflictError{Chave: "matricula.ativa_existente", Codigo: model.CodigoMatriculaAtivaDuplicada}},
+		{"unique com mensagem específica", &pq.Error{Code: codigoUniqueViolation, Constraint: uqOrganizacaoSlug},
+			&model.ConflictError{Chave: "organizacao.slug_duplicado"}},
+		{"unique de email não revela o usuário", &pq.Error{Code: codigoUniqueViolation, Constraint: "usuarios_email_key"},
+			&model.ConflictError{Chave: "usuario.email_indisponivel"}},
+		{"unique desconhecida", &pq.Error{Code: codigoUniqueViolation, Constraint: "uq_desconhecida"},
+			&model.ConflictError{Chave: "registro.duplicado"}},
+		{"foreign key conhecida", &pq.Error{Code: codigoForeignKeyViolation, Constraint: "fk_aula_concluida_aula"},
+			&model.BusinessRuleError{Chave: "aula.inexistente", Codigo: model.CodigoReferenciaInexistente}},
+		{"foreign key desconhecida", &pq.Error{Code: codigoForeignKeyViolation, Constraint: "fk_desconhecida"},
+			&model.BusinessRuleError{Chave: "registro.referencia_invalida", Codigo: model.CodigoReferenciaInexistente}},
+		{"check conhecida", &pq.Error{Code: codigoCheckViolation, Constraint: "ck_trilhas_carga_horaria_positiva"},
+			&model.BusinessRuleError{Chave: "trilha.carga_horaria_invalida"}},
+		{"check desconhecida", &pq.Error{Code: codigoCheckViolation, Constraint: "ck_desconhecida"},
+			&model.BusinessRuleError{Chave: "registro.fora_do_dominio"}},
+		{"falha de serialização", serializacao,
+			&model.TransientError{Err: fmt.Errorf("erro ao operar: %w", serializacao)}},
+		{"deadlock", deadlock,
+			&model.TransientError{Err: fmt.Errorf("erro ao operar: %w", deadlock)}},
+		{"pq.Error embrulhado", fmt.Errorf("contexto: %w", &pq.Error{Code: codigoUniqueViolation, Constraint: uqOrganizacaoSlug}),
+			&model.ConflictError{Chave: "organizacao.slug_duplicado"}},
+		{"erro já traduzido", naoEncontrado, naoEncontrado},
+		{"outro erro do PostgreSQL", sintaxe, fmt.Errorf("erro ao operar: %w", sintaxe)},
+		{"erro do driver", sql.ErrConnDone, fmt.Errorf("erro ao operar: %w", sql.ErrConnDone)},
+	}
+	for _, c := range casos {
+		if err := traduzirErro(c.err, "operar"); !reflect.DeepEqual(err, c.esperado) {
+			t.Fatalf("%s: traduzirErro = %#v, esperado %#v", c.nome, err, c.esperado)
+		}
+	}
+}
+
+func TestErroTransitorio(t *testing.T) {
+	silenciarLog(t)
+	casos := []struct {
+		nome     string
+		err      error
+		esperado bool
+	}{
+		{"falha de serialização", &pq.Error{Code: codigoSerializationFailure}, true},
+		{"deadlock no commit", fmt.Errorf("commit: %w", &pq.Error{Code: codigoDeadlockDetected}), true},
+		{"já traduzido", traduzirErro(&pq.Error{Code: codigoSerializationFailure}, "operar"), true},
+		{"embrulhado pelo service", fmt.Errorf("etapa: %w", &model.TransientError{Err: errors.New("x")}), true},
+		{"unique violation", &pq.Error{Code: codigoUniqueViolation}, false},
+		{"erro comum", errors.New("falha"), false},
+		{"sem erro", nil, false},
+	}
+	for _, c := range casos {
+		if transitorio := ErroTransitorio(c.err); transitorio != c.esperado {
+			t.Fatalf("%s: ErroTransitorio = %v, esperado %v", c.nome, transitorio, c.esperado)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"context"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// GapCompetenciasDAO é a interface para as consultas da análise de lacunas entre o
+// perfil de competências do usuário e um cargo-alvo.
+type GapCompetenciasDAO interface {
+	CompararPerfil(ctx context.Context, cargoID, usuarioID int64) ([]model.LacunaCompetencia, error)
+	FindTrilhasPorCompetencias(ctx context.Context, organizacaoID, usuarioID int64, competenciaIDs []int64) (map[int64][]model.TrilhaLacuna, error)
+}
+
+// gapCompetenciasDAOImpl implementa a interface GapCompetenciasDAO.
+type gapCompetenciasDAOImpl struct {
+	*Conexao
+}
+
+// NewGapCompetenciasDAO cria uma nova instância de GapCompetenciasDAO.
+func NewGapCompetenciasDAO(conexao *Conexao) GapCompetenciasDAO {
+	return &gapCompetenciasDAOImpl{Conexao: conexao}
+}
+
+// CompararPerfil devolve cada competência exigida pelo cargo com o nível requerido e o
+// nível efetivo do usuário (0 quando ele não a possui), ordenadas pelo nome.
+func (d *gapCompetenciasDAOImpl) CompararPerfil(ctx context.Context, cargoID, usuarioID int64) ([]model.LacunaCompetencia, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT c.id, c.nome, COALESCE(c.categoria, ''), cc.nivel_minimo,
+		       COALESCE(e.nivel, 0), COALESCE(e.origem, '')
+		FROM cargo_competencias cc
+		JOIN competencias c ON c.id = cc.competencia_id
+		LEFT JOIN usuario_competencias_efetivas e
+		       ON e.competencia_id = cc.competencia_id AND e.usuario_id = $2
+		WHERE cc.cargo_id = $1
+		ORDER BY c.nome, c.id
+	`, cargoID, usuarioID)
+	if err != nil {
+		return nil, traduzirErro(err, "comparar perfil com o cargo")
+	}
+	defer rows.Close()
+
+	competencias := make([]model.LacunaCompetencia, 0)
+	for rows.Next() {
+		c := model.LacunaCompetencia{}
+		err := rows.Scan(
+			&c.CompetenciaID,
+			&c.Nome,
+			&c.Categoria,
+			&c.NivelRequerido,
+			&c.NivelAtual,
+			&c.Origem,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de competência do cargo")
+		}
+		competencias = append(competencias, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar perfil de competências")
+	}
+	return competencias, nil
+}
+
+// FindTrilhasPorCompetencias busca, via trilha_competencia, as trilhas visíveis para a
+// organização que desenvolvem cada competência informada, com o nível concedido ao
+// concluí-las. Trilhas já concluídas pelo usuário são ignoradas.
+func (d *gapCompetenciasDAOImpl) FindTrilhasPorCompetencias(ctx context.Context, organizacaoID, usuarioID int64, competenciaIDs []int64) (map[int64][]model.TrilhaLacuna, error) {
+	result := make(map[int64][]model.TrilhaLacuna)
+	if len(competenciaIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT tc.competencia_id, t.id, t.nome, t.nivel, t.carga_horaria, `+NivelConcedidoPorTrilha+`
+		FROM trilha_competencia tc
+		JOIN trilhas t ON t.id = tc.trilha_id
+		WHERE tc.competencia_id = ANY($1) AND `+trilhaVisivel("t", 2)+`
+		  AND NOT EXISTS (
+		      SELECT 1 FROM matriculas m
+		      WHERE m.trilha_id = t.id AND m.usuario_id = $3 AND m.status = $4
+		  )
+		ORDER BY tc.competencia_id, t.carga_horaria, t.id
+	`, pq.Array(competenciaIDs), organizacaoID, usuarioID, model.StatusMatriculaConcluida)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar trilhas das competências")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var competenciaID int64
+		t := model.TrilhaLacuna{}
+		err := rows.Scan(
+			&competenciaID,
+			&t.TrilhaID,
+			&t.Nome,
+			&t.Nivel,
+			&t.CargaHoraria,
+			&t.NivelConcedido,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de trilha")
+		}
+		result[competenciaID] = append(result[competenciaID], t)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar trilhas por competência")
+	}
+	return result, nil
+}
@@ -1,67 +1,148 @@
 package dao
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
-	"log"
 	"time"
 
-	"upskilling-api/db"
 	"upskilling-api/model"
 )
 
+// uqMatriculaAtiva é o índice único parcial que impede duas matrículas ATIVAS
+// do mesmo usuário na mesma trilha.
+const uqMatriculaAtiva = "uq_matriculas_usuario_trilha_ativa"
+
+// matriculaColumns lista as colunas lidas por scanMatricula, na mesma ordem.
+const matriculaColumns = `id, usuario_id, trilha_id, data_inscricao, status, data_conclusao,
+		data_cancelamento, horas_estudadas, data_ultima_atividade, data_prazo`
+
+// matriculaDaOrganizacao limita as matrículas aos usuários da organização no parâmetro $n.
+func matriculaDaOrganizacao(n int) string {
+	return fmt.Sprintf("usuario_id IN (SELECT id FROM usuarios WHERE organizacao_id = $%d)", n)
+}
+
 // MatriculaDAO é a interface para as operações de acesso a dados de Matrícula.
+// As consultas recebem a organização (tenant) e só enxergam matrículas de seus usuários.
 type MatriculaDAO interface {
-	Create(matricula *model.Matricula) error
-	FindByUsuarioID(usuarioID int64) ([]model.Matricula, error)
-	// Adicionar métodos para buscar por TrilhaID, etc., se necessário
+	CreateAtiva(ctx context.Context, organizacaoID int64, matricula *model.Matricula) error
+	FindByID(ctx context.Context, organizacaoID, id int64) (*model.Matricula, error)
+	FindByUsuarioID(ctx context.Context, organizacaoID, usuarioID int64, params model.ListParams) ([]model.Matricula, *model.Pagina, error)
+	UpdateStatus(ctx context.Context, matricula *model.Matricula, statusOrigem string) error
 }
 
 // matriculaDAOImpl implementa a interface MatriculaDAO.
-type matriculaDAOImpl struct{}
+type matriculaDAOImpl struct {
+	*Conexao
+}
 
 // NewMatriculaDAO cria uma nova instância de MatriculaDAO.
-func NewMatriculaDAO() MatriculaDAO {
-	return &matriculaDAOImpl{}
+func NewMatriculaDAO(conexao *Conexao) MatriculaDAO {
+	return &matriculaDAOImpl{Conexao: conexao}
 }
 
-// Create insere uma nova matrícula no banco de dados.
-func (d *matriculaDAOImpl) Create(matricula *model.Matricula) error {
-	query := `
-		INSERT INTO matriculas (usuario_id, trilha_id, data_inscricao, status)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id, data_inscricao
-	`
-	err := db.GetDB().QueryRow(
-		query,
-		matricula.UsuarioID,
-		matricula.TrilhaID,
-		time.Now(),
-		"ATIVA", // Status inicial
-	).Scan(&matricula.ID, &matricula.DataInscricao)
+// CreateAtiva cria uma matrícula ATIVA: confirma que o usuário pertence à organização e
+// que a trilha é visível para ela, verifica se já há matrícula ativa para o par e insere.
+// O índice único parcial garante a regra mesmo sob requisições concorrentes. Deve ser
+// chamado dentro de uma unidade de trabalho (service.WithTx), que mantém usuário e
+// trilha bloqueados até a inserção ser confirmada.
+func (d *matriculaDAOImpl) CreateAtiva(ctx context.Context, organizacaoID int64, matricula *model.Matricula) error {
+	q := d.querier(ctx)
+
+	// 1. Bloqueia usuário e trilha para que não sejam removidos durante a inscrição
+	var id int64
+	err := q.QueryRowContext(ctx,
+		"SELECT id FROM usuarios WHERE id = $1 AND organizacao_id = $2 FOR SHARE",
+		matricula.UsuarioID, organizacaoID,
+	).Scan(&id)
+	if err == sql.ErrNoRows {
+		return &model.ResourceNotFoundError{Resource: "Usuário", ID: matricula.UsuarioID}
+	}
+	if err != nil {
+		return traduzirErro(err, "buscar usuário da matrícula")
+	}
 
+	err = q.QueryRowContext(ctx,
+		"SELECT id FROM trilhas WHERE id = $1 AND "+trilhaVisivel("", 2)+" FOR SHARE",
+		matricula.TrilhaID, organizacaoID,
+	).Scan(&id)
+	if err == sql.ErrNoRows {
+		return &model.ResourceNotFoundError{Resource: "Trilha", ID: matricula.TrilhaID}
+	}
 	if err != nil {
-		log.Printf("Erro ao criar matrícula: %v", err)
-		return fmt.Errorf("erro ao criar matrícula: %w", err)
+		return traduzirErro(err, "buscar trilha da matrícula")
+	}
+
+	// 2. Verifica matrícula ativa existente para o par usuário/trilha
+	err = q.QueryRowContext(ctx, `
+		SELECT id FROM matriculas
+		WHERE usuario_id = $1 AND trilha_id = $2 AND status = $3
+	`, matricula.UsuarioID, matricula.TrilhaID, model.StatusMatriculaAtiva).Scan(&id)
+	if err == nil {
+		return matriculaAtivaConflict(matricula.UsuarioID, matricula.TrilhaID)
+	}
+	if err != sql.ErrNoRows {
+		return traduzirErro(err, "verificar matrícula ativa")
+	}
+
+	// 3. Inserção
+	matricula.Status = model.StatusMatriculaAtiva
+	err = q.QueryRowContext(ctx, `
+		INSERT INTO matriculas (usuario_id, trilha_id, data_inscricao, status, data_prazo)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, data_inscricao
+	`, matricula.UsuarioID, matricula.TrilhaID, time.Now(), matricula.Status, matricula.DataPrazo).Scan(&matricula.ID, &matricula.DataInscricao)
+	if err != nil {
+		if isMatriculaAtivaDuplicada(err) {
+			return matriculaAtivaConflict(matricula.UsuarioID, matricula.TrilhaID)
+		}
+		return traduzirErro(err, "criar matrícula")
 	}
 	return nil
 }
 
-// FindByUsuarioID busca todas as matrículas de um usuário.
-func (d *matriculaDAOImpl) FindByUsuarioID(usuarioID int64) ([]model.Matricula, error) {
-	rows, err := db.GetDB().Query(`
-		SELECT id, usuario_id, trilha_id, data_inscricao, status
+// FindByID busca uma matrícula de um usuário da organização pelo ID.
+func (d *matriculaDAOImpl) FindByID(ctx context.Context, organizacaoID, id int64) (*model.Matricula, error) {
+	query := `
+		SELECT ` + matriculaColumns + `
 		FROM matriculas
-		WHERE usuario_id = $1
-		ORDER BY data_inscricao DESC
-	`, usuarioID)
+		WHERE id = $1 AND ` + matriculaDaOrganizacao(2)
+	matricula, err := scanMatricula(d.querier(ctx).QueryRowContext(ctx, query, id, organizacaoID))
+
 	if err != nil {
-		log.Printf("Erro ao buscar matrículas por usuário: %v", err)
-		return nil, fmt.Errorf("erro ao buscar matrículas por usuário: %w", err)
+		if err == sql.ErrNoRows {
+			return nil, &model.ResourceNotFoundError{Resource: "Matrícula", ID: id}
+		}
+		return nil, traduzirErro(err, "buscar matrícula por ID")
 	}
-	defer rows.Close()
+	return matricula, nil
+}
 
-	matriculas := make([]model.Matricula, 0)
-	for rows.Next() {
+// matriculaListSpec define a ordenação e os filtros aceitos na listagem de matrículas.
+var matriculaListSpec = listSpec{
+	from:        "matriculas",
+	columns:     matriculaColumns,
+	idColumn:    "id",
+	defaultSort: "-data_inscricao",
+	sortable: map[string]string{
+		"id":              "id",
+		"data_inscricao":  "data_inscricao",
+		"status":          "status",
+		"horas_estudadas": "horas_estudadas",
+	},
+	filters: map[string]string{
+		"status":    "status",
+		"trilha_id": "trilha_id::TEXT",
+	},
+}
+
+// FindByUsuarioID busca uma página das matrículas de um usuário da organização.
+func (d *matriculaDAOImpl) FindByUsuarioID(ctx context.Context, organizacaoID, usuarioID int64, params model.ListParams) ([]model.Matricula, *model.Pagina, error) {
+	spec := matriculaListSpec
+	spec.where = []string{"usuario_id = $1", matriculaDaOrganizacao(2)}
+	spec.whereArgs = []any{usuarioID, organizacaoID}
+
+	return listar(ctx, d.querier(ctx), spec, params, func(rows *sql.Rows, sortValue *string, id *int64) (model.Matricula, error) {
 		matricula := model.Matricula{}
 		err := rows.Scan(
 			&matricula.ID,
@@ -69,18 +150,97 @@ func (d *matriculaDAOImpl) FindByUsuarioID(usuarioID int64) ([]model.Matricula,
 			&matricula.TrilhaID,
 			&matricula.DataInscricao,
 			&matricula.Status,
+			&matricula.DataConclusao,
+			&matricula.DataCancelamento,
+			&matricula.HorasEstudadas,
+			&matricula.DataUltimaAtividade,
+			&matricula.DataPrazo,
+			sortValue,
+			id,
 		)
-		if err != nil {
-			log.Printf("Erro ao escanear linha de matrícula: %v", err)
-			return nil, fmt.Errorf("erro ao escanear linha de matrícula: %w", err)
+		return matricula, err
+	})
+}
+
+// UpdateStatus persiste o status e as datas de conclusão/cancelamento de uma matrícula.
+// Na conclusão, as competências da trilha são concedidas ao usuário; por isso deve ser
+// chamado dentro de uma unidade de trabalho (service.WithTx).
+// A atualização só ocorre se a matrícula ainda estiver em statusOrigem (o status validado
+// pelo serviço); se outra requisição a alterou antes, retorna um ConflictError.
+func (d *matriculaDAOImpl) UpdateStatus(ctx context.Context, matricula *model.Matricula, statusOrigem string) error {
+	query := `
+		UPDATE matriculas
+		SET status = $2, data_conclusao = $3, data_cancelamento = $4
+		WHERE id = $1 AND status = $5
+	`
+	result, err := d.querier(ctx).ExecContext(ctx,
+		query,
+		matricula.ID,
+		matricula.Status,
+		matricula.DataConclusao,
+		matricula.DataCancelamento,
+		statusOrigem,
+	)
+	if err != nil {
+		if isMatriculaAtivaDuplicada(err) {
+			return matriculaAtivaConflict(matricula.UsuarioID, matricula.TrilhaID)
 		}
-		matriculas = append(matriculas, matricula)
+		return traduzirErro(err, "atualizar status da matrícula")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return statusAlteradoConflict(matricula.ID, statusOrigem)
 	}
 
-	if err = rows.Err(); err != nil {
-		log.Printf("Erro após iteração de linhas: %v", err)
-		return nil, fmt.Errorf("erro após iteração de linhas: %w", err)
+	if matricula.Status == model.StatusMatriculaConcluida {
+		return ConcederCompetenciasTrilha(ctx, d.querier(ctx), matricula.UsuarioID, matricula.TrilhaID)
 	}
+	return nil
+}
+
+// isMatriculaAtivaDuplicada indica se o erro é a violação do índice único de matrícula ativa.
+func isMatriculaAtivaDuplicada(err error) bool {
+	return violaConstraint(err, uqMatriculaAtiva)
+}
+
+// matriculaAtivaConflict monta o erro de conflito para matrícula ativa duplicada.
+func matriculaAtivaConflict(usuarioID, trilhaID int64) error {
+	return &model.ConflictError{Chave: "matricula.ativa_duplicada", Args: []any{usuarioID, trilhaID}, Codigo: model.CodigoMatriculaAtivaDuplicada}
+}
 
-	return matriculas, nil
+// statusAlteradoConflict monta o erro de uma transição concorrente: a matrícula já não
+// está no status em que a transição foi validada.
+func statusAlteradoConflict(id int64, statusOrigem string) error {
+	return &model.ConflictError{Chave: "matricula.status_alterado", Args: []any{id, statusOrigem}}
+}
+
+// rowScanner abstrai *sql.Row e *sql.Rows para reaproveitar o mapeamento de colunas.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanMatricula lê uma matrícula a partir das colunas de matriculaColumns.
+func scanMatricula(row rowScanner) (*model.Matricula, error) {
+	matricula := &model.Matricula{}
+	err := row.Scan(
+		&matricula.ID,
+		&matricula.UsuarioID,
+		&matricula.TrilhaID,
+		&matricula.DataInscricao,
+		&matricula.Status,
+		&matricula.DataConclusao,
+		&matricula.DataCancelamento,
+		&matricula.HorasEstudadas,
+		&matricula.DataUltimaAtividade,
+		&matricula.DataPrazo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return matricula, nil
 }
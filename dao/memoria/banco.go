@@ -0,0 +1,105 @@
+// Package memoria implementa DAOs em memória com o mesmo comportamento observável dos
+// DAOs PostgreSQL (isolamento por organização, erros de recurso inexistente e de
+// conflito, paginação, remoções em cascata). Servem para testes e para subir a API
+// sem banco de dados; os dados se perdem ao encerrar o processo.
+package memoria
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"upskilling-api/model"
+)
+
+// Banco guarda as tabelas compartilhadas pelos DAOs em memória. Todos os acessos são
+// protegidos por um único RWMutex, portanto os DAOs podem ser usados concorrentemente.
+type Banco struct {
+	mu sync.RWMutex
+
+	organizacoes  map[int64]model.Organizacao
+	equipes       map[int64]model.Equipe
+	usuarios      map[int64]model.Usuario
+	trilhas       map[int64]model.Trilha
+	competencias  map[int64]model.Competencia
+	cargos        map[int64]cargo
+	matriculas    map[int64]model.Matricula
+	refreshTokens map[string]refreshToken
+
+	proximaOrganizacaoID int64
+	proximaEquipeID      int64
+	proximoUsuarioID     int64
+	proximaTrilhaID      int64
+	proximaCompetenciaID int64
+	proximoCargoID       int64
+	proximaMatriculaID   int64
+}
+
+// NewBanco cria um banco em memória vazio.
+func NewBanco() *Banco {
+	return &Banco{
+		organizacoes:  make(map[int64]model.Organizacao),
+		equipes:       make(map[int64]model.Equipe),
+		usuarios:      make(map[int64]model.Usuario),
+		trilhas:       make(map[int64]model.Trilha),
+		competencias:  make(map[int64]model.Competencia),
+		cargos:        make(map[int64]cargo),
+		matriculas:    make(map[int64]model.Matricula),
+		refreshTokens: make(map[string]refreshToken),
+	}
+}
+
+// InserirOrganizacao cadastra uma organização, atribuindo o ID e a data de criação, como
+// fazem a migração (organização da plataforma) e o seeder no PostgreSQL. O slug não é
+// validado.
+func (b *Banco) InserirOrganizacao(organizacao *model.Organizacao) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.inserirOrganizacao(organizacao)
+}
+
+// inserirOrganizacao cadastra a organização. Deve ser chamada com o lock adquirido.
+func (b *Banco) inserirOrganizacao(organizacao *model.Organizacao) {
+	b.proximaOrganizacaoID++
+	organizacao.ID = b.proximaOrganizacaoID
+	organizacao.DataCriacao = time.Now()
+	b.organizacoes[organizacao.ID] = *organizacao
+}
+
+// EmTransacao implementa dao.Transacionador. Cada operação dos DAOs em memória já é
+// atômica; a unidade de trabalho apenas executa fn, sem isolamento entre operações e
+// sem desfazer as alterações quando fn retorna erro.
+func (b *Banco) EmTransacao(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// trilhaVisivel replica dao.trilhaVisivel: catálogo público ou privada da organização.
+func trilhaVisivel(t model.Trilha, organizacaoID int64) bool {
+	return t.OrganizacaoID == nil || *t.OrganizacaoID == organizacaoID
+}
+
+// matriculaDaOrganizacao replica dao.matriculaDaOrganizacao: a matrícula pertence a um
+// usuário da organização. Deve ser chamada com o lock adquirido.
+func (b *Banco) matriculaDaOrganizacao(m model.Matricula, organizacaoID int64) bool {
+	u, ok := b.usuarios[m.UsuarioID]
+	return ok && u.OrganizacaoID == organizacaoID
+}
+
+// clonarID copia um ID opcional, para que o banco não compartilhe ponteiros com quem
+// chamou o DAO.
+func clonarID(id *int64) *int64 {
+	if id == nil {
+		return nil
+	}
+	v := *id
+	return &v
+}
+
+// clonarData copia uma data opcional.
+func clonarData(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	return &v
+}
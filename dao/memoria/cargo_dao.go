@@ -0,0 +1,190 @@
+package memoria
+
+import (
+	"context"
+	"sort"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// cargo é um registro da tabela cargos com suas competências exigidas (competência →
+// nível mínimo, como em cargo_competencias).
+type cargo struct {
+	model.Cargo
+	niveis map[int64]int
+}
+
+// cargoListSpec replica a ordenação de dao.cargoListSpec.
+var cargoListSpec = listSpec[model.Cargo]{
+	id:          func(c model.Cargo) int64 { return c.ID },
+	defaultSort: "nome",
+	sortable: map[string]func(model.Cargo) any{
+		"id":   func(c model.Cargo) any { return c.ID },
+		"nome": func(c model.Cargo) any { return c.Nome },
+	},
+	filters: map[string]func(model.Cargo) (string, bool){},
+}
+
+// cargoDAO implementa dao.CargoDAO em memória.
+type cargoDAO struct {
+	banco *Banco
+}
+
+// NewCargoDAO cria um CargoDAO sobre o banco em memória.
+func NewCargoDAO(banco *Banco) dao.CargoDAO {
+	return &cargoDAO{banco: banco}
+}
+
+// Create insere um novo cargo e suas competências exigidas (organizacao_id nil =
+// catálogo público).
+func (d *cargoDAO) Create(ctx context.Context, c *model.Cargo) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	niveis := make(map[int64]int, len(c.Competencias))
+	for _, cc := range c.Competencias {
+		niveis[cc.CompetenciaID] = cc.NivelMinimo
+	}
+	if err := b.validarNiveisCargo(niveis); err != nil {
+		return err
+	}
+
+	b.proximoCargoID++
+	c.ID = b.proximoCargoID
+	registro := cargo{Cargo: copiarCargo(*c), niveis: niveis}
+	registro.Competencias = nil
+	b.cargos[c.ID] = registro
+	return nil
+}
+
+// FindByID busca um cargo visível para a organização pelo ID, com as competências
+// exigidas em ordem de nome.
+func (d *cargoDAO) FindByID(ctx context.Context, organizacaoID, id int64) (*model.Cargo, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	c, ok := b.cargos[id]
+	if !ok || !cargoVisivel(c.Cargo, organizacaoID) {
+		return nil, &model.ResourceNotFoundError{Resource: "Cargo", ID: id}
+	}
+	res := copiarCargo(c.Cargo)
+	res.Competencias = make([]model.CompetenciaCargo, 0, len(c.niveis))
+	for competenciaID, nivel := range c.niveis {
+		competencia := b.competencias[competenciaID]
+		res.Competencias = append(res.Competencias, model.CompetenciaCargo{
+			CompetenciaID: competenciaID,
+			Nome:          competencia.Nome,
+			Categoria:     competencia.Categoria,
+			NivelMinimo:   nivel,
+		})
+	}
+	sort.Slice(res.Competencias, func(i, j int) bool {
+		x, y := res.Competencias[i], res.Competencias[j]
+		if x.Nome != y.Nome {
+			return x.Nome < y.Nome
+		}
+		return x.CompetenciaID < y.CompetenciaID
+	})
+	return &res, nil
+}
+
+// FindAll busca uma página dos cargos visíveis para a organização.
+func (d *cargoDAO) FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Cargo, *model.Pagina, error) {
+	b := d.banco
+	b.mu.RLock()
+	cargos := make([]model.Cargo, 0, len(b.cargos))
+	for _, c := range b.cargos {
+		if cargoVisivel(c.Cargo, organizacaoID) {
+			cargos = append(cargos, copiarCargo(c.Cargo))
+		}
+	}
+	b.mu.RUnlock()
+
+	return listar(cargos, cargoListSpec, params)
+}
+
+// Update atualiza o nome e a descrição de um cargo visível para a organização.
+func (d *cargoDAO) Update(ctx context.Context, organizacaoID int64, c *model.Cargo) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	registro, ok := b.cargos[c.ID]
+	if !ok || !cargoVisivel(registro.Cargo, organizacaoID) {
+		return &model.ResourceNotFoundError{Resource: "Cargo", ID: c.ID}
+	}
+	registro.Nome = c.Nome
+	registro.Descricao = c.Descricao
+	b.cargos[c.ID] = registro
+	return nil
+}
+
+// Delete remove um cargo visível para a organização pelo ID.
+func (d *cargoDAO) Delete(ctx context.Context, organizacaoID, id int64) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.cargos[id]
+	if !ok || !cargoVisivel(c.Cargo, organizacaoID) {
+		return &model.ResourceNotFoundError{Resource: "Cargo", ID: id}
+	}
+	delete(b.cargos, id)
+	return nil
+}
+
+// ReplaceCompetencias substitui as competências exigidas pelo cargo pelos níveis mínimos
+// informados (competência → nível). Cargo inexistente só é erro quando há competências a
+// inserir (fk_cargo_competencia_cargo).
+func (d *cargoDAO) ReplaceCompetencias(ctx context.Context, cargoID int64, niveis map[int64]int) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.cargos[cargoID]
+	if !ok {
+		if len(niveis) == 0 {
+			return nil
+		}
+		return &model.BusinessRuleError{Chave: "registro.referencia_invalida", Codigo: model.CodigoReferenciaInexistente}
+	}
+	if err := b.validarNiveisCargo(niveis); err != nil {
+		return err
+	}
+	c.niveis = make(map[int64]int, len(niveis))
+	for competenciaID, nivel := range niveis {
+		c.niveis[competenciaID] = nivel
+	}
+	b.cargos[cargoID] = c
+	return nil
+}
+
+// validarNiveisCargo replica as restrições de cargo_competencias: a competência precisa
+// existir e o nível mínimo fica entre 1 e 5. Deve ser chamada com o lock adquirido.
+func (b *Banco) validarNiveisCargo(niveis map[int64]int) error {
+	for competenciaID, nivel := range niveis {
+		if _, ok := b.competencias[competenciaID]; !ok {
+			return &model.BusinessRuleError{Chave: "competencia.inexistente", Codigo: model.CodigoReferenciaInexistente}
+		}
+		if nivel < 1 || nivel > 5 {
+			return &model.BusinessRuleError{Chave: "cargo.nivel_minimo_fora_da_escala"}
+		}
+	}
+	return nil
+}
+
+// cargoVisivel replica dao.trilhaVisivel para cargos: catálogo público ou privado da
+// organização.
+func cargoVisivel(c model.Cargo, organizacaoID int64) bool {
+	return c.OrganizacaoID == nil || *c.OrganizacaoID == organizacaoID
+}
+
+// copiarCargo copia o cargo sem compartilhar a organização dona nem as competências.
+func copiarCargo(c model.Cargo) model.Cargo {
+	c.OrganizacaoID = clonarID(c.OrganizacaoID)
+	c.Competencias = append([]model.CompetenciaCargo(nil), c.Competencias...)
+	return c
+}
@@ -0,0 +1,118 @@
+package memoria
+
+import (
+	"context"
+	"sort"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// competenciaListSpec replica a ordenação e os filtros de dao.competenciaListSpec.
+var competenciaListSpec = listSpec[model.Competencia]{
+	id:          func(c model.Competencia) int64 { return c.ID },
+	defaultSort: "id",
+	sortable: map[string]func(model.Competencia) any{
+		"id":   func(c model.Competencia) any { return c.ID },
+		"nome": func(c model.Competencia) any { return c.Nome },
+	},
+	filters: map[string]func(model.Competencia) (string, bool){
+		"categoria": func(c model.Competencia) (string, bool) { return c.Categoria, true },
+	},
+}
+
+// competenciaDAO implementa dao.CompetenciaDAO em memória.
+type competenciaDAO struct {
+	banco *Banco
+}
+
+// NewCompetenciaDAO cria um CompetenciaDAO sobre o banco em memória.
+func NewCompetenciaDAO(banco *Banco) dao.CompetenciaDAO {
+	return &competenciaDAO{banco: banco}
+}
+
+// Create insere uma nova competência.
+func (d *competenciaDAO) Create(ctx context.Context, competencia *model.Competencia) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.proximaCompetenciaID++
+	competencia.ID = b.proximaCompetenciaID
+	b.competencias[competencia.ID] = *competencia
+	return nil
+}
+
+// FindByID busca uma competência pelo ID.
+func (d *competenciaDAO) FindByID(ctx context.Context, id int64) (*model.Competencia, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	c, ok := b.competencias[id]
+	if !ok {
+		return nil, &model.ResourceNotFoundError{Resource: "Competência", ID: id}
+	}
+	return &c, nil
+}
+
+// FindAll busca uma página de competências.
+func (d *competenciaDAO) FindAll(ctx context.Context, params model.ListParams) ([]model.Competencia, *model.Pagina, error) {
+	b := d.banco
+	b.mu.RLock()
+	competencias := make([]model.Competencia, 0, len(b.competencias))
+	for _, c := range b.competencias {
+		competencias = append(competencias, c)
+	}
+	b.mu.RUnlock()
+
+	return listar(competencias, competenciaListSpec, params)
+}
+
+// FindByIDs busca as competências cujos IDs estão na lista informada, em ordem de ID.
+func (d *competenciaDAO) FindByIDs(ctx context.Context, ids []int64) ([]model.Competencia, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	competencias := make([]model.Competencia, 0, len(ids))
+	vistas := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if c, ok := b.competencias[id]; ok && !vistas[id] {
+			competencias = append(competencias, c)
+			vistas[id] = true
+		}
+	}
+	sort.Slice(competencias, func(i, j int) bool { return competencias[i].ID < competencias[j].ID })
+	return competencias, nil
+}
+
+// Update atualiza uma competência existente.
+func (d *competenciaDAO) Update(ctx context.Context, competencia *model.Competencia) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.competencias[competencia.ID]; !ok {
+		return &model.ResourceNotFoundError{Resource: "Competência", ID: competencia.ID}
+	}
+	b.competencias[competencia.ID] = *competencia
+	return nil
+}
+
+// Delete remove uma competência e, em cascata, sua exigência pelos cargos.
+func (d *competenciaDAO) Delete(ctx context.Context, id int64) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.competencias[id]; !ok {
+		return &model.ResourceNotFoundError{Resource: "Competência", ID: id}
+	}
+	delete(b.competencias, id)
+
+	for _, c := range b.cargos {
+		delete(c.niveis, id)
+	}
+	return nil
+}
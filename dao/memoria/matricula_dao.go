@@ -0,0 +1,144 @@
+package memoria
+
+import (
+	"context"
+	"time"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// matriculaListSpec replica a ordenação e os filtros de dao.matriculaListSpec.
+var matriculaListSpec = listSpec[model.Matricula]{
+	id:          func(m model.Matricula) int64 { return m.ID },
+	defaultSort: "-data_inscricao",
+	sortable: map[string]func(model.Matricula) any{
+		"id":              func(m model.Matricula) any { return m.ID },
+		"data_inscricao":  func(m model.Matricula) any { return m.DataInscricao },
+		"status":          func(m model.Matricula) any { return m.Status },
+		"horas_estudadas": func(m model.Matricula) any { return m.HorasEstudadas },
+	},
+	filters: map[string]func(model.Matricula) (string, bool){
+		"status":    func(m model.Matricula) (string, bool) { return m.Status, true },
+		"trilha_id": func(m model.Matricula) (string, bool) { return idTexto(&m.TrilhaID) },
+	},
+}
+
+// matriculaDAO implementa dao.MatriculaDAO em memória.
+type matriculaDAO struct {
+	banco *Banco
+}
+
+// NewMatriculaDAO cria um MatriculaDAO sobre o banco em memória.
+func NewMatriculaDAO(banco *Banco) dao.MatriculaDAO {
+	return &matriculaDAO{banco: banco}
+}
+
+// CreateAtiva cria uma matrícula ATIVA depois de confirmar que o usuário pertence à
+// organização, que a trilha é visível para ela e que não há outra matrícula ativa no par.
+func (d *matriculaDAO) CreateAtiva(ctx context.Context, organizacaoID int64, matricula *model.Matricula) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if u, ok := b.usuarios[matricula.UsuarioID]; !ok || u.OrganizacaoID != organizacaoID {
+		return &model.ResourceNotFoundError{Resource: "Usuário", ID: matricula.UsuarioID}
+	}
+	if t, ok := b.trilhas[matricula.TrilhaID]; !ok || !trilhaVisivel(t, organizacaoID) {
+		return &model.ResourceNotFoundError{Resource: "Trilha", ID: matricula.TrilhaID}
+	}
+	if b.possuiMatriculaAtiva(matricula.UsuarioID, matricula.TrilhaID, 0) {
+		return matriculaAtivaConflict(matricula.UsuarioID, matricula.TrilhaID)
+	}
+	b.inserirMatricula(matricula)
+	return nil
+}
+
+// FindByID busca uma matrícula de um usuário da organização pelo ID.
+func (d *matriculaDAO) FindByID(ctx context.Context, organizacaoID, id int64) (*model.Matricula, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	m, ok := b.matriculas[id]
+	if !ok || !b.matriculaDaOrganizacao(m, organizacaoID) {
+		return nil, &model.ResourceNotFoundError{Resource: "Matrícula", ID: id}
+	}
+	m = copiarMatricula(m)
+	return &m, nil
+}
+
+// FindByUsuarioID busca uma página das matrículas de um usuário da organização.
+func (d *matriculaDAO) FindByUsuarioID(ctx context.Context, organizacaoID, usuarioID int64, params model.ListParams) ([]model.Matricula, *model.Pagina, error) {
+	b := d.banco
+	b.mu.RLock()
+	matriculas := make([]model.Matricula, 0)
+	for _, m := range b.matriculas {
+		if m.UsuarioID == usuarioID && b.matriculaDaOrganizacao(m, organizacaoID) {
+			matriculas = append(matriculas, copiarMatricula(m))
+		}
+	}
+	b.mu.RUnlock()
+
+	return listar(matriculas, matriculaListSpec, params)
+}
+
+// UpdateStatus persiste o status e as datas de conclusão/cancelamento de uma matrícula.
+// Diferente do PostgreSQL, a conclusão não concede as competências da trilha: o banco em
+// memória não guarda competências de usuários.
+func (d *matriculaDAO) UpdateStatus(ctx context.Context, matricula *model.Matricula, statusOrigem string) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	m, ok := b.matriculas[matricula.ID]
+	if !ok {
+		return &model.ResourceNotFoundError{Resource: "Matrícula", ID: matricula.ID}
+	}
+	if m.Status != statusOrigem {
+		return &model.ConflictError{Chave: "matricula.status_alterado", Args: []any{m.ID, statusOrigem}}
+	}
+	if matricula.Status == model.StatusMatriculaAtiva && b.possuiMatriculaAtiva(m.UsuarioID, m.TrilhaID, m.ID) {
+		return matriculaAtivaConflict(m.UsuarioID, m.TrilhaID)
+	}
+	m.Status = matricula.Status
+	m.DataConclusao = clonarData(matricula.DataConclusao)
+	m.DataCancelamento = clonarData(matricula.DataCancelamento)
+	b.matriculas[m.ID] = m
+	return nil
+}
+
+// possuiMatriculaAtiva replica o índice único parcial de matrícula ativa por usuário e
+// trilha, ignorando a matrícula exceto. Deve ser chamada com o lock adquirido.
+func (b *Banco) possuiMatriculaAtiva(usuarioID, trilhaID, exceto int64) bool {
+	for _, m := range b.matriculas {
+		if m.ID != exceto && m.UsuarioID == usuarioID && m.TrilhaID == trilhaID && m.Status == model.StatusMatriculaAtiva {
+			return true
+		}
+	}
+	return false
+}
+
+// inserirMatricula grava a matrícula como ATIVA, preenchendo ID e data de inscrição.
+// Deve ser chamada com o lock de escrita adquirido.
+func (b *Banco) inserirMatricula(matricula *model.Matricula) {
+	b.proximaMatriculaID++
+	matricula.ID = b.proximaMatriculaID
+	matricula.DataInscricao = time.Now()
+	matricula.Status = model.StatusMatriculaAtiva
+	b.matriculas[matricula.ID] = copiarMatricula(*matricula)
+}
+
+// matriculaAtivaConflict monta o mesmo erro de conflito dos DAOs PostgreSQL.
+func matriculaAtivaConflict(usuarioID, trilhaID int64) error {
+	return &model.ConflictError{Chave: "matricula.ativa_duplicada", Args: []any{usuarioID, trilhaID}, Codigo: model.CodigoMatriculaAtivaDuplicada}
+}
+
+// copiarMatricula copia a matrícula sem compartilhar as datas opcionais.
+func copiarMatricula(m model.Matricula) model.Matricula {
+	m.DataConclusao = clonarData(m.DataConclusao)
+	m.DataCancelamento = clonarData(m.DataCancelamento)
+	m.DataUltimaAtividade = clonarData(m.DataUltimaAtividade)
+	m.DataPrazo = clonarData(m.DataPrazo)
+	return m
+}
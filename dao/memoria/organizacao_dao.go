@@ -0,0 +1,150 @@
+package memoria
+
+import (
+	"context"
+	"sort"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// organizacaoDAO implementa dao.OrganizacaoDAO em memória.
+type organizacaoDAO struct {
+	banco *Banco
+}
+
+// NewOrganizacaoDAO cria um OrganizacaoDAO sobre o banco em memória.
+func NewOrganizacaoDAO(banco *Banco) dao.OrganizacaoDAO {
+	return &organizacaoDAO{banco: banco}
+}
+
+// CreateComAdmin registra a organização e seu primeiro administrador. Os conflitos de
+// slug e de email são verificados antes de gravar, para que nunca exista organização
+// sem administrador.
+func (d *organizacaoDAO) CreateComAdmin(ctx context.Context, organizacao *model.Organizacao, admin *model.Usuario) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, o := range b.organizacoes {
+		if o.Slug == organizacao.Slug {
+			return &model.ConflictError{Chave: "organizacao.slug_duplicado"}
+		}
+	}
+	if b.emailEmUso(admin.Email) {
+		return &model.ConflictError{Chave: "usuario.email_indisponivel"}
+	}
+
+	organizacao.Plataforma = false
+	b.inserirOrganizacao(organizacao)
+	admin.OrganizacaoID = organizacao.ID
+	admin.Papel = model.PapelAdmin
+	return b.inserirUsuario(admin)
+}
+
+// FindByID busca uma organização pelo ID.
+func (d *organizacaoDAO) FindByID(ctx context.Context, id int64) (*model.Organizacao, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	o, ok := b.organizacoes[id]
+	if !ok {
+		return nil, &model.ResourceNotFoundError{Resource: "Organização", ID: id}
+	}
+	return &o, nil
+}
+
+// CreateEquipe insere uma nova equipe na organização. O nome é único na organização.
+func (d *organizacaoDAO) CreateEquipe(ctx context.Context, equipe *model.Equipe) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.nomeEquipeEmUso(equipe.OrganizacaoID, 0, equipe.Nome) {
+		return &model.ConflictError{Chave: "equipe.nome_duplicado", Args: []any{equipe.Nome}}
+	}
+	b.proximaEquipeID++
+	equipe.ID = b.proximaEquipeID
+	b.equipes[equipe.ID] = *equipe
+	return nil
+}
+
+// FindEquipeByID busca uma equipe da organização pelo ID.
+func (d *organizacaoDAO) FindEquipeByID(ctx context.Context, organizacaoID, id int64) (*model.Equipe, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	e, ok := b.equipes[id]
+	if !ok || e.OrganizacaoID != organizacaoID {
+		return nil, &model.ResourceNotFoundError{Resource: "Equipe", ID: id}
+	}
+	return &e, nil
+}
+
+// FindEquipes lista as equipes da organização, em ordem alfabética.
+func (d *organizacaoDAO) FindEquipes(ctx context.Context, organizacaoID int64) ([]model.Equipe, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	equipes := []model.Equipe{}
+	for _, e := range b.equipes {
+		if e.OrganizacaoID == organizacaoID {
+			equipes = append(equipes, e)
+		}
+	}
+	sort.Slice(equipes, func(i, j int) bool { return equipes[i].Nome < equipes[j].Nome })
+	return equipes, nil
+}
+
+// UpdateEquipe renomeia uma equipe da organização.
+func (d *organizacaoDAO) UpdateEquipe(ctx context.Context, equipe *model.Equipe) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.equipes[equipe.ID]
+	if !ok || e.OrganizacaoID != equipe.OrganizacaoID {
+		return &model.ResourceNotFoundError{Resource: "Equipe", ID: equipe.ID}
+	}
+	if b.nomeEquipeEmUso(equipe.OrganizacaoID, equipe.ID, equipe.Nome) {
+		return &model.ConflictError{Chave: "equipe.nome_duplicado", Args: []any{equipe.Nome}}
+	}
+	e.Nome = equipe.Nome
+	b.equipes[e.ID] = e
+	return nil
+}
+
+// DeleteEquipe remove uma equipe da organização. Os membros ficam sem equipe.
+func (d *organizacaoDAO) DeleteEquipe(ctx context.Context, organizacaoID, id int64) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.equipes[id]
+	if !ok || e.OrganizacaoID != organizacaoID {
+		return &model.ResourceNotFoundError{Resource: "Equipe", ID: id}
+	}
+	delete(b.equipes, id)
+
+	for usuarioID, u := range b.usuarios {
+		if u.EquipeID != nil && *u.EquipeID == id {
+			u.EquipeID = nil
+			b.usuarios[usuarioID] = u
+		}
+	}
+	return nil
+}
+
+// nomeEquipeEmUso indica se outra equipe da organização (exceto a de ID ignorar) já usa
+// o nome (restrição uq_equipes_organizacao_nome). Deve ser chamada com o lock adquirido.
+func (b *Banco) nomeEquipeEmUso(organizacaoID, ignorar int64, nome string) bool {
+	for _, e := range b.equipes {
+		if e.OrganizacaoID == organizacaoID && e.ID != ignorar && e.Nome == nome {
+			return true
+		}
+	}
+	return false
+}
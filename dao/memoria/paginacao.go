@@ -0,0 +1,256 @@
+package memoria
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"upskilling-api/model"
+)
+
+// listSpec descreve, como em dao.listSpec, os campos ordenáveis e filtráveis de uma
+// listagem. Os nomes públicos e as mensagens de erro são os mesmos do PostgreSQL.
+type listSpec[T any] struct {
+	id          func(T) int64
+	sortable    map[string]func(T) any            // campo público → valor (int64, float64, string ou time.Time)
+	defaultSort string                            // campo público usado quando sort não é informado
+	filters     map[string]func(T) (string, bool) // campo público → valor em texto (false = NULL)
+}
+
+// cursorPayload é o conteúdo (codificado em base64) de um cursor de paginação.
+type cursorPayload struct {
+	Sort  string `json:"s"`
+	Valor string `json:"v"`
+	ID    int64  `json:"id"`
+}
+
+// listar aplica filtros, ordenação, cursor e limit/offset a itens, com a mesma semântica
+// de dao.listar (ordenação pelo campo e, em seguida, pelo ID; cursor keyset).
+func listar[T any](itens []T, spec listSpec[T], params model.ListParams) ([]T, *model.Pagina, error) {
+	// 1. Normalização de limit/offset
+	limit := params.Limit
+	if limit <= 0 {
+		limit = model.DefaultPageLimit
+	}
+	if limit > model.MaxPageLimit {
+		limit = model.MaxPageLimit
+	}
+	offset := params.Offset
+	if offset < 0 || params.Cursor != "" {
+		offset = 0
+	}
+
+	// 2. Ordenação (apenas campos da lista permitida)
+	sortParam := params.Sort
+	if sortParam == "" {
+		sortParam = spec.defaultSort
+	}
+	campo := strings.TrimPrefix(sortParam, "-")
+	desc := strings.HasPrefix(sortParam, "-")
+	valor, ok := spec.sortable[campo]
+	if !ok {
+		return nil, nil, &model.InvalidParameterError{Param: "sort", Chave: "parametro.sort_valores", Args: []any{strings.Join(sortedKeys(spec.sortable), ", ")}}
+	}
+
+	// 3. Filtros (igualdade sem diferenciar maiúsculas)
+	filtrados := make([]T, 0, len(itens))
+	for _, nome := range sortedKeys(params.Filtros) {
+		if params.Filtros[nome] == "" {
+			continue
+		}
+		if _, ok := spec.filters[nome]; !ok {
+			return nil, nil, &model.InvalidParameterError{Param: nome, Chave: "parametro.filtro_nao_suportado"}
+		}
+	}
+	for _, item := range itens {
+		if atendeFiltros(item, spec, params.Filtros) {
+			filtrados = append(filtrados, item)
+		}
+	}
+
+	// 4. Ordenação estável por (campo, id)
+	sort.SliceStable(filtrados, func(i, j int) bool {
+		c := compararItens(valor(filtrados[i]), spec.id(filtrados[i]), valor(filtrados[j]), spec.id(filtrados[j]))
+		if desc {
+			return c > 0
+		}
+		return c < 0
+	})
+	total := len(filtrados)
+
+	// 5. Cursor (keyset): continua a partir do último item da página anterior
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(params.Cursor)
+		if err != nil || cursor.Sort != sortParam {
+			return nil, nil, &model.InvalidParameterError{Param: "cursor", Chave: "parametro.cursor_invalido"}
+		}
+		restantes := filtrados[:0:0]
+		for _, item := range filtrados {
+			c, err := compararComCursor(valor(item), spec.id(item), cursor)
+			if err != nil {
+				return nil, nil, &model.InvalidParameterError{Param: "cursor", Chave: "parametro.cursor_invalido"}
+			}
+			if (!desc && c > 0) || (desc && c < 0) {
+				restantes = append(restantes, item)
+			}
+		}
+		filtrados = restantes
+	}
+
+	// 6. Página
+	if offset > len(filtrados) {
+		offset = len(filtrados)
+	}
+	fim := offset + limit
+	temProxima := fim < len(filtrados)
+	if !temProxima {
+		fim = len(filtrados)
+	}
+	pagina := append(make([]T, 0, fim-offset), filtrados[offset:fim]...)
+
+	meta := &model.Pagina{Total: total, Limit: limit, Offset: offset, Sort: sortParam}
+	if temProxima && len(pagina) > 0 {
+		ultimo := pagina[len(pagina)-1]
+		meta.ProximoCursor = encodeCursor(cursorPayload{Sort: sortParam, Valor: formatarValor(valor(ultimo)), ID: spec.id(ultimo)})
+	}
+	return pagina, meta, nil
+}
+
+// atendeFiltros indica se o item satisfaz todos os filtros informados.
+func atendeFiltros[T any](item T, spec listSpec[T], filtros map[string]string) bool {
+	for nome, esperado := range filtros {
+		if esperado == "" {
+			continue
+		}
+		atual, ok := spec.filters[nome](item)
+		if !ok || !strings.EqualFold(atual, esperado) {
+			return false
+		}
+	}
+	return true
+}
+
+// compararItens compara (valor, id) de dois itens.
+func compararItens(a any, idA int64, b any, idB int64) int {
+	if c := compararValores(a, b); c != 0 {
+		return c
+	}
+	return compararInt(idA, idB)
+}
+
+// compararComCursor compara (valor, id) do item com a posição gravada no cursor.
+func compararComCursor(v any, id int64, cursor *cursorPayload) (int, error) {
+	var c int
+	switch x := v.(type) {
+	case int64:
+		y, err := strconv.ParseInt(cursor.Valor, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		c = compararInt(x, y)
+	case float64:
+		y, err := strconv.ParseFloat(cursor.Valor, 64)
+		if err != nil {
+			return 0, err
+		}
+		c = compararValores(x, y)
+	case time.Time:
+		y, err := time.Parse(time.RFC3339Nano, cursor.Valor)
+		if err != nil {
+			return 0, err
+		}
+		c = compararValores(x, y)
+	default:
+		c = strings.Compare(formatarValor(v), cursor.Valor)
+	}
+	if c != 0 {
+		return c, nil
+	}
+	return compararInt(id, cursor.ID), nil
+}
+
+// compararValores compara dois valores de ordenação do mesmo tipo.
+func compararValores(a, b any) int {
+	switch x := a.(type) {
+	case int64:
+		return compararInt(x, b.(int64))
+	case float64:
+		y := b.(float64)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case time.Time:
+		return x.Compare(b.(time.Time))
+	default:
+		return strings.Compare(formatarValor(a), formatarValor(b))
+	}
+}
+
+func compararInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// formatarValor converte o valor de ordenação para o texto gravado no cursor.
+func formatarValor(v any) string {
+	switch x := v.(type) {
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64)
+	case time.Time:
+		return x.UTC().Format(time.RFC3339Nano)
+	case string:
+		return x
+	}
+	return ""
+}
+
+// encodeCursor serializa o cursor em base64 URL-safe.
+func encodeCursor(c cursorPayload) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor lê um cursor gerado por encodeCursor.
+func decodeCursor(s string) (*cursorPayload, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	c := &cursorPayload{}
+	if err := json.Unmarshal(raw, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// sortedKeys retorna as chaves do mapa em ordem alfabética (mensagens determinísticas).
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// idTexto converte um ID opcional para o texto usado nos filtros (equipe_id::TEXT).
+func idTexto(id *int64) (string, bool) {
+	if id == nil {
+		return "", false
+	}
+	return strconv.FormatInt(*id, 10), true
+}
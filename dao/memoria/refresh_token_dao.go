@@ -0,0 +1,79 @@
+package memoria
+
+import (
+	"context"
+	"time"
+
+	"upskilling-api/dao"
+)
+
+// refreshToken é uma linha de refresh_tokens.
+type refreshToken struct {
+	usuarioID     int64
+	dataExpiracao time.Time
+	revogado      bool
+}
+
+// refreshTokenDAO implementa dao.RefreshTokenDAO em memória.
+type refreshTokenDAO struct {
+	banco *Banco
+}
+
+// NewRefreshTokenDAO cria um RefreshTokenDAO sobre o banco em memória.
+func NewRefreshTokenDAO(banco *Banco) dao.RefreshTokenDAO {
+	return &refreshTokenDAO{banco: banco}
+}
+
+// Create registra um refresh token emitido para o usuário.
+func (d *refreshTokenDAO) Create(ctx context.Context, usuarioID int64, tokenHash string, dataExpiracao time.Time) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refreshTokens[tokenHash] = refreshToken{usuarioID: usuarioID, dataExpiracao: dataExpiracao}
+	return nil
+}
+
+// Consume revoga o token, se ainda válido, e retorna o ID do usuário dono. Retorna 0
+// quando o token não existe, expirou ou já foi revogado.
+func (d *refreshTokenDAO) Consume(ctx context.Context, tokenHash string) (int64, error) {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, ok := b.refreshTokens[tokenHash]
+	if !ok || t.revogado || !t.dataExpiracao.After(time.Now()) {
+		return 0, nil
+	}
+	t.revogado = true
+	b.refreshTokens[tokenHash] = t
+	return t.usuarioID, nil
+}
+
+// Revoke revoga um refresh token. Tokens inexistentes ou já revogados são ignorados.
+func (d *refreshTokenDAO) Revoke(ctx context.Context, tokenHash string) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if t, ok := b.refreshTokens[tokenHash]; ok {
+		t.revogado = true
+		b.refreshTokens[tokenHash] = t
+	}
+	return nil
+}
+
+// RevokeAllByUsuario revoga todos os refresh tokens ativos do usuário.
+func (d *refreshTokenDAO) RevokeAllByUsuario(ctx context.Context, usuarioID int64) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for hash, t := range b.refreshTokens {
+		if t.usuarioID == usuarioID {
+			t.revogado = true
+			b.refreshTokens[hash] = t
+		}
+	}
+	return nil
+}
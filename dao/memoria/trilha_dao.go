@@ -0,0 +1,120 @@
+package memoria
+
+import (
+	"context"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// trilhaListSpec replica a ordenação e os filtros de dao.trilhaListSpec.
+var trilhaListSpec = listSpec[model.Trilha]{
+	id:          func(t model.Trilha) int64 { return t.ID },
+	defaultSort: "id",
+	sortable: map[string]func(model.Trilha) any{
+		"id":            func(t model.Trilha) any { return t.ID },
+		"nome":          func(t model.Trilha) any { return t.Nome },
+		"nivel":         func(t model.Trilha) any { return t.Nivel },
+		"carga_horaria": func(t model.Trilha) any { return int64(t.CargaHoraria) },
+	},
+	filters: map[string]func(model.Trilha) (string, bool){
+		"nivel":          func(t model.Trilha) (string, bool) { return t.Nivel, true },
+		"foco_principal": func(t model.Trilha) (string, bool) { return t.FocoPrincipal, true },
+	},
+}
+
+// trilhaDAO implementa dao.TrilhaDAO em memória.
+type trilhaDAO struct {
+	banco *Banco
+}
+
+// NewTrilhaDAO cria um TrilhaDAO sobre o banco em memória.
+func NewTrilhaDAO(banco *Banco) dao.TrilhaDAO {
+	return &trilhaDAO{banco: banco}
+}
+
+// Create insere uma nova trilha (organizacao_id nil = catálogo público).
+func (d *trilhaDAO) Create(ctx context.Context, trilha *model.Trilha) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.proximaTrilhaID++
+	trilha.ID = b.proximaTrilhaID
+	b.trilhas[trilha.ID] = copiarTrilha(*trilha)
+	return nil
+}
+
+// FindByID busca uma trilha visível para a organização pelo ID.
+func (d *trilhaDAO) FindByID(ctx context.Context, organizacaoID, id int64) (*model.Trilha, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	t, ok := b.trilhas[id]
+	if !ok || !trilhaVisivel(t, organizacaoID) {
+		return nil, &model.ResourceNotFoundError{Resource: "Trilha", ID: id}
+	}
+	t = copiarTrilha(t)
+	return &t, nil
+}
+
+// FindAll busca uma página das trilhas visíveis para a organização.
+func (d *trilhaDAO) FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Trilha, *model.Pagina, error) {
+	b := d.banco
+	b.mu.RLock()
+	trilhas := make([]model.Trilha, 0, len(b.trilhas))
+	for _, t := range b.trilhas {
+		if trilhaVisivel(t, organizacaoID) {
+			trilhas = append(trilhas, copiarTrilha(t))
+		}
+	}
+	b.mu.RUnlock()
+
+	return listar(trilhas, trilhaListSpec, params)
+}
+
+// Update atualiza uma trilha visível para a organização (a organização dona não muda).
+func (d *trilhaDAO) Update(ctx context.Context, organizacaoID int64, trilha *model.Trilha) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, ok := b.trilhas[trilha.ID]
+	if !ok || !trilhaVisivel(t, organizacaoID) {
+		return &model.ResourceNotFoundError{Resource: "Trilha", ID: trilha.ID}
+	}
+	t.Nome = trilha.Nome
+	t.Descricao = trilha.Descricao
+	t.Nivel = trilha.Nivel
+	t.CargaHoraria = trilha.CargaHoraria
+	t.FocoPrincipal = trilha.FocoPrincipal
+	b.trilhas[t.ID] = t
+	return nil
+}
+
+// Delete remove uma trilha visível para a organização e, em cascata, suas matrículas.
+func (d *trilhaDAO) Delete(ctx context.Context, organizacaoID, id int64) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, ok := b.trilhas[id]
+	if !ok || !trilhaVisivel(t, organizacaoID) {
+		return &model.ResourceNotFoundError{Resource: "Trilha", ID: id}
+	}
+	delete(b.trilhas, id)
+
+	for matriculaID, m := range b.matriculas {
+		if m.TrilhaID == id {
+			delete(b.matriculas, matriculaID)
+		}
+	}
+	return nil
+}
+
+// copiarTrilha copia a trilha sem compartilhar a organização dona.
+func copiarTrilha(t model.Trilha) model.Trilha {
+	t.OrganizacaoID = clonarID(t.OrganizacaoID)
+	return t
+}
@@ -0,0 +1,236 @@
+package memoria
+
+import (
+	"context"
+	"time"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// usuarioListSpec replica a ordenação e os filtros de dao.usuarioListSpec.
+var usuarioListSpec = listSpec[model.Usuario]{
+	id:          func(u model.Usuario) int64 { return u.ID },
+	defaultSort: "id",
+	sortable: map[string]func(model.Usuario) any{
+		"id":            func(u model.Usuario) any { return u.ID },
+		"nome":          func(u model.Usuario) any { return u.Nome },
+		"email":         func(u model.Usuario) any { return u.Email },
+		"data_cadastro": func(u model.Usuario) any { return u.DataCadastro },
+	},
+	filters: map[string]func(model.Usuario) (string, bool){
+		"area_atuacao":   func(u model.Usuario) (string, bool) { return u.AreaAtuacao, true },
+		"nivel_carreira": func(u model.Usuario) (string, bool) { return u.NivelCarreira, true },
+		"papel":          func(u model.Usuario) (string, bool) { return u.Papel, true },
+		"equipe_id":      func(u model.Usuario) (string, bool) { return idTexto(u.EquipeID) },
+		"gestor_id":      func(u model.Usuario) (string, bool) { return idTexto(u.GestorID) },
+	},
+}
+
+// usuarioDAO implementa dao.UsuarioDAO em memória.
+type usuarioDAO struct {
+	banco *Banco
+}
+
+// NewUsuarioDAO cria um UsuarioDAO sobre o banco em memória.
+func NewUsuarioDAO(banco *Banco) dao.UsuarioDAO {
+	return &usuarioDAO{banco: banco}
+}
+
+// Create insere o usuário. O email é único em toda a plataforma.
+func (d *usuarioDAO) Create(ctx context.Context, usuario *model.Usuario) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.inserirUsuario(usuario)
+}
+
+// inserirUsuario implementa Create. Deve ser chamada com o lock adquirido.
+func (b *Banco) inserirUsuario(usuario *model.Usuario) error {
+	if b.emailEmUso(usuario.Email) {
+		return &model.ConflictError{Chave: "usuario.email_indisponivel"}
+	}
+
+	b.proximoUsuarioID++
+	usuario.ID = b.proximoUsuarioID
+	usuario.DataCadastro = time.Now()
+	if usuario.Papel == "" {
+		usuario.Papel = model.PapelLearner
+	}
+	b.usuarios[usuario.ID] = copiarUsuario(*usuario)
+	return nil
+}
+
+// FindByID busca um usuário da organização pelo ID.
+func (d *usuarioDAO) FindByID(ctx context.Context, organizacaoID, id int64) (*model.Usuario, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	u, ok := b.usuarios[id]
+	if !ok || u.OrganizacaoID != organizacaoID {
+		return nil, &model.ResourceNotFoundError{Resource: "Usuário", ID: id}
+	}
+	u = copiarUsuario(u)
+	u.SenhaHash = ""
+	return &u, nil
+}
+
+// FindAutenticado busca o usuário em qualquer organização, indicando se ele pertence à
+// organização da plataforma.
+func (d *usuarioDAO) FindAutenticado(ctx context.Context, id int64) (*model.Usuario, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	u, ok := b.usuarios[id]
+	if !ok {
+		return nil, &model.ResourceNotFoundError{Resource: "Usuário", ID: id}
+	}
+	u = copiarUsuario(u)
+	u.SenhaHash = ""
+	u.OrganizacaoPlataforma = b.organizacoes[u.OrganizacaoID].Plataforma
+	return &u, nil
+}
+
+// FindByEmail busca um usuário pelo email, incluindo o hash da senha. Retorna nil, nil
+// se não encontrar.
+func (d *usuarioDAO) FindByEmail(ctx context.Context, email string) (*model.Usuario, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, u := range b.usuarios {
+		if u.Email == email {
+			u = copiarUsuario(u)
+			return &u, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindAll busca uma página dos usuários da organização.
+func (d *usuarioDAO) FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Usuario, *model.Pagina, error) {
+	b := d.banco
+	b.mu.RLock()
+	usuarios := make([]model.Usuario, 0, len(b.usuarios))
+	for _, u := range b.usuarios {
+		if u.OrganizacaoID == organizacaoID {
+			u = copiarUsuario(u)
+			u.SenhaHash = ""
+			usuarios = append(usuarios, u)
+		}
+	}
+	b.mu.RUnlock()
+
+	return listar(usuarios, usuarioListSpec, params)
+}
+
+// Update atualiza nome, área, nível, equipe e gestor do usuário da organização. O hash
+// da senha só é alterado quando informado; email e papel não mudam.
+func (d *usuarioDAO) Update(ctx context.Context, organizacaoID int64, usuario *model.Usuario) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	u, ok := b.usuarios[usuario.ID]
+	if !ok || u.OrganizacaoID != organizacaoID {
+		return &model.ResourceNotFoundError{Resource: "Usuário", ID: usuario.ID}
+	}
+	u.Nome = usuario.Nome
+	u.AreaAtuacao = usuario.AreaAtuacao
+	u.NivelCarreira = usuario.NivelCarreira
+	if usuario.SenhaHash != "" {
+		u.SenhaHash = usuario.SenhaHash
+	}
+	u.EquipeID = clonarID(usuario.EquipeID)
+	u.GestorID = clonarID(usuario.GestorID)
+	b.usuarios[u.ID] = u
+	return nil
+}
+
+// UpdatePapel altera o papel de um usuário da organização.
+func (d *usuarioDAO) UpdatePapel(ctx context.Context, organizacaoID, id int64, papel string) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	u, ok := b.usuarios[id]
+	if !ok || u.OrganizacaoID != organizacaoID {
+		return &model.ResourceNotFoundError{Resource: "Usuário", ID: id}
+	}
+	u.Papel = papel
+	b.usuarios[id] = u
+	return nil
+}
+
+// CriaCicloGestor indica se usuarioID já é (direta ou indiretamente) gestor de gestorID.
+func (d *usuarioDAO) CriaCicloGestor(ctx context.Context, usuarioID, gestorID int64) (bool, error) {
+	b := d.banco
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	visitados := make(map[int64]bool)
+	for atual := gestorID; !visitados[atual]; {
+		if atual == usuarioID {
+			return true, nil
+		}
+		visitados[atual] = true
+		u, ok := b.usuarios[atual]
+		if !ok || u.GestorID == nil {
+			return false, nil
+		}
+		atual = *u.GestorID
+	}
+	return false, nil
+}
+
+// Delete remove um usuário da organização. Como no esquema, as matrículas e os refresh
+// tokens do usuário são removidos e seus liderados ficam sem gestor.
+func (d *usuarioDAO) Delete(ctx context.Context, organizacaoID, id int64) error {
+	b := d.banco
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	u, ok := b.usuarios[id]
+	if !ok || u.OrganizacaoID != organizacaoID {
+		return &model.ResourceNotFoundError{Resource: "Usuário", ID: id}
+	}
+	delete(b.usuarios, id)
+
+	for lideradoID, liderado := range b.usuarios {
+		if liderado.GestorID != nil && *liderado.GestorID == id {
+			liderado.GestorID = nil
+			b.usuarios[lideradoID] = liderado
+		}
+	}
+	for matriculaID, m := range b.matriculas {
+		if m.UsuarioID == id {
+			delete(b.matriculas, matriculaID)
+		}
+	}
+	for hash, t := range b.refreshTokens {
+		if t.usuarioID == id {
+			delete(b.refreshTokens, hash)
+		}
+	}
+	return nil
+}
+
+// copiarUsuario copia o usuário sem compartilhar os campos opcionais.
+func copiarUsuario(u model.Usuario) model.Usuario {
+	u.EquipeID = clonarID(u.EquipeID)
+	u.GestorID = clonarID(u.GestorID)
+	return u
+}
+
+// emailEmUso indica se algum usuário, de qualquer organização, já usa o email (restrição
+// usuarios_email_key). Deve ser chamada com o lock adquirido.
+func (b *Banco) emailEmUso(email string) bool {
+	for _, u := range b.usuarios {
+		if u.Email == email {
+			return true
+		}
+	}
+	return false
+}
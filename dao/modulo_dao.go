@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"upskilling-api/model"
+)
+
+// ModuloDAO é a interface para as operações de acesso a dados de Módulo.
+type ModuloDAO interface {
+	Create(ctx context.Context, modulo *model.Modulo) error
+	FindByID(ctx context.Context, id int64) (*model.Modulo, error)
+	FindByTrilhaID(ctx context.Context, trilhaID int64) ([]model.Modulo, error)
+	Update(ctx context.Context, modulo *model.Modulo) error
+	Delete(ctx context.Context, id int64) error
+	RecalcularCargaHoraria(ctx context.Context, trilhaID int64) error
+}
+
+// moduloDAOImpl implementa a interface ModuloDAO.
+type moduloDAOImpl struct {
+	*Conexao
+}
+
+// NewModuloDAO cria uma nova instância de ModuloDAO.
+func NewModuloDAO(conexao *Conexao) ModuloDAO {
+	return &moduloDAOImpl{Conexao: conexao}
+}
+
+// Create insere um novo módulo. Sem ordem informada, o módulo é posicionado após o último.
+func (d *moduloDAOImpl) Create(ctx context.Context, modulo *model.Modulo) error {
+	query := `
+		INSERT INTO modulos (trilha_id, titulo, descricao, ordem)
+		VALUES ($1, $2, $3, CASE WHEN $4 > 0 THEN $4 ELSE
+			(SELECT COALESCE(MAX(ordem), 0) + 1 FROM modulos WHERE trilha_id = $1) END)
+		RETURNING id, ordem
+	`
+	err := d.querier(ctx).QueryRowContext(ctx,
+		query,
+		modulo.TrilhaID,
+		modulo.Titulo,
+		modulo.Descricao,
+		modulo.Ordem,
+	).Scan(&modulo.ID, &modulo.Ordem)
+
+	if err != nil {
+		return traduzirErro(err, "criar módulo")
+	}
+	return nil
+}
+
+// FindByID busca um módulo pelo ID.
+func (d *moduloDAOImpl) FindByID(ctx context.Context, id int64) (*model.Modulo, error) {
+	modulo := &model.Modulo{}
+	query := `
+		SELECT id, trilha_id, titulo, COALESCE(descricao, ''), ordem
+		FROM modulos
+		WHERE id = $1
+	`
+	err := d.querier(ctx).QueryRowContext(ctx, query, id).Scan(
+		&modulo.ID,
+		&modulo.TrilhaID,
+		&modulo.Titulo,
+		&modulo.Descricao,
+		&modulo.Ordem,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &model.ResourceNotFoundError{Resource: "Módulo", ID: id}
+		}
+		return nil, traduzirErro(err, "buscar módulo por ID")
+	}
+	return modulo, nil
+}
+
+// FindByTrilhaID busca os módulos de uma trilha em ordem.
+func (d *moduloDAOImpl) FindByTrilhaID(ctx context.Context, trilhaID int64) ([]model.Modulo, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT id, trilha_id, titulo, COALESCE(descricao, ''), ordem
+		FROM modulos
+		WHERE trilha_id = $1
+		ORDER BY ordem, id
+	`, trilhaID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar módulos da trilha")
+	}
+	defer rows.Close()
+
+	modulos := make([]model.Modulo, 0)
+	for rows.Next() {
+		modulo := model.Modulo{}
+		err := rows.Scan(
+			&modulo.ID,
+			&modulo.TrilhaID,
+			&modulo.Titulo,
+			&modulo.Descricao,
+			&modulo.Ordem,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de módulo")
+		}
+		modulos = append(modulos, modulo)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar módulos")
+	}
+
+	return modulos, nil
+}
+
+// Update atualiza um módulo existente.
+func (d *moduloDAOImpl) Update(ctx context.Context, modulo *model.Modulo) error {
+	query := `
+		UPDATE modulos
+		SET titulo = $2, descricao = $3, ordem = $4
+		WHERE id = $1
+	`
+	result, err := d.querier(ctx).ExecContext(ctx,
+		query,
+		modulo.ID,
+		modulo.Titulo,
+		modulo.Descricao,
+		modulo.Ordem,
+	)
+	if err != nil {
+		return traduzirErro(err, "atualizar módulo")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Módulo", ID: modulo.ID}
+	}
+
+	return nil
+}
+
+// Delete remove um módulo (e, em cascata, suas aulas) pelo ID.
+func (d *moduloDAOImpl) Delete(ctx context.Context, id int64) error {
+	result, err := d.querier(ctx).ExecContext(ctx, "DELETE FROM modulos WHERE id = $1", id)
+	if err != nil {
+		return traduzirErro(err, "deletar módulo")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Módulo", ID: id}
+	}
+
+	return nil
+}
+
+// RecalcularCargaHoraria deriva a carga horária da trilha a partir da duração das aulas
+// de seus módulos (arredondada para cima, em horas). Trilhas sem aulas mantêm a carga
+// horária informada manualmente.
+func (d *moduloDAOImpl) RecalcularCargaHoraria(ctx context.Context, trilhaID int64) error {
+	_, err := d.querier(ctx).ExecContext(ctx, `
+		UPDATE trilhas t
+		SET carga_horaria = CEIL(c.minutos / 60.0)::INT
+		FROM (
+			SELECT COALESCE(SUM(a.duracao_minutos), 0) AS minutos
+			FROM aulas a
+			JOIN modulos m ON m.id = a.modulo_id
+			WHERE m.trilha_id = $1
+		) c
+		WHERE t.id = $1 AND c.minutos > 0
+	`, trilhaID)
+	if err != nil {
+		return traduzirErro(err, "recalcular carga horária da trilha")
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"upskilling-api/model"
+)
+
+// Constraints únicas tratadas como conflito.
+const (
+	uqOrganizacaoSlug       = "organizacoes_slug_key"
+	uqEquipeOrganizacaoNome = "uq_equipes_organizacao_nome"
+)
+
+// OrganizacaoDAO é a interface para as operações de acesso a dados de Organização e Equipe.
+type OrganizacaoDAO interface {
+	CreateComAdmin(ctx context.Context, organizacao *model.Organizacao, admin *model.Usuario) error
+	FindByID(ctx context.Context, id int64) (*model.Organizacao, error)
+
+	CreateEquipe(ctx context.Context, equipe *model.Equipe) error
+	FindEquipeByID(ctx context.Context, organizacaoID, id int64) (*model.Equipe, error)
+	FindEquipes(ctx context.Context, organizacaoID int64) ([]model.Equipe, error)
+	UpdateEquipe(ctx context.Context, equipe *model.Equipe) error
+	DeleteEquipe(ctx context.Context, organizacaoID, id int64) error
+}
+
+// organizacaoDAOImpl implementa a interface OrganizacaoDAO.
+type organizacaoDAOImpl struct {
+	*Conexao
+}
+
+// NewOrganizacaoDAO cria uma nova instância de OrganizacaoDAO.
+func NewOrganizacaoDAO(conexao *Conexao) OrganizacaoDAO {
+	return &organizacaoDAOImpl{Conexao: conexao}
+}
+
+// CreateComAdmin registra a organização e seu primeiro administrador. Deve ser chamado
+// dentro de uma unidade de trabalho (service.WithTx), para que nunca exista organização
+// sem administrador.
+func (d *organizacaoDAOImpl) CreateComAdmin(ctx context.Context, organizacao *model.Organizacao, admin *model.Usuario) error {
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		INSERT INTO organizacoes (nome, slug)
+		VALUES ($1, $2)
+		RETURNING id, plataforma, data_criacao
+	`, organizacao.Nome, organizacao.Slug).Scan(&organizacao.ID, &organizacao.Plataforma, &organizacao.DataCriacao)
+	if err != nil {
+		return traduzirErro(err, "criar organização")
+	}
+
+	admin.OrganizacaoID = organizacao.ID
+	admin.Papel = model.PapelAdmin
+	return insertUsuario(ctx, d.querier(ctx), admin)
+}
+
+// FindByID busca uma organização pelo ID.
+func (d *organizacaoDAOImpl) FindByID(ctx context.Context, id int64) (*model.Organizacao, error) {
+	organizacao := &model.Organizacao{}
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		SELECT id, nome, slug, plataforma, data_criacao
+		FROM organizacoes
+		WHERE id = $1
+	`, id).Scan(
+		&organizacao.ID,
+		&organizacao.Nome,
+		&organizacao.Slug,
+		&organizacao.Plataforma,
+		&organizacao.DataCriacao,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &model.ResourceNotFoundError{Resource: "Organização", ID: id}
+		}
+		return nil, traduzirErro(err, "buscar organização por ID")
+	}
+	return organizacao, nil
+}
+
+// CreateEquipe insere uma nova equipe na organização.
+func (d *organizacaoDAOImpl) CreateEquipe(ctx context.Context, equipe *model.Equipe) error {
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		INSERT INTO equipes (organizacao_id, nome)
+		VALUES ($1, $2)
+		RETURNING id
+	`, equipe.OrganizacaoID, equipe.Nome).Scan(&equipe.ID)
+
+	if err != nil {
+		if violaConstraint(err, uqEquipeOrganizacaoNome) {
+			return equipeConflict(equipe.Nome)
+		}
+		return traduzirErro(err, "criar equipe")
+	}
+	return nil
+}
+
+// FindEquipeByID busca uma equipe da organização pelo ID.
+func (d *organizacaoDAOImpl) FindEquipeByID(ctx context.Context, organizacaoID, id int64) (*model.Equipe, error) {
+	equipe := &model.Equipe{}
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		SELECT id, organizacao_id, nome
+		FROM equipes
+		WHERE id = $1 AND organizacao_id = $2
+	`, id, organizacaoID).Scan(&equipe.ID, &equipe.OrganizacaoID, &equipe.Nome)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &model.ResourceNotFoundError{Resource: "Equipe", ID: id}
+		}
+		return nil, traduzirErro(err, "buscar equipe por ID")
+	}
+	return equipe, nil
+}
+
+// FindEquipes lista as equipes da organização, em ordem alfabética.
+func (d *organizacaoDAOImpl) FindEquipes(ctx context.Context, organizacaoID int64) ([]model.Equipe, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT id, organizacao_id, nome
+		FROM equipes
+		WHERE organizacao_id = $1
+		ORDER BY nome
+	`, organizacaoID)
+	if err != nil {
+		return nil, traduzirErro(err, "listar equipes")
+	}
+	defer rows.Close()
+
+	equipes := []model.Equipe{}
+	for rows.Next() {
+		var equipe model.Equipe
+		if err := rows.Scan(&equipe.ID, &equipe.OrganizacaoID, &equipe.Nome); err != nil {
+			return nil, traduzirErro(err, "ler equipe")
+		}
+		equipes = append(equipes, equipe)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar equipes")
+	}
+	return equipes, nil
+}
+
+// UpdateEquipe renomeia uma equipe da organização.
+func (d *organizacaoDAOImpl) UpdateEquipe(ctx context.Context, equipe *model.Equipe) error {
+	result, err := d.querier(ctx).ExecContext(ctx,
+		"UPDATE equipes SET nome = $3 WHERE id = $1 AND organizacao_id = $2",
+		equipe.ID, equipe.OrganizacaoID, equipe.Nome,
+	)
+	if err != nil {
+		if violaConstraint(err, uqEquipeOrganizacaoNome) {
+			return equipeConflict(equipe.Nome)
+		}
+		return traduzirErro(err, "atualizar equipe")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Equipe", ID: equipe.ID}
+	}
+	return nil
+}
+
+// DeleteEquipe remove uma equipe da organização. Os membros ficam sem equipe.
+func (d *organizacaoDAOImpl) DeleteEquipe(ctx context.Context, organizacaoID, id int64) error {
+	result, err := d.querier(ctx).ExecContext(ctx, "DELETE FROM equipes WHERE id = $1 AND organizacao_id = $2", id, organizacaoID)
+	if err != nil {
+		return traduzirErro(err, "deletar equipe")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Equipe", ID: id}
+	}
+	return nil
+}
+
+// equipeConflict monta o erro de conflito para nome de equipe duplicado.
+func equipeConflict(nome string) error {
+	return &model.ConflictError{Chave: "equipe.nome_duplicado", Args: []any{nome}}
+}
@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"upskilling-api/model"
+)
+
+// listSpec descreve como uma tabela pode ser listada: colunas retornadas, campos
+// ordenáveis e campos filtráveis. Apenas nomes presentes nos mapas chegam ao SQL.
+type listSpec struct {
+	from        string            // tabela (com alias, se necessário)
+	columns     string            // colunas selecionadas, na ordem do scan
+	idColumn    string            // coluna de desempate e de cursor
+	sortable    map[string]string // campo público → coluna
+	defaultSort string            // campo público usado quando sort não é informado
+	filters     map[string]string // campo público → coluna (igualdade sem diferenciar maiúsculas)
+	where       []string          // condições fixas (ex: "m.usuario_id = $1")
+	whereArgs   []any             // argumentos das condições fixas
+}
+
+// cursorPayload é o conteúdo (codificado em base64) de um cursor de paginação.
+type cursorPayload struct {
+	Sort  string `json:"s"`
+	Valor string `json:"v"`
+	ID    int64  `json:"id"`
+}
+
+// listar executa a listagem paginada descrita por spec. A função scan deve ler as
+// colunas de spec.columns seguidas do valor de ordenação (em texto) e do ID, usados
+// para montar o próximo cursor.
+func listar[T any](ctx context.Context, q Querier, spec listSpec, params model.ListParams, scan func(rows *sql.Rows, sortValue *string, id *int64) (T, error)) ([]T, *model.Pagina, error) {
+	// 1. Normalização de limit/offset
+	limit := params.Limit
+	if limit <= 0 {
+		limit = model.DefaultPageLimit
+	}
+	if limit > model.MaxPageLimit {
+		limit = model.MaxPageLimit
+	}
+	offset := params.Offset
+	if offset < 0 || params.Cursor != "" {
+		offset = 0
+	}
+
+	// 2. Ordenação (apenas campos da lista permitida)
+	sortParam := params.Sort
+	if sortParam == "" {
+		sortParam = spec.defaultSort
+	}
+	campo := strings.TrimPrefix(sortParam, "-")
+	desc := strings.HasPrefix(sortParam, "-")
+	sortColumn, ok := spec.sortable[campo]
+	if !ok {
+		return nil, nil, &model.InvalidParameterError{Param: "sort", Chave: "parametro.sort_valores", Args: []any{strings.Join(sortedKeys(spec.sortable), ", ")}}
+	}
+	direction, comparator := "ASC", ">"
+	if desc {
+		direction, comparator = "DESC", "<"
+	}
+
+	// 3. Filtros
+	conditions := append([]string{}, spec.where...)
+	args := append([]any{}, spec.whereArgs...)
+	for _, nome := range sortedKeys(params.Filtros) {
+		valor := params.Filtros[nome]
+		if valor == "" {
+			continue
+		}
+		column, ok := spec.filters[nome]
+		if !ok {
+			return nil, nil, &model.InvalidParameterError{Param: nome, Chave: "parametro.filtro_nao_suportado"}
+		}
+		args = append(args, valor)
+		conditions = append(conditions, fmt.Sprintf("LOWER(%s) = LOWER($%d)", column, len(args)))
+	}
+
+	// 4. Total de registros (sem paginação)
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", spec.from, whereClause)
+	if err := q.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, nil, traduzirErro(err, "contar registros de "+spec.from)
+	}
+
+	// 5. Cursor (keyset): continua a partir do último item da página anterior
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(params.Cursor)
+		if err != nil || cursor.Sort != sortParam {
+			return nil, nil, &model.InvalidParameterError{Param: "cursor", Chave: "parametro.cursor_invalido"}
+		}
+		args = append(args, cursor.Valor, cursor.ID)
+		conditions = append(conditions, fmt.Sprintf("(%s, %s) %s ($%d, $%d)", sortColumn, spec.idColumn, comparator, len(args)-1, len(args)))
+		whereClause = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	// 6. Consulta da página (limit+1 para saber se há próxima página)
+	args = append(args, limit+1, offset)
+	query := fmt.Sprintf(
+		"SELECT %s, (%s)::TEXT, %s FROM %s %s ORDER BY %s %s, %s %s LIMIT $%d OFFSET $%d",
+		spec.columns, sortColumn, spec.idColumn, spec.from, whereClause,
+		sortColumn, direction, spec.idColumn, direction, len(args)-1, len(args),
+	)
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, traduzirErro(err, "listar "+spec.from)
+	}
+	defer rows.Close()
+
+	items := make([]T, 0, limit)
+	var ultimoValor string
+	var ultimoID int64
+	temProxima := false
+	for rows.Next() {
+		if len(items) == limit {
+			temProxima = true
+			break
+		}
+		var sortValue string
+		var id int64
+		item, err := scan(rows, &sortValue, &id)
+		if err != nil {
+			return nil, nil, traduzirErro(err, "escanear linha de "+spec.from)
+		}
+		items = append(items, item)
+		ultimoValor, ultimoID = sortValue, id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, traduzirErro(err, "iterar linhas de "+spec.from)
+	}
+
+	pagina := &model.Pagina{Total: total, Limit: limit, Offset: offset, Sort: sortParam}
+	if temProxima {
+		pagina.ProximoCursor = encodeCursor(cursorPayload{Sort: sortParam, Valor: ultimoValor, ID: ultimoID})
+	}
+	return items, pagina, nil
+}
+
+// encodeCursor serializa o cursor em base64 URL-safe.
+func encodeCursor(c cursorPayload) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeCursor lê um cursor gerado por encodeCursor.
+func decodeCursor(s string) (*cursorPayload, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	c := &cursorPayload{}
+	if err := json.Unmarshal(raw, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// sortedKeys retorna as chaves do mapa em ordem alfabética (mensagens e SQL determinísticos).
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestCursor(t *testing.T) {
+	casos := []cursorPayload{
+		{Sort: "id", Valor: "42", ID: 42},
+		{Sort: "-nome", Valor: "Análise de Dados & IA", ID: 7},
+		{Sort: "data_inscricao", Valor: "2024-03-01 10:00:00+00", ID: 1},
+		{Sort: "nome", Valor: "", ID: 0},
+		{Sort: "nome", Valor: `aspas "duplas", barras /\ e +sinais?`, ID: 9007199254740993},
+	}
+	for _, c := range casos {
+		cursor := encodeCursor(c)
+		if strings.ContainsAny(cursor, "+/=") {
+			t.Fatalf("cursor %q não é base64 URL-safe sem padding", cursor)
+		}
+		lido, err := decodeCursor(cursor)
+		if err != nil {
+			t.Fatalf("decodeCursor(%q): %v", cursor, err)
+		}
+		if *lido != c {
+			t.Fatalf("decodeCursor(encodeCursor(%+v)) = %+v", c, *lido)
+		}
+	}
+}
+
+func TestDecodeCursorInvalido(t *testing.T) {
+	casos := []struct {
+		nome, cursor string
+	}{
+		{"base64 inválido", "!!!"},
+		{"base64 com padding", base64.URLEncoding.EncodeToString([]byte(`{"s":"id","v":"1","id":1}`))},
+		{"conteúdo que não é JSON", base64.RawURLEncoding.EncodeToString([]byte("id:1"))},
+		{"JSON com tipo errado", base64.RawURLEncoding.EncodeToString([]byte(`{"s":"id","v":"1","id":"um"}`))},
+	}
+	for _, c := range casos {
+		if lido, err := decodeCursor(c.cursor); err == nil {
+			t.Fatalf("%s: decodeCursor(%q) = %+v, esperado erro", c.nome, c.cursor, lido)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"upskilling-api/model"
+)
+
+// Expressões SQL compartilhadas pelas consultas do painel (alias m = matriculas, t = trilhas).
+var (
+	// percentualMatricula calcula o progresso da matrícula, limitado a 100; concluídas valem 100.
+	percentualMatricula = fmt.Sprintf(
+		"CASE WHEN m.status = '%s' THEN 100 WHEN t.carga_horaria > 0 THEN LEAST(100, m.horas_estudadas / t.carga_horaria * 100) ELSE 0 END",
+		model.StatusMatriculaConcluida,
+	)
+	// matriculaAtrasada indica matrícula ATIVA com prazo vencido (sem prazo, nunca atrasa).
+	matriculaAtrasada = fmt.Sprintf("COALESCE(m.status = '%s' AND m.data_prazo < CURRENT_DATE, FALSE)", model.StatusMatriculaAtiva)
+)
+
+// PainelGestorDAO é a interface para as consultas agregadas do painel do gestor.
+// As consultas consideram apenas os liderados diretos do gestor na organização.
+type PainelGestorDAO interface {
+	ResumoLiderados(ctx context.Context, organizacaoID, gestorID int64) ([]model.ResumoLiderado, error)
+	FindMatriculasLiderados(ctx context.Context, organizacaoID, gestorID int64, params model.ListParams) ([]model.MatriculaLiderado, *model.Pagina, error)
+}
+
+// painelGestorDAOImpl implementa a interface PainelGestorDAO.
+type painelGestorDAOImpl struct {
+	*Conexao
+}
+
+// NewPainelGestorDAO cria uma nova instância de PainelGestorDAO.
+func NewPainelGestorDAO(conexao *Conexao) PainelGestorDAO {
+	return &painelGestorDAOImpl{Conexao: conexao}
+}
+
+// ResumoLiderados agrega, em uma única consulta, as matrículas de cada liderado direto do
+// gestor: contagem por status, atrasadas, horas estudadas, progresso médio das ATIVAS e
+// última atividade. Liderados sem matrículas aparecem com os indicadores zerados.
+func (d *painelGestorDAOImpl) ResumoLiderados(ctx context.Context, organizacaoID, gestorID int64) ([]model.ResumoLiderado, error) {
+	query := fmt.Sprintf(`
+		SELECT u.id, u.nome, u.email, u.equipe_id,
+		       COUNT(m.id),
+		       COUNT(m.id) FILTER (WHERE m.status = '%[1]s'),
+		       COUNT(m.id) FILTER (WHERE m.status = '%[2]s'),
+		       COUNT(m.id) FILTER (WHERE m.status = '%[3]s'),
+		       COUNT(m.id) FILTER (WHERE %[4]s),
+		       COALESCE(SUM(m.horas_estudadas), 0),
+		       COALESCE(AVG(%[5]s) FILTER (WHERE m.status = '%[1]s'), 0),
+		       MAX(m.data_ultima_atividade)
+		FROM usuarios u
+		LEFT JOIN matriculas m ON m.usuario_id = u.id
+		LEFT JOIN trilhas t ON t.id = m.trilha_id
+		WHERE u.gestor_id = $1 AND u.organizacao_id = $2
+		GROUP BY u.id
+		ORDER BY u.nome, u.id
+	`, model.StatusMatriculaAtiva, model.StatusMatriculaConcluida, model.StatusMatriculaCancelada,
+		matriculaAtrasada, percentualMatricula)
+
+	rows, err := d.querier(ctx).QueryContext(ctx, query, gestorID, organizacaoID)
+	if err != nil {
+		return nil, traduzirErro(err, "resumir matrículas dos liderados")
+	}
+	defer rows.Close()
+
+	liderados := []model.ResumoLiderado{}
+	for rows.Next() {
+		var r model.ResumoLiderado
+		err := rows.Scan(
+			&r.UsuarioID,
+			&r.Nome,
+			&r.Email,
+			&r.EquipeID,
+			&r.TotalMatriculas,
+			&r.MatriculasAtivas,
+			&r.MatriculasConcluidas,
+			&r.MatriculasCanceladas,
+			&r.MatriculasAtrasadas,
+			&r.HorasEstudadas,
+			&r.ProgressoMedio,
+			&r.DataUltimaAtividade,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "ler resumo do liderado")
+		}
+		liderados = append(liderados, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar resumo dos liderados")
+	}
+	return liderados, nil
+}
+
+// matriculaLideradoListSpec define a listagem das matrículas dos liderados, com trilha e
+// progresso calculados na própria consulta.
+var matriculaLideradoListSpec = listSpec{
+	from: "matriculas m JOIN usuarios u ON u.id = m.usuario_id JOIN trilhas t ON t.id = m.trilha_id",
+	columns: `m.id, u.id, u.nome, t.id, t.nome, m.status, m.data_inscricao, m.data_prazo, m.data_conclusao,
+		m.horas_estudadas, t.carga_horaria, ` + percentualMatricula + `, ` + matriculaAtrasada + `, m.data_ultima_atividade`,
+	idColumn:    "m.id",
+	defaultSort: "data_inscricao",
+	sortable: map[string]string{
+		"id":              "m.id",
+		"data_inscricao":  "m.data_inscricao",
+		"horas_estudadas": "m.horas_estudadas",
+		"nome":            "u.nome",
+	},
+	filters: map[string]string{
+		"status":     "m.status",
+		"usuario_id": "u.id::TEXT",
+		"trilha_id":  "t.id::TEXT",
+		"atrasada":   matriculaAtrasada + "::TEXT",
+	},
+}
+
+// FindMatriculasLiderados busca uma página das matrículas dos liderados diretos do gestor.
+func (d *painelGestorDAOImpl) FindMatriculasLiderados(ctx context.Context, organizacaoID, gestorID int64, params model.ListParams) ([]model.MatriculaLiderado, *model.Pagina, error) {
+	spec := matriculaLideradoListSpec
+	spec.where = []string{"u.gestor_id = $1", "u.organizacao_id = $2"}
+	spec.whereArgs = []any{gestorID, organizacaoID}
+
+	return listar(ctx, d.querier(ctx), spec, params, func(rows *sql.Rows, sortValue *string, id *int64) (model.MatriculaLiderado, error) {
+		m := model.MatriculaLiderado{}
+		err := rows.Scan(
+			&m.MatriculaID,
+			&m.UsuarioID,
+			&m.UsuarioNome,
+			&m.TrilhaID,
+			&m.TrilhaNome,
+			&m.Status,
+			&m.DataInscricao,
+			&m.DataPrazo,
+			&m.DataConclusao,
+			&m.HorasEstudadas,
+			&m.CargaHoraria,
+			&m.PercentualConcluido,
+			&m.Atrasada,
+			&m.DataUltimaAtividade,
+			sortValue,
+			id,
+		)
+		return m, err
+	})
+}
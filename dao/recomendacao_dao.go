@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// Pesos dos sinais na pontuação das recomendações. As contagens de usuários e matrículas
+// entram em escala logarítmica, para que trilhas muito populares não dominem o ranking.
+const (
+	pesoCompetenciaNova = 1.5 // por competência da trilha que o usuário não possui
+	pesoNivelAdequado   = 2.0 // nível da trilha indicado para o nível de carreira
+	pesoMesmaArea       = 1.0 // ln(1 + colegas da mesma área matriculados)
+	pesoCoInscricao     = 2.0 // ln(1 + usuários com trilhas em comum matriculados)
+	pesoPopularidade    = 0.5 // ln(1 + matrículas na organização)
+)
+
+// RecomendacaoDAO é a interface para a consulta de trilhas recomendadas a um usuário.
+type RecomendacaoDAO interface {
+	FindRecomendacoes(ctx context.Context, organizacaoID int64, usuario *model.Usuario, nivelIndicado string, niveisCarreira []string, limit, offset int) ([]model.RecomendacaoTrilha, int, error)
+}
+
+// recomendacaoDAOImpl implementa a interface RecomendacaoDAO.
+type recomendacaoDAOImpl struct {
+	*Conexao
+}
+
+// NewRecomendacaoDAO cria uma nova instância de RecomendacaoDAO.
+func NewRecomendacaoDAO(conexao *Conexao) RecomendacaoDAO {
+	return &recomendacaoDAOImpl{Conexao: conexao}
+}
+
+// candidatasRecomendacao calcula os sinais de cada trilha candidata para o usuário ($2)
+// da organização ($1). Candidatas são as trilhas visíveis em que o usuário nunca se
+// matriculou e cujo nível de carreira mínimo ele atende ($6, níveis em minúsculas). Os
+// sinais consideram apenas matrículas não canceladas ($5) de outros usuários da
+// organização: colegas da mesma área ($3), usuários que fizeram alguma trilha em comum
+// com ele (co-inscrição) e o total de matrículas; $4 é o nível de trilha indicado.
+var candidatasRecomendacao = `
+	WITH matriculas_org AS (
+		SELECT m.usuario_id, m.trilha_id, u.area_atuacao
+		FROM matriculas m
+		JOIN usuarios u ON u.id = m.usuario_id
+		WHERE u.organizacao_id = $1 AND m.usuario_id <> $2 AND m.status <> $5
+	),
+	similares AS (
+		SELECT DISTINCT mo.usuario_id
+		FROM matriculas_org mo
+		WHERE mo.trilha_id IN (SELECT trilha_id FROM matriculas WHERE usuario_id = $2 AND status <> $5)
+	),
+	candidatas AS (
+		SELECT t.id, t.nome, t.nivel, t.carga_horaria, COALESCE(t.foco_principal, '') AS foco_principal,
+		       ARRAY(
+		           SELECT c.nome
+		           FROM trilha_competencia tc
+		           JOIN competencias c ON c.id = tc.competencia_id
+		           WHERE tc.trilha_id = t.id AND NOT EXISTS (
+		               SELECT 1 FROM usuario_competencias_efetivas e
+		               WHERE e.usuario_id = $2 AND e.competencia_id = tc.competencia_id
+		           )
+		           ORDER BY c.nome
+		       ) AS competencias_novas,
+		       t.nivel = $4 AS nivel_adequado,
+		       (SELECT COUNT(DISTINCT mo.usuario_id) FROM matriculas_org mo
+		        WHERE mo.trilha_id = t.id AND $3 <> '' AND LOWER(mo.area_atuacao) = LOWER($3)) AS colegas_mesma_area,
+		       (SELECT COUNT(DISTINCT mo.usuario_id) FROM matriculas_org mo
+		        JOIN similares s ON s.usuario_id = mo.usuario_id
+		        WHERE mo.trilha_id = t.id) AS co_inscritos,
+		       (SELECT COUNT(*) FROM matriculas_org mo WHERE mo.trilha_id = t.id) AS matriculas
+		FROM trilhas t
+		WHERE ` + trilhaVisivel("t", 1) + `
+		  AND NOT EXISTS (SELECT 1 FROM matriculas m WHERE m.trilha_id = t.id AND m.usuario_id = $2)
+		  AND (t.nivel_carreira_minimo IS NULL OR LOWER(t.nivel_carreira_minimo) = ANY($6))
+	)
+`
+
+// pontuacaoRecomendacao é a expressão SQL da pontuação de uma candidata.
+var pontuacaoRecomendacao = fmt.Sprintf(
+	`%g * CARDINALITY(competencias_novas)
+	 + CASE WHEN nivel_adequado THEN %g ELSE 0 END
+	 + %g * LN(1 + colegas_mesma_area::FLOAT8)
+	 + %g * LN(1 + co_inscritos::FLOAT8)
+	 + %g * LN(1 + matriculas::FLOAT8)`,
+	pesoCompetenciaNova, pesoNivelAdequado, pesoMesmaArea, pesoCoInscricao, pesoPopularidade,
+)
+
+// FindRecomendacoes retorna uma página das trilhas candidatas ordenadas pela pontuação,
+// com os sinais de cada uma, e o total de candidatas.
+func (d *recomendacaoDAOImpl) FindRecomendacoes(ctx context.Context, organizacaoID int64, usuario *model.Usuario, nivelIndicado string, niveisCarreira []string, limit, offset int) ([]model.RecomendacaoTrilha, int, error) {
+	args := []any{
+		organizacaoID,
+		usuario.ID,
+		usuario.AreaAtuacao,
+		nivelIndicado,
+		model.StatusMatriculaCancelada,
+		pq.Array(niveisCarreira),
+	}
+
+	// 1. Total de candidatas
+	var total int
+	err := d.querier(ctx).QueryRowContext(ctx, candidatasRecomendacao+`SELECT COUNT(*) FROM candidatas`, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, traduzirErro(err, "contar trilhas candidatas")
+	}
+	if total == 0 {
+		return []model.RecomendacaoTrilha{}, 0, nil
+	}
+
+	// 2. Página ordenada pela pontuação (desempate por popularidade e ID)
+	rows, err := d.querier(ctx).QueryContext(ctx, candidatasRecomendacao+`
+		SELECT id, nome, nivel, carga_horaria, foco_principal, competencias_novas, nivel_adequado,
+		       colegas_mesma_area, co_inscritos, matriculas, pontuacao
+		FROM (SELECT c.*, `+pontuacaoRecomendacao+` AS pontuacao FROM candidatas c) r
+		ORDER BY pontuacao DESC, matriculas DESC, id
+		LIMIT $7 OFFSET $8
+	`, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, traduzirErro(err, "buscar recomendações")
+	}
+	defer rows.Close()
+
+	recomendacoes := make([]model.RecomendacaoTrilha, 0, limit)
+	for rows.Next() {
+		r := model.RecomendacaoTrilha{}
+		err := rows.Scan(
+			&r.TrilhaID,
+			&r.Nome,
+			&r.Nivel,
+			&r.CargaHoraria,
+			&r.FocoPrincipal,
+			pq.Array(&r.Sinais.CompetenciasNovas),
+			&r.Sinais.NivelAdequado,
+			&r.Sinais.ColegasMesmaArea,
+			&r.Sinais.CoInscritos,
+			&r.Sinais.Matriculas,
+			&r.Pontuacao,
+		)
+		if err != nil {
+			return nil, 0, traduzirErro(err, "escanear recomendação")
+		}
+		recomendacoes = append(recomendacoes, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, traduzirErro(err, "iterar recomendações")
+	}
+
+	return recomendacoes, total, nil
+}
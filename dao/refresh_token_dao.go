@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// RefreshTokenDAO é a interface para as operações de acesso a dados dos refresh tokens.
+// Os tokens são identificados apenas pelo hash; o valor em claro nunca é persistido.
+type RefreshTokenDAO interface {
+	Create(ctx context.Context, usuarioID int64, tokenHash string, dataExpiracao time.Time) error
+	Consume(ctx context.Context, tokenHash string) (int64, error)
+	Revoke(ctx context.Context, tokenHash string) error
+	RevokeAllByUsuario(ctx context.Context, usuarioID int64) error
+}
+
+// refreshTokenDAOImpl implementa a interface RefreshTokenDAO.
+type refreshTokenDAOImpl struct {
+	*Conexao
+}
+
+// NewRefreshTokenDAO cria uma nova instância de RefreshTokenDAO.
+func NewRefreshTokenDAO(conexao *Conexao) RefreshTokenDAO {
+	return &refreshTokenDAOImpl{Conexao: conexao}
+}
+
+// Create registra um refresh token emitido para o usuário.
+func (d *refreshTokenDAOImpl) Create(ctx context.Context, usuarioID int64, tokenHash string, dataExpiracao time.Time) error {
+	_, err := d.querier(ctx).ExecContext(ctx,
+		"INSERT INTO refresh_tokens (usuario_id, token_hash, data_expiracao) VALUES ($1, $2, $3)",
+		usuarioID, tokenHash, dataExpiracao,
+	)
+	if err != nil {
+		return traduzirErro(err, "registrar refresh token")
+	}
+	return nil
+}
+
+// Consume revoga o token, se ainda válido, e retorna o ID do usuário dono. A revogação
+// e a verificação acontecem no mesmo UPDATE, então um token só pode ser usado uma vez.
+// Retorna 0 quando o token não existe, expirou ou já foi revogado.
+func (d *refreshTokenDAOImpl) Consume(ctx context.Context, tokenHash string) (int64, error) {
+	var usuarioID int64
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		UPDATE refresh_tokens
+		SET data_revogacao = NOW()
+		WHERE token_hash = $1
+		  AND data_revogacao IS NULL
+		  AND data_expiracao > NOW()
+		RETURNING usuario_id
+	`, tokenHash).Scan(&usuarioID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, traduzirErro(err, "consumir refresh token")
+	}
+	return usuarioID, nil
+}
+
+// Revoke revoga um refresh token. Tokens inexistentes ou já revogados são ignorados.
+func (d *refreshTokenDAOImpl) Revoke(ctx context.Context, tokenHash string) error {
+	_, err := d.querier(ctx).ExecContext(ctx,
+		"UPDATE refresh_tokens SET data_revogacao = NOW() WHERE token_hash = $1 AND data_revogacao IS NULL",
+		tokenHash,
+	)
+	if err != nil {
+		return traduzirErro(err, "revogar refresh token")
+	}
+	return nil
+}
+
+// RevokeAllByUsuario revoga todos os refresh tokens ativos do usuário.
+func (d *refreshTokenDAOImpl) RevokeAllByUsuario(ctx context.Context, usuarioID int64) error {
+	_, err := d.querier(ctx).ExecContext(ctx,
+		"UPDATE refresh_tokens SET data_revogacao = NOW() WHERE usuario_id = $1 AND data_revogacao IS NULL",
+		usuarioID,
+	)
+	if err != nil {
+		return traduzirErro(err, "revogar refresh tokens do usuário")
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// RequisitoDAO é a interface para as operações de acesso a dados dos requisitos de
+// elegibilidade das trilhas.
+type RequisitoDAO interface {
+	FindByTrilhaID(ctx context.Context, organizacaoID, trilhaID int64) (*model.RequisitosTrilha, error)
+	Replace(ctx context.Context, trilhaID int64, nivelCarreiraMinimo string, prerequisitoIDs, competenciaIDs []int64) error
+	CriaCiclo(ctx context.Context, trilhaID int64, prerequisitoIDs []int64) (bool, error)
+	FindTrilhasConcluidas(ctx context.Context, usuarioID int64) (map[int64]bool, error)
+	FindCompetenciasAdquiridas(ctx context.Context, usuarioID int64) (map[int64]bool, error)
+}
+
+// requisitoDAOImpl implementa a interface RequisitoDAO.
+type requisitoDAOImpl struct {
+	*Conexao
+}
+
+// NewRequisitoDAO cria uma nova instância de RequisitoDAO.
+func NewRequisitoDAO(conexao *Conexao) RequisitoDAO {
+	return &requisitoDAOImpl{Conexao: conexao}
+}
+
+// FindByTrilhaID busca o nível de carreira mínimo, as trilhas pré-requisito e as
+// competências requeridas de uma trilha visível para a organização.
+func (d *requisitoDAOImpl) FindByTrilhaID(ctx context.Context, organizacaoID, trilhaID int64) (*model.RequisitosTrilha, error) {
+	requisitos := &model.RequisitosTrilha{TrilhaID: trilhaID}
+
+	// 1. Nível de carreira mínimo
+	err := d.querier(ctx).QueryRowContext(ctx,
+		"SELECT COALESCE(nivel_carreira_minimo, '') FROM trilhas WHERE id = $1 AND "+trilhaVisivel("", 2),
+		trilhaID, organizacaoID,
+	).Scan(&requisitos.NivelCarreiraMinimo)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &model.ResourceNotFoundError{Resource: "Trilha", ID: trilhaID}
+		}
+		return nil, traduzirErro(err, "buscar requisitos da trilha")
+	}
+
+	// 2. Trilhas pré-requisito
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT t.id, t.nome, COALESCE(t.descricao, ''), t.nivel, t.carga_horaria, COALESCE(t.foco_principal, ''), t.organizacao_id
+		FROM trilhas t
+		JOIN trilha_prerequisitos tp ON tp.prerequisito_id = t.id
+		WHERE tp.trilha_id = $1 AND `+trilhaVisivel("t", 2)+`
+		ORDER BY t.id
+	`, trilhaID, organizacaoID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar trilhas pré-requisito")
+	}
+	defer rows.Close()
+
+	requisitos.TrilhasPrerequisito = make([]model.Trilha, 0)
+	for rows.Next() {
+		trilha := model.Trilha{}
+		err := rows.Scan(
+			&trilha.ID,
+			&trilha.Nome,
+			&trilha.Descricao,
+			&trilha.Nivel,
+			&trilha.CargaHoraria,
+			&trilha.FocoPrincipal,
+			&trilha.OrganizacaoID,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de trilha")
+		}
+		requisitos.TrilhasPrerequisito = append(requisitos.TrilhasPrerequisito, trilha)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar requisitos da trilha")
+	}
+
+	// 3. Competências requeridas
+	competenciaRows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT c.id, c.nome, COALESCE(c.categoria, ''), COALESCE(c.descricao, '')
+		FROM competencias c
+		JOIN trilha_competencias_requeridas tcr ON tcr.competencia_id = c.id
+		WHERE tcr.trilha_id = $1
+		ORDER BY c.id
+	`, trilhaID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar competências requeridas")
+	}
+	defer competenciaRows.Close()
+
+	requisitos.CompetenciasRequeridas, err = scanCompetencias(competenciaRows)
+	if err != nil {
+		return nil, err
+	}
+
+	return requisitos, nil
+}
+
+// Replace substitui todos os requisitos de uma trilha. Deve ser chamado dentro de uma
+// unidade de trabalho (service.WithTx).
+func (d *requisitoDAOImpl) Replace(ctx context.Context, trilhaID int64, nivelCarreiraMinimo string, prerequisitoIDs, competenciaIDs []int64) error {
+	q := d.querier(ctx)
+
+	// 1. Nível de carreira mínimo (vazio remove a exigência)
+	result, err := q.ExecContext(ctx,
+		"UPDATE trilhas SET nivel_carreira_minimo = NULLIF($2, '') WHERE id = $1",
+		trilhaID, nivelCarreiraMinimo,
+	)
+	if err != nil {
+		return traduzirErro(err, "atualizar nível de carreira mínimo")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Trilha", ID: trilhaID}
+	}
+
+	// 2. Trilhas pré-requisito
+	if _, err := q.ExecContext(ctx, "DELETE FROM trilha_prerequisitos WHERE trilha_id = $1", trilhaID); err != nil {
+		return traduzirErro(err, "limpar trilhas pré-requisito")
+	}
+	if len(prerequisitoIDs) > 0 {
+		_, err := q.ExecContext(ctx, `
+			INSERT INTO trilha_prerequisitos (trilha_id, prerequisito_id)
+			SELECT $1::BIGINT, UNNEST($2::BIGINT[])
+			ON CONFLICT DO NOTHING
+		`, trilhaID, pq.Array(prerequisitoIDs))
+		if err != nil {
+			return traduzirErro(err, "inserir trilhas pré-requisito")
+		}
+	}
+
+	// 3. Competências requeridas
+	if _, err := q.ExecContext(ctx, "DELETE FROM trilha_competencias_requeridas WHERE trilha_id = $1", trilhaID); err != nil {
+		return traduzirErro(err, "limpar competências requeridas")
+	}
+	if len(competenciaIDs) > 0 {
+		_, err := q.ExecContext(ctx, `
+			INSERT INTO trilha_competencias_requeridas (trilha_id, competencia_id)
+			SELECT $1::BIGINT, UNNEST($2::BIGINT[])
+			ON CONFLICT DO NOTHING
+		`, trilhaID, pq.Array(competenciaIDs))
+		if err != nil {
+			return traduzirErro(err, "inserir competências requeridas")
+		}
+	}
+	return nil
+}
+
+// CriaCiclo indica se tornar prerequisitoIDs pré-requisitos de trilhaID criaria uma
+// dependência circular, isto é, se trilhaID já é (direta ou indiretamente)
+// pré-requisito de alguma delas.
+func (d *requisitoDAOImpl) CriaCiclo(ctx context.Context, trilhaID int64, prerequisitoIDs []int64) (bool, error) {
+	if len(prerequisitoIDs) == 0 {
+		return false, nil
+	}
+
+	var ciclo bool
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		WITH RECURSIVE dependencias AS (
+			SELECT UNNEST($2::BIGINT[]) AS trilha_id
+			UNION
+			SELECT tp.prerequisito_id
+			FROM trilha_prerequisitos tp
+			JOIN dependencias d ON tp.trilha_id = d.trilha_id
+		)
+		SELECT EXISTS (SELECT 1 FROM dependencias WHERE trilha_id = $1)
+	`, trilhaID, pq.Array(prerequisitoIDs)).Scan(&ciclo)
+	if err != nil {
+		return false, traduzirErro(err, "verificar ciclo de pré-requisitos")
+	}
+	return ciclo, nil
+}
+
+// FindTrilhasConcluidas retorna o conjunto de trilhas que o usuário já concluiu.
+func (d *requisitoDAOImpl) FindTrilhasConcluidas(ctx context.Context, usuarioID int64) (map[int64]bool, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT DISTINCT trilha_id
+		FROM matriculas
+		WHERE usuario_id = $1 AND status = $2
+	`, usuarioID, model.StatusMatriculaConcluida)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar trilhas concluídas")
+	}
+	defer rows.Close()
+
+	return scanIDSet(rows)
+}
+
+// FindCompetenciasAdquiridas retorna o conjunto de competências verificadas do usuário,
+// isto é, concedidas pela conclusão de uma trilha ou validadas pelo gestor. A
+// autoavaliação não conta como competência adquirida.
+func (d *requisitoDAOImpl) FindCompetenciasAdquiridas(ctx context.Context, usuarioID int64) (map[int64]bool, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT competencia_id
+		FROM usuario_competencias_efetivas
+		WHERE usuario_id = $1 AND verificada
+	`, usuarioID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar competências adquiridas")
+	}
+	defer rows.Close()
+
+	return scanIDSet(rows)
+}
+
+// scanIDSet lê uma coluna de IDs e a converte em um conjunto.
+func scanIDSet(rows *sql.Rows) (map[int64]bool, error) {
+	ids := make(map[int64]bool)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, traduzirErro(err, "escanear ID")
+		}
+		ids[id] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar IDs")
+	}
+	return ids, nil
+}
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"upskilling-api/model"
+)
+
+// SessaoEstudoDAO é a interface para as operações de acesso a dados de Sessão de Estudo.
+type SessaoEstudoDAO interface {
+	Registrar(ctx context.Context, sessao *model.SessaoEstudo, cargaHoraria int) (*model.Matricula, error)
+	FindByMatriculaID(ctx context.Context, matriculaID int64) ([]model.SessaoEstudo, error)
+}
+
+// sessaoEstudoDAOImpl implementa a interface SessaoEstudoDAO.
+type sessaoEstudoDAOImpl struct {
+	*Conexao
+}
+
+// NewSessaoEstudoDAO cria uma nova instância de SessaoEstudoDAO.
+func NewSessaoEstudoDAO(conexao *Conexao) SessaoEstudoDAO {
+	return &sessaoEstudoDAOImpl{Conexao: conexao}
+}
+
+// Registrar insere a sessão de estudo e acumula as horas na matrícula. Quando o total
+// de horas atinge a carga horária da trilha, a matrícula passa automaticamente para
+// CONCLUIDA e as competências da trilha são concedidas ao usuário. Apenas matrículas
+// ATIVAS aceitam sessões. Deve ser chamado dentro de uma unidade de trabalho
+// (service.WithTx), para que as escritas sejam confirmadas ou desfeitas juntas.
+func (d *sessaoEstudoDAOImpl) Registrar(ctx context.Context, sessao *model.SessaoEstudo, cargaHoraria int) (*model.Matricula, error) {
+	return registrarSessao(ctx, d.querier(ctx), sessao, cargaHoraria)
+}
+
+// FindByMatriculaID busca as sessões de estudo de uma matrícula, da mais recente para a mais antiga.
+func (d *sessaoEstudoDAOImpl) FindByMatriculaID(ctx context.Context, matriculaID int64) ([]model.SessaoEstudo, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT id, matricula_id, horas, data_sessao, COALESCE(observacao, '')
+		FROM sessoes_estudo
+		WHERE matricula_id = $1
+		ORDER BY data_sessao DESC, id DESC
+	`, matriculaID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar sessões de estudo")
+	}
+	defer rows.Close()
+
+	sessoes := make([]model.SessaoEstudo, 0)
+	for rows.Next() {
+		sessao := model.SessaoEstudo{}
+		err := rows.Scan(
+			&sessao.ID,
+			&sessao.MatriculaID,
+			&sessao.Horas,
+			&sessao.DataSessao,
+			&sessao.Observacao,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de sessão de estudo")
+		}
+		sessoes = append(sessoes, sessao)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar sessões de estudo")
+	}
+
+	return sessoes, nil
+}
+
+// registrarSessao acumula as horas da sessão na matrícula e insere a sessão usando a
+// transação informada, para que outras operações (ex: conclusão de aula) possam
+// registrar progresso de forma atômica.
+func registrarSessao(ctx context.Context, q Querier, sessao *model.SessaoEstudo, cargaHoraria int) (*model.Matricula, error) {
+	// 1. Atualiza o progresso de forma atômica (o UPDATE bloqueia a linha da matrícula)
+	matricula, err := scanMatricula(q.QueryRowContext(ctx, `
+		UPDATE matriculas
+		SET horas_estudadas = horas_estudadas + $2,
+		    data_ultima_atividade = GREATEST(COALESCE(data_ultima_atividade, $3), $3),
+		    status = CASE WHEN horas_estudadas + $2 >= $4 THEN $6 ELSE status END,
+		    data_conclusao = CASE WHEN horas_estudadas + $2 >= $4 THEN NOW() ELSE data_conclusao END
+		WHERE id = $1 AND status = $5
+		RETURNING `+matriculaColumns,
+		sessao.MatriculaID,
+		sessao.Horas,
+		sessao.DataSessao,
+		cargaHoraria,
+		model.StatusMatriculaAtiva,
+		model.StatusMatriculaConcluida,
+	))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &model.BusinessRuleError{Chave: "sessao.matricula_inativa", Args: []any{sessao.MatriculaID}}
+		}
+		return nil, traduzirErro(err, "atualizar progresso da matrícula")
+	}
+
+	// 2. Ao concluir a matrícula, concede as competências da trilha
+	if matricula.Status == model.StatusMatriculaConcluida {
+		if err := ConcederCompetenciasTrilha(ctx, q, matricula.UsuarioID, matricula.TrilhaID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Registra a sessão
+	err = q.QueryRowContext(ctx, `
+		INSERT INTO sessoes_estudo (matricula_id, horas, data_sessao, observacao)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`, sessao.MatriculaID, sessao.Horas, sessao.DataSessao, sessao.Observacao).Scan(&sessao.ID)
+	if err != nil {
+		return nil, traduzirErro(err, "registrar sessão de estudo")
+	}
+
+	return matricula, nil
+}
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"log"
+)
+
+// Querier é satisfeita por *sql.DB e *sql.Tx. Os DAOs executam as consultas na
+// transação carregada pelo contexto (unit of work) ou, fora de uma, diretamente no pool.
+type Querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Transacionador abre unidades de trabalho: os DAOs chamados com o contexto recebido
+// por fn participam da mesma transação.
+type Transacionador interface {
+	EmTransacao(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// txKey é a chave da transação em andamento no contexto.
+type txKey struct{}
+
+// Conexao dá aos DAOs acesso ao pool de conexões e à transação em andamento no
+// contexto. É compartilhada por todos os DAOs de uma mesma aplicação.
+type Conexao struct {
+	db *sql.DB
+}
+
+// NewConexao cria uma Conexao sobre o pool informado.
+func NewConexao(db *sql.DB) *Conexao {
+	return &Conexao{db: db}
+}
+
+// querier retorna a transação em andamento no contexto ou, se não houver, o pool.
+func (c *Conexao) querier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return c.db
+}
+
+// EmTransacao executa fn em uma transação: os DAOs chamados com o contexto recebido
+// por fn participam dela. A transação é confirmada se fn não retornar erro e desfeita
+// caso contrário. Se o contexto já carrega uma transação, fn apenas participa dela e
+// o commit fica a cargo de quem a iniciou. A transação usa o nível de isolamento padrão
+// do servidor (READ COMMITTED no PostgreSQL); as regras de concorrência dependem de
+// bloqueios de linha, atualizações condicionais e índices únicos, não de SERIALIZABLE.
+func (c *Conexao) EmTransacao(ctx context.Context, fn func(ctx context.Context) error) error {
+	if EmTransacaoAtiva(ctx) {
+		return fn(ctx)
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("Erro ao iniciar transação: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	// O erro do commit é devolvido sem embrulho para que possa ser classificado (pq.Error)
+	if err := tx.Commit(); err != nil {
+		log.Printf("Erro ao confirmar transação: %v", err)
+		return err
+	}
+	return nil
+}
+
+// EmTransacaoAtiva indica se o contexto carrega uma transação em andamento.
+func EmTransacaoAtiva(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return ok
+}
@@ -0,0 +1,187 @@
+package dao
+
+import (
+	"context"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// TrilhaCompetenciaDAO é a interface para as operações de acesso a dados da
+// relação N:N entre trilhas e competências.
+type TrilhaCompetenciaDAO interface {
+	FindCompetenciasByTrilhaID(ctx context.Context, trilhaID int64) ([]model.Competencia, error)
+	FindCompetenciasByTrilhaIDs(ctx context.Context, trilhaIDs []int64) (map[int64][]model.Competencia, error)
+	FindTrilhasByCompetenciaID(ctx context.Context, organizacaoID, competenciaID int64) ([]model.Trilha, error)
+	Add(ctx context.Context, trilhaID, competenciaID int64) error
+	Remove(ctx context.Context, trilhaID, competenciaID int64) error
+	ReplaceAll(ctx context.Context, trilhaID int64, competenciaIDs []int64) error
+}
+
+// trilhaCompetenciaDAOImpl implementa a interface TrilhaCompetenciaDAO.
+type trilhaCompetenciaDAOImpl struct {
+	*Conexao
+}
+
+// NewTrilhaCompetenciaDAO cria uma nova instância de TrilhaCompetenciaDAO.
+func NewTrilhaCompetenciaDAO(conexao *Conexao) TrilhaCompetenciaDAO {
+	return &trilhaCompetenciaDAOImpl{Conexao: conexao}
+}
+
+// FindCompetenciasByTrilhaID busca as competências desenvolvidas por uma trilha.
+func (d *trilhaCompetenciaDAOImpl) FindCompetenciasByTrilhaID(ctx context.Context, trilhaID int64) ([]model.Competencia, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT c.id, c.nome, COALESCE(c.categoria, ''), COALESCE(c.descricao, '')
+		FROM competencias c
+		JOIN trilha_competencia tc ON tc.competencia_id = c.id
+		WHERE tc.trilha_id = $1
+		ORDER BY c.id
+	`, trilhaID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar competências da trilha")
+	}
+	defer rows.Close()
+
+	return scanCompetencias(rows)
+}
+
+// FindCompetenciasByTrilhaIDs busca, em uma única consulta, as competências de várias
+// trilhas, agrupadas pelo ID da trilha.
+func (d *trilhaCompetenciaDAOImpl) FindCompetenciasByTrilhaIDs(ctx context.Context, trilhaIDs []int64) (map[int64][]model.Competencia, error) {
+	result := make(map[int64][]model.Competencia)
+	if len(trilhaIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT tc.trilha_id, c.id, c.nome, COALESCE(c.categoria, ''), COALESCE(c.descricao, '')
+		FROM competencias c
+		JOIN trilha_competencia tc ON tc.competencia_id = c.id
+		WHERE tc.trilha_id = ANY($1)
+		ORDER BY tc.trilha_id, c.id
+	`, pq.Array(trilhaIDs))
+	if err != nil {
+		return nil, traduzirErro(err, "buscar competências das trilhas")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var trilhaID int64
+		competencia := model.Competencia{}
+		err := rows.Scan(
+			&trilhaID,
+			&competencia.ID,
+			&competencia.Nome,
+			&competencia.Categoria,
+			&competencia.Descricao,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de competência")
+		}
+		result[trilhaID] = append(result[trilhaID], competencia)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar competências das trilhas")
+	}
+
+	return result, nil
+}
+
+// FindTrilhasByCompetenciaID busca as trilhas visíveis para a organização que
+// desenvolvem uma competência.
+func (d *trilhaCompetenciaDAOImpl) FindTrilhasByCompetenciaID(ctx context.Context, organizacaoID, competenciaID int64) ([]model.Trilha, error) {
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT t.id, t.nome, COALESCE(t.descricao, ''), t.nivel, t.carga_horaria, COALESCE(t.foco_principal, ''), t.organizacao_id
+		FROM trilhas t
+		JOIN trilha_competencia tc ON tc.trilha_id = t.id
+		WHERE tc.competencia_id = $1 AND `+trilhaVisivel("t", 2)+`
+		ORDER BY t.id
+	`, competenciaID, organizacaoID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar trilhas da competência")
+	}
+	defer rows.Close()
+
+	trilhas := make([]model.Trilha, 0)
+	for rows.Next() {
+		trilha := model.Trilha{}
+		err := rows.Scan(
+			&trilha.ID,
+			&trilha.Nome,
+			&trilha.Descricao,
+			&trilha.Nivel,
+			&trilha.CargaHoraria,
+			&trilha.FocoPrincipal,
+			&trilha.OrganizacaoID,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de trilha")
+		}
+		trilhas = append(trilhas, trilha)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar trilhas da competência")
+	}
+
+	return trilhas, nil
+}
+
+// Add associa uma competência a uma trilha. A operação é idempotente.
+func (d *trilhaCompetenciaDAOImpl) Add(ctx context.Context, trilhaID, competenciaID int64) error {
+	_, err := d.querier(ctx).ExecContext(ctx, `
+		INSERT INTO trilha_competencia (trilha_id, competencia_id)
+		VALUES ($1, $2)
+		ON CONFLICT (trilha_id, competencia_id) DO NOTHING
+	`, trilhaID, competenciaID)
+	if err != nil {
+		return traduzirErro(err, "associar competência à trilha")
+	}
+	return nil
+}
+
+// Remove desfaz a associação entre uma trilha e uma competência.
+func (d *trilhaCompetenciaDAOImpl) Remove(ctx context.Context, trilhaID, competenciaID int64) error {
+	result, err := d.querier(ctx).ExecContext(ctx,
+		"DELETE FROM trilha_competencia WHERE trilha_id = $1 AND competencia_id = $2",
+		trilhaID, competenciaID,
+	)
+	if err != nil {
+		return traduzirErro(err, "remover associação trilha-competência")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Associação trilha-competência", ID: competenciaID}
+	}
+
+	return nil
+}
+
+// ReplaceAll substitui todas as competências associadas a uma trilha. Deve ser chamado
+// dentro de uma unidade de trabalho (service.WithTx).
+func (d *trilhaCompetenciaDAOImpl) ReplaceAll(ctx context.Context, trilhaID int64, competenciaIDs []int64) error {
+	q := d.querier(ctx)
+
+	if _, err := q.ExecContext(ctx, "DELETE FROM trilha_competencia WHERE trilha_id = $1", trilhaID); err != nil {
+		return traduzirErro(err, "limpar competências da trilha")
+	}
+
+	if len(competenciaIDs) > 0 {
+		_, err := q.ExecContext(ctx, `
+			INSERT INTO trilha_competencia (trilha_id, competencia_id)
+			SELECT $1::BIGINT, UNNEST($2::BIGINT[])
+			ON CONFLICT (trilha_id, competencia_id) DO NOTHING
+		`, trilhaID, pq.Array(competenciaIDs))
+		if err != nil {
+			return traduzirErro(err, "associar competências à trilha")
+		}
+	}
+	return nil
+}
@@ -1,96 +1,119 @@
 package dao
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
 
-	"upskilling-api/db"
 	"upskilling-api/model"
 )
 
 // TrilhaDAO é a interface para as operações de acesso a dados de Trilha.
+// Todas as consultas recebem a organização (tenant) do usuário e só enxergam as trilhas
+// do catálogo público e as trilhas privadas dessa organização.
 type TrilhaDAO interface {
-	Create(trilha *model.Trilha) error
-	FindByID(id int64) (*model.Trilha, error)
-	FindAll() ([]model.Trilha, error)
-	Update(trilha *model.Trilha) error
-	Delete(id int64) error
+	Create(ctx context.Context, trilha *model.Trilha) error
+	FindByID(ctx context.Context, organizacaoID, id int64) (*model.Trilha, error)
+	FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Trilha, *model.Pagina, error)
+	Update(ctx context.Context, organizacaoID int64, trilha *model.Trilha) error
+	Delete(ctx context.Context, organizacaoID, id int64) error
+}
+
+// trilhaVisivel retorna a condição SQL que limita as trilhas (com o alias informado) ao
+// catálogo público e às trilhas privadas da organização no parâmetro $n.
+func trilhaVisivel(alias string, n int) string {
+	if alias != "" {
+		alias += "."
+	}
+	return fmt.Sprintf("(%[1]sorganizacao_id IS NULL OR %[1]sorganizacao_id = $%[2]d)", alias, n)
 }
 
 // trilhaDAOImpl implementa a interface TrilhaDAO.
-type trilhaDAOImpl struct{}
+type trilhaDAOImpl struct {
+	*Conexao
+}
 
 // NewTrilhaDAO cria uma nova instância de TrilhaDAO.
-func NewTrilhaDAO() TrilhaDAO {
-	return &trilhaDAOImpl{}
+func NewTrilhaDAO(conexao *Conexao) TrilhaDAO {
+	return &trilhaDAOImpl{Conexao: conexao}
 }
 
 // Create insere uma nova trilha no banco de dados.
-func (d *trilhaDAOImpl) Create(trilha *model.Trilha) error {
+func (d *trilhaDAOImpl) Create(ctx context.Context, trilha *model.Trilha) error {
 	query := `
-		INSERT INTO trilhas (nome, descricao, nivel, carga_horaria, foco_principal)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO trilhas (nome, descricao, nivel, carga_horaria, foco_principal, organizacao_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id
 	`
-	err := db.GetDB().QueryRow(
+	err := d.querier(ctx).QueryRowContext(ctx,
 		query,
 		trilha.Nome,
 		trilha.Descricao,
 		trilha.Nivel,
 		trilha.CargaHoraria,
 		trilha.FocoPrincipal,
+		trilha.OrganizacaoID,
 	).Scan(&trilha.ID)
 
 	if err != nil {
-		log.Printf("Erro ao criar trilha: %v", err)
-		return fmt.Errorf("erro ao criar trilha: %w", err)
+		return traduzirErro(err, "criar trilha")
 	}
 	return nil
 }
 
-// FindByID busca uma trilha pelo ID.
-func (d *trilhaDAOImpl) FindByID(id int64) (*model.Trilha, error) {
+// FindByID busca uma trilha visível para a organização pelo ID. Trilhas privadas de
+// outras organizações são tratadas como inexistentes.
+func (d *trilhaDAOImpl) FindByID(ctx context.Context, organizacaoID, id int64) (*model.Trilha, error) {
 	trilha := &model.Trilha{}
 	query := `
-		SELECT id, nome, descricao, nivel, carga_horaria, foco_principal
+		SELECT id, nome, descricao, nivel, carga_horaria, foco_principal, organizacao_id
 		FROM trilhas
-		WHERE id = $1
-	`
-	err := db.GetDB().QueryRow(query, id).Scan(
+		WHERE id = $1 AND ` + trilhaVisivel("", 2)
+	err := d.querier(ctx).QueryRowContext(ctx, query, id, organizacaoID).Scan(
 		&trilha.ID,
 		&trilha.Nome,
 		&trilha.Descricao,
 		&trilha.Nivel,
 		&trilha.CargaHoraria,
 		&trilha.FocoPrincipal,
+		&trilha.OrganizacaoID,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &model.ResourceNotFoundError{Resource: "Trilha", ID: id}
 		}
-		log.Printf("Erro ao buscar trilha por ID: %v", err)
-		return nil, fmt.Errorf("erro ao buscar trilha por ID: %w", err)
+		return nil, traduzirErro(err, "buscar trilha por ID")
 	}
 	return trilha, nil
 }
 
-// FindAll busca todas as trilhas.
-func (d *trilhaDAOImpl) FindAll() ([]model.Trilha, error) {
-	rows, err := db.GetDB().Query(`
-		SELECT id, nome, descricao, nivel, carga_horaria, foco_principal
-		FROM trilhas
-		ORDER BY id
-	`)
-	if err != nil {
-		log.Printf("Erro ao buscar todas as trilhas: %v", err)
-		return nil, fmt.Errorf("erro ao buscar todas as trilhas: %w", err)
-	}
-	defer rows.Close()
+// trilhaListSpec define a ordenação e os filtros aceitos na listagem de trilhas.
+var trilhaListSpec = listSpec{
+	from:        "trilhas",
+	columns:     "id, nome, descricao, nivel, carga_horaria, foco_principal, organizacao_id",
+	idColumn:    "id",
+	defaultSort: "id",
+	sortable: map[string]string{
+		"id":            "id",
+		"nome":          "nome",
+		"nivel":         "nivel",
+		"carga_horaria": "carga_horaria",
+	},
+	filters: map[string]string{
+		"nivel":          "nivel",
+		"foco_principal": "foco_principal",
+	},
+}
+
+// FindAll busca uma página das trilhas visíveis para a organização, aplicando filtros e
+// ordenação no SQL.
+func (d *trilhaDAOImpl) FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Trilha, *model.Pagina, error) {
+	spec := trilhaListSpec
+	spec.where = []string{trilhaVisivel("", 1)}
+	spec.whereArgs = []any{organizacaoID}
 
-	trilhas := make([]model.Trilha, 0)
-	for rows.Next() {
+	return listar(ctx, d.querier(ctx), spec, params, func(rows *sql.Rows, sortValue *string, id *int64) (model.Trilha, error) {
 		trilha := model.Trilha{}
 		err := rows.Scan(
 			&trilha.ID,
@@ -99,30 +122,21 @@ func (d *trilhaDAOImpl) FindAll() ([]model.Trilha, error) {
 			&trilha.Nivel,
 			&trilha.CargaHoraria,
 			&trilha.FocoPrincipal,
+			&trilha.OrganizacaoID,
+			sortValue,
+			id,
 		)
-		if err != nil {
-			log.Printf("Erro ao escanear linha de trilha: %v", err)
-			return nil, fmt.Errorf("erro ao escanear linha de trilha: %w", err)
-		}
-		trilhas = append(trilhas, trilha)
-	}
-
-	if err = rows.Err(); err != nil {
-		log.Printf("Erro após iteração de linhas: %v", err)
-		return nil, fmt.Errorf("erro após iteração de linhas: %w", err)
-	}
-
-	return trilhas, nil
+		return trilha, err
+	})
 }
 
-// Update atualiza uma trilha existente.
-func (d *trilhaDAOImpl) Update(trilha *model.Trilha) error {
+// Update atualiza uma trilha existente visível para a organização.
+func (d *trilhaDAOImpl) Update(ctx context.Context, organizacaoID int64, trilha *model.Trilha) error {
 	query := `
 		UPDATE trilhas
 		SET nome = $2, descricao = $3, nivel = $4, carga_horaria = $5, foco_principal = $6
-		WHERE id = $1
-	`
-	result, err := db.GetDB().Exec(
+		WHERE id = $1 AND ` + trilhaVisivel("", 7)
+	result, err := d.querier(ctx).ExecContext(ctx,
 		query,
 		trilha.ID,
 		trilha.Nome,
@@ -130,16 +144,15 @@ func (d *trilhaDAOImpl) Update(trilha *model.Trilha) error {
 		trilha.Nivel,
 		trilha.CargaHoraria,
 		trilha.FocoPrincipal,
+		organizacaoID,
 	)
 	if err != nil {
-		log.Printf("Erro ao atualizar trilha: %v", err)
-		return fmt.Errorf("erro ao atualizar trilha: %w", err)
+		return traduzirErro(err, "atualizar trilha")
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		log.Printf("Erro ao verificar linhas afetadas: %v", err)
-		return fmt.Errorf("erro ao verificar linhas afetadas: %w", err)
+		return traduzirErro(err, "verificar linhas afetadas")
 	}
 
 	if rowsAffected == 0 {
@@ -149,18 +162,16 @@ func (d *trilhaDAOImpl) Update(trilha *model.Trilha) error {
 	return nil
 }
 
-// Delete remove uma trilha pelo ID.
-func (d *trilhaDAOImpl) Delete(id int64) error {
-	result, err := db.GetDB().Exec("DELETE FROM trilhas WHERE id = $1", id)
+// Delete remove uma trilha visível para a organização pelo ID.
+func (d *trilhaDAOImpl) Delete(ctx context.Context, organizacaoID, id int64) error {
+	result, err := d.querier(ctx).ExecContext(ctx, "DELETE FROM trilhas WHERE id = $1 AND "+trilhaVisivel("", 2), id, organizacaoID)
 	if err != nil {
-		log.Printf("Erro ao deletar trilha: %v", err)
-		return fmt.Errorf("erro ao deletar trilha: %w", err)
+		return traduzirErro(err, "deletar trilha")
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		log.Printf("Erro ao verificar linhas afetadas: %v", err)
-		return fmt.Errorf("erro ao verificar linhas afetadas: %w", err)
+		return traduzirErro(err, "verificar linhas afetadas")
 	}
 
 	if rowsAffected == 0 {
@@ -0,0 +1,153 @@
+package dao
+
+import (
+	"context"
+
+	"upskilling-api/model"
+
+	"github.com/lib/pq"
+)
+
+// NivelConcedidoPorTrilha é a expressão SQL do nível de proficiência concedido ao
+// concluir uma trilha (alias t), conforme o nível da trilha. A migração 0006 repete o
+// mapeamento para os dados existentes na época; migrações aplicadas não podem mudar.
+const NivelConcedidoPorTrilha = "CASE t.nivel WHEN 'AVANCADO' THEN 4 WHEN 'INTERMEDIARIO' THEN 3 ELSE 2 END"
+
+// UsuarioCompetenciaDAO é a interface para as operações de acesso a dados do perfil de
+// competências dos usuários.
+type UsuarioCompetenciaDAO interface {
+	FindByUsuarioID(ctx context.Context, usuarioID int64) ([]model.CompetenciaUsuario, error)
+	Replace(ctx context.Context, usuarioID int64, origem string, niveis map[int64]int) error
+}
+
+// usuarioCompetenciaDAOImpl implementa a interface UsuarioCompetenciaDAO.
+type usuarioCompetenciaDAOImpl struct {
+	*Conexao
+}
+
+// NewUsuarioCompetenciaDAO cria uma nova instância de UsuarioCompetenciaDAO.
+func NewUsuarioCompetenciaDAO(conexao *Conexao) UsuarioCompetenciaDAO {
+	return &usuarioCompetenciaDAOImpl{Conexao: conexao}
+}
+
+// FindByUsuarioID busca as competências do usuário com o nível efetivo e as avaliações
+// de cada origem, ordenadas pelo nome da competência.
+func (d *usuarioCompetenciaDAOImpl) FindByUsuarioID(ctx context.Context, usuarioID int64) ([]model.CompetenciaUsuario, error) {
+	// 1. Nível efetivo de cada competência
+	rows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT e.competencia_id, c.nome, COALESCE(c.categoria, ''), e.nivel, e.origem,
+		       e.verificada, e.data_atualizacao
+		FROM usuario_competencias_efetivas e
+		JOIN competencias c ON c.id = e.competencia_id
+		WHERE e.usuario_id = $1
+		ORDER BY c.nome, c.id
+	`, usuarioID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar competências do usuário")
+	}
+	defer rows.Close()
+
+	competencias := make([]model.CompetenciaUsuario, 0)
+	indice := make(map[int64]int)
+	for rows.Next() {
+		c := model.CompetenciaUsuario{Avaliacoes: make([]model.AvaliacaoCompetencia, 0)}
+		err := rows.Scan(
+			&c.CompetenciaID,
+			&c.Nome,
+			&c.Categoria,
+			&c.Nivel,
+			&c.Origem,
+			&c.Verificada,
+			&c.DataAtualizacao,
+		)
+		if err != nil {
+			return nil, traduzirErro(err, "escanear linha de competência do usuário")
+		}
+		indice[c.CompetenciaID] = len(competencias)
+		competencias = append(competencias, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar competências do usuário")
+	}
+
+	// 2. Avaliações registradas por origem
+	avaliacaoRows, err := d.querier(ctx).QueryContext(ctx, `
+		SELECT competencia_id, origem, nivel, data_atualizacao
+		FROM usuario_competencias
+		WHERE usuario_id = $1
+		ORDER BY competencia_id, CASE origem WHEN 'GESTOR' THEN 1 WHEN 'TRILHA' THEN 2 ELSE 3 END
+	`, usuarioID)
+	if err != nil {
+		return nil, traduzirErro(err, "buscar avaliações de competências")
+	}
+	defer avaliacaoRows.Close()
+
+	for avaliacaoRows.Next() {
+		var competenciaID int64
+		a := model.AvaliacaoCompetencia{}
+		if err := avaliacaoRows.Scan(&competenciaID, &a.Origem, &a.Nivel, &a.DataAtualizacao); err != nil {
+			return nil, traduzirErro(err, "escanear linha de avaliação de competência")
+		}
+		if i, ok := indice[competenciaID]; ok {
+			competencias[i].Avaliacoes = append(competencias[i].Avaliacoes, a)
+		}
+	}
+	if err = avaliacaoRows.Err(); err != nil {
+		return nil, traduzirErro(err, "iterar avaliações de competência")
+	}
+
+	return competencias, nil
+}
+
+// Replace substitui todas as avaliações de uma origem para o usuário pelos níveis
+// informados (competência → nível). As demais origens não mudam. Deve ser chamado dentro
+// de uma unidade de trabalho (service.WithTx).
+func (d *usuarioCompetenciaDAOImpl) Replace(ctx context.Context, usuarioID int64, origem string, niveis map[int64]int) error {
+	q := d.querier(ctx)
+
+	// 1. Remove as avaliações anteriores da origem
+	_, err := q.ExecContext(ctx, "DELETE FROM usuario_competencias WHERE usuario_id = $1 AND origem = $2", usuarioID, origem)
+	if err != nil {
+		return traduzirErro(err, "limpar avaliações de competências")
+	}
+
+	// 2. Insere os novos níveis
+	if len(niveis) == 0 {
+		return nil
+	}
+	competenciaIDs := make([]int64, 0, len(niveis))
+	valores := make([]int64, 0, len(niveis))
+	for id, nivel := range niveis {
+		competenciaIDs = append(competenciaIDs, id)
+		valores = append(valores, int64(nivel))
+	}
+	_, err = q.ExecContext(ctx, `
+		INSERT INTO usuario_competencias (usuario_id, competencia_id, origem, nivel, data_atualizacao)
+		SELECT $1, n.competencia_id, $2, n.nivel, NOW()
+		FROM UNNEST($3::BIGINT[], $4::SMALLINT[]) AS n (competencia_id, nivel)
+	`, usuarioID, origem, pq.Array(competenciaIDs), pq.Array(valores))
+	if err != nil {
+		return traduzirErro(err, "inserir avaliações de competências")
+	}
+	return nil
+}
+
+// ConcederCompetenciasTrilha registra, na transação informada, as competências
+// desenvolvidas pela trilha como adquiridas pelo usuário (origem TRILHA). Um nível já
+// concedido por outra trilha só é substituído por um maior.
+func ConcederCompetenciasTrilha(ctx context.Context, q Querier, usuarioID, trilhaID int64) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO usuario_competencias (usuario_id, competencia_id, origem, nivel, data_atualizacao)
+		SELECT $1, tc.competencia_id, $3, `+NivelConcedidoPorTrilha+`, NOW()
+		FROM trilha_competencia tc
+		JOIN trilhas t ON t.id = tc.trilha_id
+		WHERE tc.trilha_id = $2
+		ON CONFLICT (usuario_id, competencia_id, origem) DO UPDATE
+		SET nivel = GREATEST(usuario_competencias.nivel, EXCLUDED.nivel),
+		    data_atualizacao = EXCLUDED.data_atualizacao
+	`, usuarioID, trilhaID, model.OrigemTrilha)
+	if err != nil {
+		return traduzirErro(err, "conceder competências da trilha")
+	}
+	return nil
+}
@@ -1,176 +1,211 @@
 package dao
 
 import (
+	"context"
 	"database/sql"
-	"fmt"
-	"log"
 	"time"
 
-	"upskilling-api/db"
 	"upskilling-api/model"
 )
 
+// usuarioColumns lista as colunas lidas por scanUsuario, na mesma ordem.
+const usuarioColumns = "id, nome, email, area_atuacao, nivel_carreira, data_cadastro, papel, organizacao_id, equipe_id, gestor_id"
+
 // UsuarioDAO é a interface para as operações de acesso a dados de Usuário.
+// As consultas recebem a organização (tenant) e só enxergam os usuários dela; apenas
+// FindByEmail (login) e FindAutenticado (validação do token) não são restritas.
 type UsuarioDAO interface {
-	Create(usuario *model.Usuario) error
-	FindByID(id int64) (*model.Usuario, error)
-	FindAll() ([]model.Usuario, error)
-	Update(usuario *model.Usuario) error
-	Delete(id int64) error
-	FindByEmail(email string) (*model.Usuario, error)
+	Create(ctx context.Context, usuario *model.Usuario) error
+	FindByID(ctx context.Context, organizacaoID, id int64) (*model.Usuario, error)
+	FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Usuario, *model.Pagina, error)
+	Update(ctx context.Context, organizacaoID int64, usuario *model.Usuario) error
+	Delete(ctx context.Context, organizacaoID, id int64) error
+	FindByEmail(ctx context.Context, email string) (*model.Usuario, error)
+	FindAutenticado(ctx context.Context, id int64) (*model.Usuario, error)
+	UpdatePapel(ctx context.Context, organizacaoID, id int64, papel string) error
+	CriaCicloGestor(ctx context.Context, usuarioID, gestorID int64) (bool, error)
 }
 
 // usuarioDAOImpl implementa a interface UsuarioDAO.
-type usuarioDAOImpl struct{}
+type usuarioDAOImpl struct {
+	*Conexao
+}
 
 // NewUsuarioDAO cria uma nova instância de UsuarioDAO.
-func NewUsuarioDAO() UsuarioDAO {
-	return &usuarioDAOImpl{}
+func NewUsuarioDAO(conexao *Conexao) UsuarioDAO {
+	return &usuarioDAOImpl{Conexao: conexao}
 }
 
 // Create insere um novo usuário no banco de dados.
-func (d *usuarioDAOImpl) Create(usuario *model.Usuario) error {
+func (d *usuarioDAOImpl) Create(ctx context.Context, usuario *model.Usuario) error {
+	return insertUsuario(ctx, d.querier(ctx), usuario)
+}
+
+// insertUsuario insere o usuário usando a conexão ou a transação informada.
+func insertUsuario(ctx context.Context, q Querier, usuario *model.Usuario) error {
 	query := `
-		INSERT INTO usuarios (nome, email, area_atuacao, nivel_carreira, data_cadastro)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, data_cadastro
+		INSERT INTO usuarios (nome, email, area_atuacao, nivel_carreira, data_cadastro, senha_hash, papel, organizacao_id, equipe_id, gestor_id)
+		VALUES ($1, $2, $3, $4, $5, $6, COALESCE(NULLIF($7, ''), 'learner'), $8, $9, $10)
+		RETURNING id, data_cadastro, papel
 	`
-	err := db.GetDB().QueryRow(
+	err := q.QueryRowContext(ctx,
 		query,
 		usuario.Nome,
 		usuario.Email,
 		usuario.AreaAtuacao,
 		usuario.NivelCarreira,
 		time.Now(),
-	).Scan(&usuario.ID, &usuario.DataCadastro)
+		usuario.SenhaHash,
+		usuario.Papel,
+		usuario.OrganizacaoID,
+		usuario.EquipeID,
+		usuario.GestorID,
+	).Scan(&usuario.ID, &usuario.DataCadastro, &usuario.Papel)
 
 	if err != nil {
-		// Tratar erro de email duplicado (Postgres)
-		if err.Error() == "pq: duplicate key value violates unique constraint \"usuarios_email_key\"" {
-			return &model.ConflictError{Msg: "Email já cadastrado."}
-		}
-		log.Printf("Erro ao criar usuário: %v", err)
-		return fmt.Errorf("erro ao criar usuário: %w", err)
+		// Email duplicado (usuarios_email_key) vira ConflictError genérico, que não revela
+		// a organização do usuário existente
+		return traduzirErro(err, "criar usuário")
 	}
 	return nil
 }
 
-// FindByID busca um usuário pelo ID.
-func (d *usuarioDAOImpl) FindByID(id int64) (*model.Usuario, error) {
-	usuario := &model.Usuario{}
-	query := `
-		SELECT id, nome, email, area_atuacao, nivel_carreira, data_cadastro
-		FROM usuarios
-		WHERE id = $1
-	`
-	err := db.GetDB().QueryRow(query, id).Scan(
+// scanUsuario lê as colunas de usuarioColumns.
+func scanUsuario(row rowScanner, usuario *model.Usuario, extras ...any) error {
+	return row.Scan(append([]any{
 		&usuario.ID,
 		&usuario.Nome,
 		&usuario.Email,
 		&usuario.AreaAtuacao,
 		&usuario.NivelCarreira,
 		&usuario.DataCadastro,
-	)
+		&usuario.Papel,
+		&usuario.OrganizacaoID,
+		&usuario.EquipeID,
+		&usuario.GestorID,
+	}, extras...)...)
+}
+
+// FindByID busca um usuário da organização pelo ID.
+func (d *usuarioDAOImpl) FindByID(ctx context.Context, organizacaoID, id int64) (*model.Usuario, error) {
+	usuario := &model.Usuario{}
+	query := `
+		SELECT ` + usuarioColumns + `
+		FROM usuarios
+		WHERE id = $1 AND organizacao_id = $2
+	`
+	err := scanUsuario(d.querier(ctx).QueryRowContext(ctx, query, id, organizacaoID), usuario)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &model.ResourceNotFoundError{Resource: "Usuário", ID: id}
 		}
-		log.Printf("Erro ao buscar usuário por ID: %v", err)
-		return nil, fmt.Errorf("erro ao buscar usuário por ID: %w", err)
+		return nil, traduzirErro(err, "buscar usuário por ID")
 	}
 	return usuario, nil
 }
 
-// FindByEmail busca um usuário pelo email.
-func (d *usuarioDAOImpl) FindByEmail(email string) (*model.Usuario, error) {
+// FindAutenticado busca o usuário identificado por um access token, em qualquer
+// organização, indicando se ele pertence à organização da plataforma.
+func (d *usuarioDAOImpl) FindAutenticado(ctx context.Context, id int64) (*model.Usuario, error) {
 	usuario := &model.Usuario{}
 	query := `
-		SELECT id, nome, email, area_atuacao, nivel_carreira, data_cadastro
+		SELECT ` + usuarioColumns + `, (SELECT o.plataforma FROM organizacoes o WHERE o.id = usuarios.organizacao_id)
 		FROM usuarios
-		WHERE email = $1
+		WHERE id = $1
 	`
-	err := db.GetDB().QueryRow(query, email).Scan(
-		&usuario.ID,
-		&usuario.Nome,
-		&usuario.Email,
-		&usuario.AreaAtuacao,
-		&usuario.NivelCarreira,
-		&usuario.DataCadastro,
-	)
+	err := scanUsuario(d.querier(ctx).QueryRowContext(ctx, query, id), usuario, &usuario.OrganizacaoPlataforma)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, nil // Retorna nil, nil se não encontrar
+			return nil, &model.ResourceNotFoundError{Resource: "Usuário", ID: id}
 		}
-		log.Printf("Erro ao buscar usuário por email: %v", err)
-		return nil, fmt.Errorf("erro ao buscar usuário por email: %w", err)
+		return nil, traduzirErro(err, "buscar usuário autenticado")
 	}
 	return usuario, nil
 }
 
-// FindAll busca todos os usuários.
-func (d *usuarioDAOImpl) FindAll() ([]model.Usuario, error) {
-	rows, err := db.GetDB().Query(`
-		SELECT id, nome, email, area_atuacao, nivel_carreira, data_cadastro
+// FindByEmail busca um usuário pelo email, incluindo o hash da senha (usado no login).
+// O email é único em toda a plataforma.
+func (d *usuarioDAOImpl) FindByEmail(ctx context.Context, email string) (*model.Usuario, error) {
+	usuario := &model.Usuario{}
+	query := `
+		SELECT ` + usuarioColumns + `, COALESCE(senha_hash, '')
 		FROM usuarios
-		ORDER BY id
-	`)
-	if err != nil {
-		log.Printf("Erro ao buscar todos os usuários: %v", err)
-		return nil, fmt.Errorf("erro ao buscar todos os usuários: %w", err)
-	}
-	defer rows.Close()
+		WHERE email = $1
+	`
+	err := scanUsuario(d.querier(ctx).QueryRowContext(ctx, query, email), usuario, &usuario.SenhaHash)
 
-	usuarios := make([]model.Usuario, 0)
-	for rows.Next() {
-		usuario := model.Usuario{}
-		err := rows.Scan(
-			&usuario.ID,
-			&usuario.Nome,
-			&usuario.Email,
-			&usuario.AreaAtuacao,
-			&usuario.NivelCarreira,
-			&usuario.DataCadastro,
-		)
-		if err != nil {
-			log.Printf("Erro ao escanear linha de usuário: %v", err)
-			return nil, fmt.Errorf("erro ao escanear linha de usuário: %w", err)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Retorna nil, nil se não encontrar
 		}
-		usuarios = append(usuarios, usuario)
+		return nil, traduzirErro(err, "buscar usuário por email")
 	}
+	return usuario, nil
+}
 
-	if err = rows.Err(); err != nil {
-		log.Printf("Erro após iteração de linhas: %v", err)
-		return nil, fmt.Errorf("erro após iteração de linhas: %w", err)
-	}
+// usuarioListSpec define a ordenação e os filtros aceitos na listagem de usuários.
+var usuarioListSpec = listSpec{
+	from:        "usuarios",
+	columns:     usuarioColumns,
+	idColumn:    "id",
+	defaultSort: "id",
+	sortable: map[string]string{
+		"id":            "id",
+		"nome":          "nome",
+		"email":         "email",
+		"data_cadastro": "data_cadastro",
+	},
+	filters: map[string]string{
+		"area_atuacao":   "area_atuacao",
+		"nivel_carreira": "nivel_carreira",
+		"papel":          "papel",
+		"equipe_id":      "equipe_id::TEXT",
+		"gestor_id":      "gestor_id::TEXT",
+	},
+}
+
+// FindAll busca uma página dos usuários da organização, aplicando filtros e ordenação no SQL.
+func (d *usuarioDAOImpl) FindAll(ctx context.Context, organizacaoID int64, params model.ListParams) ([]model.Usuario, *model.Pagina, error) {
+	spec := usuarioListSpec
+	spec.where = []string{"organizacao_id = $1"}
+	spec.whereArgs = []any{organizacaoID}
 
-	return usuarios, nil
+	return listar(ctx, d.querier(ctx), spec, params, func(rows *sql.Rows, sortValue *string, id *int64) (model.Usuario, error) {
+		usuario := model.Usuario{}
+		err := scanUsuario(rows, &usuario, sortValue, id)
+		return usuario, err
+	})
 }
 
-// Update atualiza um usuário existente.
-func (d *usuarioDAOImpl) Update(usuario *model.Usuario) error {
+// Update atualiza um usuário existente da organização. O hash da senha só é alterado
+// quando informado.
+func (d *usuarioDAOImpl) Update(ctx context.Context, organizacaoID int64, usuario *model.Usuario) error {
 	query := `
 		UPDATE usuarios
-		SET nome = $2, area_atuacao = $3, nivel_carreira = $4
-		WHERE id = $1
+		SET nome = $2, area_atuacao = $3, nivel_carreira = $4,
+		    senha_hash = COALESCE(NULLIF($5, ''), senha_hash), equipe_id = $6, gestor_id = $8
+		WHERE id = $1 AND organizacao_id = $7
 	`
-	result, err := db.GetDB().Exec(
+	result, err := d.querier(ctx).ExecContext(ctx,
 		query,
 		usuario.ID,
 		usuario.Nome,
 		usuario.AreaAtuacao,
 		usuario.NivelCarreira,
+		usuario.SenhaHash,
+		usuario.EquipeID,
+		organizacaoID,
+		usuario.GestorID,
 	)
 	if err != nil {
-		log.Printf("Erro ao atualizar usuário: %v", err)
-		return fmt.Errorf("erro ao atualizar usuário: %w", err)
+		return traduzirErro(err, "atualizar usuário")
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		log.Printf("Erro ao verificar linhas afetadas: %v", err)
-		return fmt.Errorf("erro ao verificar linhas afetadas: %w", err)
+		return traduzirErro(err, "verificar linhas afetadas")
 	}
 
 	if rowsAffected == 0 {
@@ -180,18 +215,59 @@ func (d *usuarioDAOImpl) Update(usuario *model.Usuario) error {
 	return nil
 }
 
-// Delete remove um usuário pelo ID.
-func (d *usuarioDAOImpl) Delete(id int64) error {
-	result, err := db.GetDB().Exec("DELETE FROM usuarios WHERE id = $1", id)
+// UpdatePapel altera o papel (role) de um usuário da organização.
+func (d *usuarioDAOImpl) UpdatePapel(ctx context.Context, organizacaoID, id int64, papel string) error {
+	result, err := d.querier(ctx).ExecContext(ctx,
+		"UPDATE usuarios SET papel = $2 WHERE id = $1 AND organizacao_id = $3",
+		id, papel, organizacaoID,
+	)
+	if err != nil {
+		return traduzirErro(err, "atualizar papel do usuário")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return traduzirErro(err, "verificar linhas afetadas")
+	}
+
+	if rowsAffected == 0 {
+		return &model.ResourceNotFoundError{Resource: "Usuário", ID: id}
+	}
+
+	return nil
+}
+
+// CriaCicloGestor indica se tornar gestorID o gestor de usuarioID criaria um ciclo na
+// hierarquia, isto é, se usuarioID já é (direta ou indiretamente) gestor de gestorID.
+func (d *usuarioDAOImpl) CriaCicloGestor(ctx context.Context, usuarioID, gestorID int64) (bool, error) {
+	var ciclo bool
+	err := d.querier(ctx).QueryRowContext(ctx, `
+		WITH RECURSIVE cadeia AS (
+			SELECT $2::BIGINT AS usuario_id
+			UNION
+			SELECT u.gestor_id
+			FROM usuarios u
+			JOIN cadeia c ON u.id = c.usuario_id
+			WHERE u.gestor_id IS NOT NULL
+		)
+		SELECT EXISTS (SELECT 1 FROM cadeia WHERE usuario_id = $1)
+	`, usuarioID, gestorID).Scan(&ciclo)
+	if err != nil {
+		return false, traduzirErro(err, "verificar ciclo de gestores")
+	}
+	return ciclo, nil
+}
+
+// Delete remove um usuário da organização pelo ID.
+func (d *usuarioDAOImpl) Delete(ctx context.Context, organizacaoID, id int64) error {
+	result, err := d.querier(ctx).ExecContext(ctx, "DELETE FROM usuarios WHERE id = $1 AND organizacao_id = $2", id, organizacaoID)
 	if err != nil {
-		log.Printf("Erro ao deletar usuário: %v", err)
-		return fmt.Errorf("erro ao deletar usuário: %w", err)
+		return traduzirErro(err, "deletar usuário")
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		log.Printf("Erro ao verificar linhas afetadas: %v", err)
-		return fmt.Errorf("erro ao verificar linhas afetadas: %w", err)
+		return traduzirErro(err, "verificar linhas afetadas")
 	}
 
 	if rowsAffected == 0 {
@@ -9,60 +9,56 @@ import (
 	_ "github.com/lib/pq"
 )
 
-var db *sql.DB
-
-// InitDB inicializa a conexão com o banco de dados PostgreSQL.
-func InitDB() {
-	// Variáveis de ambiente para conexão com o PostgreSQL
-	host := os.Getenv("DB_HOST")
-	port := os.Getenv("DB_PORT")
-	user := os.Getenv("DB_USER")
-	password := os.Getenv("DB_PASSWORD")
-	dbname := os.Getenv("DB_NAME")
+// Config reúne os parâmetros de conexão com o PostgreSQL.
+type Config struct {
+	Host    string
+	Porta   string
+	Usuario string
+	Senha   string
+	Nome    string
+	SSLMode string
+}
 
-	if host == "" {
-		host = "localhost"
-	}
-	if port == "" {
-		port = "5432"
-	}
-	if user == "" {
-		user = "postgres"
-	}
-	if password == "" {
-		password = "mysecretpassword"
-	}
-	if dbname == "" {
-		dbname = "upskilling_db"
+// ConfigFromEnv lê a configuração das variáveis DB_HOST, DB_PORT, DB_USER, DB_PASSWORD
+// e DB_NAME, com padrões para o ambiente local.
+func ConfigFromEnv() Config {
+	return Config{
+		Host:    envOuPadrao("DB_HOST", "localhost"),
+		Porta:   envOuPadrao("DB_PORT", "5432"),
+		Usuario: envOuPadrao("DB_USER", "postgres"),
+		Senha:   envOuPadrao("DB_PASSWORD", "mysecretpassword"),
+		Nome:    envOuPadrao("DB_NAME", "upskilling_db"),
+		SSLMode: "disable",
 	}
+}
 
-	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		host, port, user, password, dbname)
+// DSN monta a string de conexão do lib/pq.
+func (c Config) DSN() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		c.Host, c.Porta, c.Usuario, c.Senha, c.Nome, c.SSLMode)
+}
 
-	var err error
-	db, err = sql.Open("postgres", connStr)
+// Open abre o pool de conexões com o PostgreSQL e confirma que o banco responde.
+// Fechar o pool cabe a quem o abriu.
+func Open(cfg Config) (*sql.DB, error) {
+	conexao, err := sql.Open("postgres", cfg.DSN())
 	if err != nil {
-		log.Fatalf("Erro ao abrir a conexão com o banco de dados: %v", err)
+		return nil, fmt.Errorf("erro ao abrir a conexão com o banco de dados: %w", err)
 	}
 
 	// Testa a conexão
-	err = db.Ping()
-	if err != nil {
-		log.Fatalf("Erro ao conectar ao banco de dados: %v", err)
+	if err := conexao.Ping(); err != nil {
+		conexao.Close()
+		return nil, fmt.Errorf("erro ao conectar ao banco de dados: %w", err)
 	}
-
 	log.Println("Conexão com o banco de dados PostgreSQL estabelecida com sucesso.")
+	return conexao, nil
 }
 
-// GetDB retorna a instância da conexão com o banco de dados.
-func GetDB() *sql.DB {
-	return db
-}
-
-// CloseDB fecha a conexão com o banco de dados.
-func CloseDB() {
-	if db != nil {
-		db.Close()
-		log.Println("Conexão com o banco de dados fechada.")
+// envOuPadrao retorna a variável de ambiente ou o valor padrão, se ela estiver vazia.
+func envOuPadrao(nome, padrao string) string {
+	if v := os.Getenv(nome); v != "" {
+		return v
 	}
+	return padrao
 }
@@ -0,0 +1,361 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// arquivosMigracoes contém as migrações versionadas, embutidas no binário.
+// Cada versão tem um arquivo NNNN_nome.up.sql e, opcionalmente, NNNN_nome.down.sql.
+//
+//go:embed migracoes/*.sql
+var arquivosMigracoes embed.FS
+
+// chaveLockMigracoes identifica o advisory lock que serializa as migrações entre
+// instâncias concorrentes.
+const chaveLockMigracoes = "upskilling-api:schema_migrations"
+
+var padraoArquivoMigracao = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migracao é uma versão do esquema do banco, com os scripts de aplicação e reversão.
+type Migracao struct {
+	Versao   int64
+	Nome     string
+	Up       string
+	Down     string
+	Checksum string // SHA-256 do script up
+}
+
+// StatusMigracao descreve o estado de uma migração no banco.
+type StatusMigracao struct {
+	Versao             int64
+	Nome               string
+	Aplicada           bool
+	DataAplicacao      *time.Time
+	ChecksumDivergente bool // o script up mudou depois de aplicado
+	Desconhecida       bool // aplicada no banco, mas ausente neste binário
+}
+
+// migracaoAplicada é um registro da tabela schema_migrations.
+type migracaoAplicada struct {
+	nome          string
+	checksum      string
+	dataAplicacao time.Time
+}
+
+// CarregarMigracoes lê as migrações embutidas, em ordem crescente de versão.
+func CarregarMigracoes() ([]Migracao, error) {
+	return carregarMigracoes(arquivosMigracoes)
+}
+
+// carregarMigracoes lê as migrações do diretório migracoes de fsys, em ordem crescente
+// de versão.
+func carregarMigracoes(fsys fs.FS) ([]Migracao, error) {
+	arquivos, err := fs.Glob(fsys, "migracoes/*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar migrações: %w", err)
+	}
+
+	porVersao := make(map[int64]*Migracao)
+	for _, arquivo := range arquivos {
+		nomeArquivo := arquivo[len("migracoes/"):]
+		partes := padraoArquivoMigracao.FindStringSubmatch(nomeArquivo)
+		if partes == nil {
+			return nil, fmt.Errorf("nome de migração inválido: %s (esperado NNNN_nome.up.sql ou NNNN_nome.down.sql)", nomeArquivo)
+		}
+		versao, _ := strconv.ParseInt(partes[1], 10, 64)
+		if versao <= 0 {
+			return nil, fmt.Errorf("versão de migração inválida: %s", nomeArquivo)
+		}
+
+		conteudo, err := fs.ReadFile(fsys, arquivo)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao ler a migração %s: %w", nomeArquivo, err)
+		}
+
+		m, ok := porVersao[versao]
+		if !ok {
+			m = &Migracao{Versao: versao, Nome: partes[2]}
+			porVersao[versao] = m
+		}
+		if m.Nome != partes[2] {
+			return nil, fmt.Errorf("versão %d usada por duas migrações: %s e %s", versao, m.Nome, partes[2])
+		}
+		if partes[3] == "up" {
+			m.Up = string(conteudo)
+			soma := sha256.Sum256(conteudo)
+			m.Checksum = hex.EncodeToString(soma[:])
+		} else {
+			m.Down = string(conteudo)
+		}
+	}
+
+	migracoes := make([]Migracao, 0, len(porVersao))
+	for _, m := range porVersao {
+		if m.Checksum == "" {
+			return nil, fmt.Errorf("migração %04d_%s não tem o script up", m.Versao, m.Nome)
+		}
+		migracoes = append(migracoes, *m)
+	}
+	sort.Slice(migracoes, func(i, j int) bool { return migracoes[i].Versao < migracoes[j].Versao })
+	return migracoes, nil
+}
+
+// Migrador aplica e reverte as migrações embutidas, registrando cada versão aplicada
+// (com o checksum do script) na tabela schema_migrations. Todas as operações seguram
+// um advisory lock do PostgreSQL, para que instâncias concorrentes não migrem ao mesmo
+// tempo, e cada migração roda em sua própria transação.
+type Migrador struct {
+	db        *sql.DB
+	migracoes []Migracao
+}
+
+// NewMigrador cria um Migrador com as migrações embutidas no binário.
+func NewMigrador(conn *sql.DB) (*Migrador, error) {
+	migracoes, err := CarregarMigracoes()
+	if err != nil {
+		return nil, err
+	}
+	return &Migrador{db: conn, migracoes: migracoes}, nil
+}
+
+// Up aplica, em ordem, todas as migrações pendentes e retorna quantas foram aplicadas.
+// Falha sem aplicar nada se alguma migração já aplicada foi alterada (checksum
+// divergente) ou não existe neste binário.
+func (m *Migrador) Up() (int, error) {
+	aplicadas := 0
+	err := m.comLock(func(ctx context.Context, conn *sql.Conn) error {
+		registros, err := m.aplicadas(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verificar(registros); err != nil {
+			return err
+		}
+
+		for _, mig := range m.migracoes {
+			if _, ok := registros[mig.Versao]; ok {
+				continue
+			}
+			log.Printf("Aplicando migração %04d_%s...", mig.Versao, mig.Nome)
+			err := executarMigracao(ctx, conn, mig.Up,
+				"INSERT INTO schema_migrations (versao, nome, checksum) VALUES ($1, $2, $3)",
+				mig.Versao, mig.Nome, mig.Checksum)
+			if err != nil {
+				return fmt.Errorf("erro ao aplicar a migração %04d_%s: %w", mig.Versao, mig.Nome, err)
+			}
+			aplicadas++
+		}
+		return nil
+	})
+	return aplicadas, err
+}
+
+// Down reverte as n últimas migrações aplicadas, da mais recente para a mais antiga,
+// e retorna quantas foram revertidas.
+func (m *Migrador) Down(n int) (int, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("a quantidade de migrações a reverter deve ser positiva")
+	}
+
+	revertidas := 0
+	err := m.comLock(func(ctx context.Context, conn *sql.Conn) error {
+		registros, err := m.aplicadas(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migracoes) - 1; i >= 0 && revertidas < n; i-- {
+			mig := m.migracoes[i]
+			if _, ok := registros[mig.Versao]; !ok {
+				continue
+			}
+			if mig.Down == "" {
+				return fmt.Errorf("migração %04d_%s não tem o script down", mig.Versao, mig.Nome)
+			}
+			log.Printf("Revertendo migração %04d_%s...", mig.Versao, mig.Nome)
+			err := executarMigracao(ctx, conn, mig.Down, "DELETE FROM schema_migrations WHERE versao = $1", mig.Versao)
+			if err != nil {
+				return fmt.Errorf("erro ao reverter a migração %04d_%s: %w", mig.Versao, mig.Nome, err)
+			}
+			revertidas++
+		}
+		return nil
+	})
+	return revertidas, err
+}
+
+// Status lista as migrações embutidas e as aplicadas no banco, em ordem de versão.
+func (m *Migrador) Status() ([]StatusMigracao, error) {
+	var status []StatusMigracao
+	err := m.comLock(func(ctx context.Context, conn *sql.Conn) error {
+		registros, err := m.aplicadas(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migracoes {
+			s := StatusMigracao{Versao: mig.Versao, Nome: mig.Nome}
+			if r, ok := registros[mig.Versao]; ok {
+				dataAplicacao := r.dataAplicacao
+				s.Aplicada = true
+				s.DataAplicacao = &dataAplicacao
+				s.ChecksumDivergente = r.checksum != mig.Checksum
+				delete(registros, mig.Versao)
+			}
+			status = append(status, s)
+		}
+		for versao, r := range registros {
+			dataAplicacao := r.dataAplicacao
+			status = append(status, StatusMigracao{
+				Versao: versao, Nome: r.nome, Aplicada: true, DataAplicacao: &dataAplicacao, Desconhecida: true,
+			})
+		}
+		sort.Slice(status, func(i, j int) bool { return status[i].Versao < status[j].Versao })
+		return nil
+	})
+	return status, err
+}
+
+// Force registra as migrações até a versão informada como aplicadas, com o checksum
+// atual, e remove os registros das versões posteriores, sem executar nenhum script.
+// Serve para adotar um banco criado fora do migrador ou aceitar uma migração alterada
+// depois de aplicada. A versão 0 remove todos os registros.
+func (m *Migrador) Force(versao int64) error {
+	if versao != 0 && m.buscar(versao) == nil {
+		return fmt.Errorf("versão %d não existe entre as migrações", versao)
+	}
+
+	return m.comLock(func(ctx context.Context, conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer tx.Rollback()
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE versao > $1", versao); err != nil {
+			return fmt.Errorf("erro ao remover registros de migração: %w", err)
+		}
+		for _, mig := range m.migracoes {
+			if mig.Versao > versao {
+				break
+			}
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO schema_migrations (versao, nome, checksum) VALUES ($1, $2, $3)
+				ON CONFLICT (versao) DO UPDATE SET nome = EXCLUDED.nome, checksum = EXCLUDED.checksum
+			`, mig.Versao, mig.Nome, mig.Checksum)
+			if err != nil {
+				return fmt.Errorf("erro ao registrar a migração %04d_%s: %w", mig.Versao, mig.Nome, err)
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+// comLock executa fn em uma conexão dedicada que segura o advisory lock das migrações
+// (aguardando, se outra instância estiver migrando) e garante a tabela schema_migrations.
+func (m *Migrador) comLock(fn func(ctx context.Context, conn *sql.Conn) error) error {
+	ctx := context.Background()
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao obter conexão para as migrações: %w", err)
+	}
+	defer conn.Close()
+
+	// O advisory lock pertence à sessão, por isso tudo roda na mesma conexão
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", chaveLockMigracoes); err != nil {
+		return fmt.Errorf("erro ao obter o lock das migrações: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", chaveLockMigracoes); err != nil {
+			log.Printf("Erro ao liberar o lock das migrações: %v", err)
+		}
+	}()
+
+	_, err = conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			versao BIGINT PRIMARY KEY,
+			nome VARCHAR(150) NOT NULL,
+			checksum CHAR(64) NOT NULL,
+			data_aplicacao TIMESTAMP NOT NULL DEFAULT NOW()
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("erro ao criar a tabela schema_migrations: %w", err)
+	}
+
+	return fn(ctx, conn)
+}
+
+// aplicadas retorna os registros de schema_migrations, indexados pela versão.
+func (m *Migrador) aplicadas(ctx context.Context, conn *sql.Conn) (map[int64]migracaoAplicada, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT versao, nome, checksum, data_aplicacao FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("erro ao consultar as migrações aplicadas: %w", err)
+	}
+	defer rows.Close()
+
+	registros := make(map[int64]migracaoAplicada)
+	for rows.Next() {
+		var versao int64
+		var r migracaoAplicada
+		if err := rows.Scan(&versao, &r.nome, &r.checksum, &r.dataAplicacao); err != nil {
+			return nil, fmt.Errorf("erro ao escanear migração aplicada: %w", err)
+		}
+		registros[versao] = r
+	}
+	return registros, rows.Err()
+}
+
+// verificar garante que as migrações aplicadas existem neste binário e não foram
+// alteradas depois de aplicadas.
+func (m *Migrador) verificar(registros map[int64]migracaoAplicada) error {
+	for versao, r := range registros {
+		mig := m.buscar(versao)
+		if mig == nil {
+			return fmt.Errorf("migração %04d_%s está aplicada no banco, mas não existe neste binário", versao, r.nome)
+		}
+		if r.checksum != mig.Checksum {
+			return fmt.Errorf("migração %04d_%s foi alterada depois de aplicada (checksum divergente); "+
+				"crie uma nova migração ou use force para aceitar a alteração", versao, mig.Nome)
+		}
+	}
+	return nil
+}
+
+// buscar retorna a migração da versão informada, ou nil se não existir.
+func (m *Migrador) buscar(versao int64) *Migracao {
+	for i := range m.migracoes {
+		if m.migracoes[i].Versao == versao {
+			return &m.migracoes[i]
+		}
+	}
+	return nil
+}
+
+// executarMigracao executa o script e atualiza schema_migrations na mesma transação.
+func executarMigracao(ctx context.Context, conn *sql.Conn, script, registro string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, registro, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// checksum calcula o SHA-256 esperado para o script up.
+func checksum(script string) string {
+	soma := sha256.Sum256([]byte(script))
+	return hex.EncodeToString(soma[:])
+}
+
+// arquivos monta um sistema de arquivos com os scripts informados em migracoes/.
+func arquivos(scripts map[string]string) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for nome, conteudo := range scripts {
+		fsys["migracoes/"+nome] = &fstest.MapFile{Data: []byte(conteudo)}
+	}
+	return fsys
+}
+
+func TestCarregarMigracoes(t *testing.T) {
+	casos := []struct {
+		nome     string
+		scripts  map[string]string
+		esperado []Migracao
+	}{
+		{
+			"ordem numérica das versões",
+			map[string]string{
+				"10_c.up.sql":  "SELECT 10;",
+				"2_b.up.sql":   "SELECT 2;",
+				"2_b.down.sql": "SELECT -2;",
+				"1_a.up.sql":   "SELECT 1;",
+			},
+			[]Migracao{
+				{Versao: 1, Nome: "a", Up: "SELECT 1;", Checksum: checksum("SELECT 1;")},
+				{Versao: 2, Nome: "b", Up: "SELECT 2;", Down: "SELECT -2;", Checksum: checksum("SELECT 2;")},
+				{Versao: 10, Nome: "c", Up: "SELECT 10;", Checksum: checksum("SELECT 10;")},
+			},
+		},
+		{
+			"checksum só do script up",
+			map[string]string{
+				"0001_a.up.sql":   "CREATE TABLE a ();",
+				"0001_a.down.sql": "DROP TABLE a; -- alterado",
+			},
+			[]Migracao{
+				{Versao: 1, Nome: "a", Up: "CREATE TABLE a ();", Down: "DROP TABLE a; -- alterado", Checksum: checksum("CREATE TABLE a ();")},
+			},
+		},
+		{"sem migrações", map[string]string{}, []Migracao{}},
+	}
+	for _, c := range casos {
+		migracoes, err := carregarMigracoes(arquivos(c.scripts))
+		if err != nil {
+			t.Fatalf("%s: %v", c.nome, err)
+		}
+		if !reflect.DeepEqual(migracoes, c.esperado) {
+			t.Fatalf("%s: migrações = %+v, esperado %+v", c.nome, migracoes, c.esperado)
+		}
+	}
+}
+
+func TestCarregarMigracoesInvalidas(t *testing.T) {
+	casos := []struct {
+		nome    string
+		scripts map[string]string
+		erro    string
+	}{
+		{"nome fora do padrão", map[string]string{"0001-a.up.sql": ""}, "nome de migração inválido"},
+		{"sentido desconhecido", map[string]string{"0001_a.sql": ""}, "nome de migração inválido"},
+		{"versão zero", map[string]string{"0000_a.up.sql": ""}, "versão de migração inválida"},
+		{"versão repetida", map[string]string{"0001_a.up.sql": "", "0001_b.up.sql": ""}, "usada por duas migrações"},
+		{"sem script up", map[string]string{"0001_a.down.sql": ""}, "não tem o script up"},
+	}
+	for _, c := range casos {
+		if _, err := carregarMigracoes(arquivos(c.scripts)); err == nil || !strings.Contains(err.Error(), c.erro) {
+			t.Fatalf("%s: erro = %v, esperado %q", c.nome, err, c.erro)
+		}
+	}
+}
+
+// As migrações embutidas no binário precisam carregar, com versões consecutivas a partir de 1.
+func TestMigracoesEmbutidas(t *testing.T) {
+	migracoes, err := CarregarMigracoes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, m := range migracoes {
+		if m.Versao != int64(i+1) || m.Down == "" {
+			t.Fatalf("migração %04d_%s: esperada a versão %d com script down", m.Versao, m.Nome, i+1)
+		}
+	}
+}
+
+func TestVerificar(t *testing.T) {
+	m := &Migrador{migracoes: []Migracao{
+		{Versao: 1, Nome: "a", Checksum: checksum("SELECT 1;")},
+		{Versao: 2, Nome: "b", Checksum: checksum("SELECT 2;")},
+	}}
+	casos := []struct {
+		nome      string
+		registros map[int64]migracaoAplicada
+		erro      string
+	}{
+		{"nenhuma aplicada", map[int64]migracaoAplicada{}, ""},
+		{"aplicadas sem alteração", map[int64]migracaoAplicada{
+			1: {nome: "a", checksum: checksum("SELECT 1;")},
+			2: {nome: "b", checksum: checksum("SELECT 2;")},
+		}, ""},
+		{"checksum divergente", map[int64]migracaoAplicada{
+			1: {nome: "a", checksum: checksum("SELECT 1; -- alterado")},
+		}, "checksum divergente"},
+		{"aplicada e ausente do binário", map[int64]migracaoAplicada{
+			3: {nome: "c", checksum: checksum("SELECT 3;")},
+		}, "não existe neste binário"},
+	}
+	for _, c := range casos {
+		err := m.verificar(c.registros)
+		if c.erro == "" && err != nil || c.erro != "" && (err == nil || !strings.Contains(err.Error(), c.erro)) {
+			t.Fatalf("%s: erro = %v, esperado %q", c.nome, err, c.erro)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS matriculas;
+DROP TABLE IF EXISTS trilha_competencia;
+DROP TABLE IF EXISTS competencias;
+DROP TABLE IF EXISTS trilhas;
+DROP TABLE IF EXISTS usuarios;
@@ -55,3 +55,8 @@ CREATE INDEX IF NOT EXISTS idx_usuarios_email ON usuarios (email);
 CREATE INDEX IF NOT EXISTS idx_trilhas_nivel ON trilhas (nivel);
 CREATE INDEX IF NOT EXISTS idx_matriculas_usuario ON matriculas (usuario_id);
 CREATE INDEX IF NOT EXISTS idx_matriculas_trilha ON matriculas (trilha_id);
+CREATE INDEX IF NOT EXISTS idx_competencias_categoria ON competencias (LOWER(categoria));
+CREATE INDEX IF NOT EXISTS idx_trilhas_foco_principal ON trilhas (LOWER(foco_principal));
+CREATE INDEX IF NOT EXISTS idx_usuarios_area_atuacao ON usuarios (LOWER(area_atuacao));
+CREATE INDEX IF NOT EXISTS idx_matriculas_usuario_data ON matriculas (usuario_id, data_inscricao, id);
+CREATE INDEX IF NOT EXISTS idx_trilha_competencia_competencia ON trilha_competencia (competencia_id);
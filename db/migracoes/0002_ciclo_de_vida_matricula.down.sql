@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS uq_matriculas_usuario_trilha_ativa;
+DROP TABLE IF EXISTS sessoes_estudo;
+ALTER TABLE matriculas DROP COLUMN IF EXISTS data_ultima_atividade;
+ALTER TABLE matriculas DROP COLUMN IF EXISTS horas_estudadas;
+ALTER TABLE matriculas DROP COLUMN IF EXISTS data_cancelamento;
+ALTER TABLE matriculas DROP COLUMN IF EXISTS data_conclusao;
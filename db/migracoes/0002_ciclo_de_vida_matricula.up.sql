@@ -0,0 +1,35 @@
+-- Datas do ciclo de vida da matrícula (conclusão e cancelamento)
+ALTER TABLE matriculas ADD COLUMN IF NOT EXISTS data_conclusao TIMESTAMP;
+ALTER TABLE matriculas ADD COLUMN IF NOT EXISTS data_cancelamento TIMESTAMP;
+
+-- Progresso da matrícula (horas estudadas e última atividade)
+ALTER TABLE matriculas ADD COLUMN IF NOT EXISTS horas_estudadas NUMERIC(8, 2) NOT NULL DEFAULT 0;
+ALTER TABLE matriculas ADD COLUMN IF NOT EXISTS data_ultima_atividade TIMESTAMP;
+
+-- Sessões de estudo registradas em cada matrícula
+CREATE TABLE IF NOT EXISTS sessoes_estudo (
+    id BIGSERIAL PRIMARY KEY,
+    matricula_id BIGINT NOT NULL,
+    horas NUMERIC(5, 2) NOT NULL CHECK (horas > 0),
+    data_sessao TIMESTAMP NOT NULL DEFAULT NOW(),
+    observacao TEXT,
+    CONSTRAINT fk_sessao_estudo_matricula
+        FOREIGN KEY (matricula_id) REFERENCES matriculas (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessoes_estudo_matricula ON sessoes_estudo (matricula_id);
+
+-- Garante no máximo uma matrícula ATIVA por par usuário/trilha.
+-- Antes de criar o índice, cancela duplicatas legadas mantendo a mais antiga.
+UPDATE matriculas m
+SET status = 'CANCELADA', data_cancelamento = NOW()
+WHERE m.status = 'ATIVA'
+  AND EXISTS (
+      SELECT 1 FROM matriculas o
+      WHERE o.usuario_id = m.usuario_id
+        AND o.trilha_id = m.trilha_id
+        AND o.status = 'ATIVA'
+        AND o.id < m.id
+  );
+CREATE UNIQUE INDEX IF NOT EXISTS uq_matriculas_usuario_trilha_ativa
+    ON matriculas (usuario_id, trilha_id) WHERE status = 'ATIVA';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS aulas_concluidas;
+DROP TABLE IF EXISTS aulas;
+DROP TABLE IF EXISTS modulos;
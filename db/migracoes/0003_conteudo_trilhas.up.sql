@@ -0,0 +1,38 @@
+-- Módulos de conteúdo de uma trilha
+CREATE TABLE IF NOT EXISTS modulos (
+    id BIGSERIAL PRIMARY KEY,
+    trilha_id BIGINT NOT NULL,
+    titulo VARCHAR(150) NOT NULL,
+    descricao TEXT,
+    ordem INT NOT NULL,
+    CONSTRAINT fk_modulo_trilha
+        FOREIGN KEY (trilha_id) REFERENCES trilhas (id) ON DELETE CASCADE
+);
+
+-- Aulas/atividades de um módulo
+CREATE TABLE IF NOT EXISTS aulas (
+    id BIGSERIAL PRIMARY KEY,
+    modulo_id BIGINT NOT NULL,
+    titulo VARCHAR(150) NOT NULL,
+    tipo VARCHAR(30) NOT NULL, -- ex: "VIDEO", "LEITURA", "EXERCICIO", "PROJETO", "QUIZ"
+    ordem INT NOT NULL,
+    duracao_minutos INT NOT NULL CHECK (duracao_minutos > 0),
+    url TEXT,
+    CONSTRAINT fk_aula_modulo
+        FOREIGN KEY (modulo_id) REFERENCES modulos (id) ON DELETE CASCADE
+);
+
+-- Aulas concluídas em cada matrícula
+CREATE TABLE IF NOT EXISTS aulas_concluidas (
+    matricula_id BIGINT NOT NULL,
+    aula_id BIGINT NOT NULL,
+    data_conclusao TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (matricula_id, aula_id),
+    CONSTRAINT fk_aula_concluida_matricula
+        FOREIGN KEY (matricula_id) REFERENCES matriculas (id) ON DELETE CASCADE,
+    CONSTRAINT fk_aula_concluida_aula
+        FOREIGN KEY (aula_id) REFERENCES aulas (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_modulos_trilha ON modulos (trilha_id, ordem);
+CREATE INDEX IF NOT EXISTS idx_aulas_modulo ON aulas (modulo_id, ordem);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS trilha_competencias_requeridas;
+DROP TABLE IF EXISTS trilha_prerequisitos;
+ALTER TABLE trilhas DROP COLUMN IF EXISTS nivel_carreira_minimo;
@@ -0,0 +1,25 @@
+-- Requisitos de elegibilidade das trilhas
+ALTER TABLE trilhas ADD COLUMN IF NOT EXISTS nivel_carreira_minimo VARCHAR(50);
+
+-- Trilhas que precisam estar CONCLUIDAS antes da inscrição em outra trilha
+CREATE TABLE IF NOT EXISTS trilha_prerequisitos (
+    trilha_id BIGINT NOT NULL,
+    prerequisito_id BIGINT NOT NULL,
+    PRIMARY KEY (trilha_id, prerequisito_id),
+    CONSTRAINT ck_trilha_prerequisito_distinto CHECK (trilha_id <> prerequisito_id),
+    CONSTRAINT fk_trilha_prerequisito_trilha
+        FOREIGN KEY (trilha_id) REFERENCES trilhas (id) ON DELETE CASCADE,
+    CONSTRAINT fk_trilha_prerequisito_prerequisito
+        FOREIGN KEY (prerequisito_id) REFERENCES trilhas (id) ON DELETE CASCADE
+);
+
+-- Competências que o usuário precisa possuir antes da inscrição na trilha
+CREATE TABLE IF NOT EXISTS trilha_competencias_requeridas (
+    trilha_id BIGINT NOT NULL,
+    competencia_id BIGINT NOT NULL,
+    PRIMARY KEY (trilha_id, competencia_id),
+    CONSTRAINT fk_trilha_competencia_requerida_trilha
+        FOREIGN KEY (trilha_id) REFERENCES trilhas (id) ON DELETE CASCADE,
+    CONSTRAINT fk_trilha_competencia_requerida_competencia
+        FOREIGN KEY (competencia_id) REFERENCES competencias (id) ON DELETE CASCADE
+);
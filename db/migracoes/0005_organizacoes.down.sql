@@ -0,0 +1,7 @@
+ALTER TABLE matriculas DROP COLUMN IF EXISTS data_prazo;
+ALTER TABLE usuarios DROP COLUMN IF EXISTS gestor_id;
+ALTER TABLE trilhas DROP COLUMN IF EXISTS organizacao_id;
+ALTER TABLE usuarios DROP COLUMN IF EXISTS equipe_id;
+ALTER TABLE usuarios DROP COLUMN IF EXISTS organizacao_id;
+DROP TABLE IF EXISTS equipes;
+DROP TABLE IF EXISTS organizacoes;
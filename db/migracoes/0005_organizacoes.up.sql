@@ -0,0 +1,47 @@
+-- Organizações (tenants) e equipes. A organização marcada como plataforma mantém o
+-- catálogo público (trilhas sem organização) e as competências compartilhadas.
+CREATE TABLE IF NOT EXISTS organizacoes (
+    id BIGSERIAL PRIMARY KEY,
+    nome VARCHAR(150) NOT NULL,
+    slug VARCHAR(60) NOT NULL UNIQUE,
+    plataforma BOOLEAN NOT NULL DEFAULT FALSE,
+    data_criacao TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS equipes (
+    id BIGSERIAL PRIMARY KEY,
+    organizacao_id BIGINT NOT NULL,
+    nome VARCHAR(100) NOT NULL,
+    CONSTRAINT uq_equipes_organizacao_nome UNIQUE (organizacao_id, nome),
+    CONSTRAINT fk_equipe_organizacao
+        FOREIGN KEY (organizacao_id) REFERENCES organizacoes (id) ON DELETE CASCADE
+);
+
+-- Organização da plataforma; usuários sem organização (legado) passam a pertencer a ela
+INSERT INTO organizacoes (nome, slug, plataforma)
+VALUES ('Plataforma Upskilling', 'plataforma', TRUE)
+ON CONFLICT (slug) DO NOTHING;
+
+ALTER TABLE usuarios ADD COLUMN IF NOT EXISTS organizacao_id BIGINT
+    REFERENCES organizacoes (id) ON DELETE CASCADE;
+ALTER TABLE usuarios ADD COLUMN IF NOT EXISTS equipe_id BIGINT
+    REFERENCES equipes (id) ON DELETE SET NULL;
+UPDATE usuarios SET organizacao_id = (SELECT id FROM organizacoes WHERE slug = 'plataforma')
+WHERE organizacao_id IS NULL;
+ALTER TABLE usuarios ALTER COLUMN organizacao_id SET NOT NULL;
+
+-- Trilhas sem organização formam o catálogo público; as demais são privadas do tenant
+ALTER TABLE trilhas ADD COLUMN IF NOT EXISTS organizacao_id BIGINT
+    REFERENCES organizacoes (id) ON DELETE CASCADE;
+
+-- Gestor direto do usuário (relação gestor → liderados, dentro da mesma organização)
+ALTER TABLE usuarios ADD COLUMN IF NOT EXISTS gestor_id BIGINT
+    REFERENCES usuarios (id) ON DELETE SET NULL;
+
+-- Prazo opcional para concluir a matrícula; matrículas ATIVAS após o prazo estão atrasadas
+ALTER TABLE matriculas ADD COLUMN IF NOT EXISTS data_prazo DATE;
+
+CREATE INDEX IF NOT EXISTS idx_usuarios_organizacao ON usuarios (organizacao_id);
+CREATE INDEX IF NOT EXISTS idx_usuarios_equipe ON usuarios (equipe_id);
+CREATE INDEX IF NOT EXISTS idx_trilhas_organizacao ON trilhas (organizacao_id);
+CREATE INDEX IF NOT EXISTS idx_usuarios_gestor ON usuarios (gestor_id);
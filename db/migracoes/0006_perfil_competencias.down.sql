@@ -0,0 +1,2 @@
+DROP VIEW IF EXISTS usuario_competencias_efetivas;
+DROP TABLE IF EXISTS usuario_competencias;
@@ -0,0 +1,46 @@
+-- Perfil de competências do usuário: nível de proficiência (1 a 5) por origem.
+-- AUTOAVALIACAO é declarada pelo próprio usuário; TRILHA é concedida ao concluir uma
+-- trilha que desenvolve a competência; GESTOR é a validação do gestor ou de um admin.
+CREATE TABLE IF NOT EXISTS usuario_competencias (
+    usuario_id BIGINT NOT NULL,
+    competencia_id BIGINT NOT NULL,
+    origem VARCHAR(20) NOT NULL
+        CONSTRAINT ck_usuario_competencias_origem CHECK (origem IN ('AUTOAVALIACAO', 'TRILHA', 'GESTOR')),
+    nivel SMALLINT NOT NULL
+        CONSTRAINT ck_usuario_competencias_nivel CHECK (nivel BETWEEN 1 AND 5),
+    data_atualizacao TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (usuario_id, competencia_id, origem),
+    CONSTRAINT fk_usuario_competencia_usuario
+        FOREIGN KEY (usuario_id) REFERENCES usuarios (id) ON DELETE CASCADE,
+    CONSTRAINT fk_usuario_competencia_competencia
+        FOREIGN KEY (competencia_id) REFERENCES competencias (id) ON DELETE CASCADE
+);
+
+-- Nível efetivo de cada competência do usuário: a validação do gestor prevalece sobre a
+-- conclusão de trilha, que prevalece sobre a autoavaliação. Apenas as duas primeiras
+-- origens contam como competência verificada.
+CREATE OR REPLACE VIEW usuario_competencias_efetivas AS
+SELECT DISTINCT ON (usuario_id, competencia_id)
+    usuario_id,
+    competencia_id,
+    nivel,
+    origem,
+    origem <> 'AUTOAVALIACAO' AS verificada,
+    data_atualizacao
+FROM usuario_competencias
+ORDER BY usuario_id, competencia_id,
+    CASE origem WHEN 'GESTOR' THEN 1 WHEN 'TRILHA' THEN 2 ELSE 3 END;
+
+-- Competências das trilhas já concluídas antes da existência do perfil
+INSERT INTO usuario_competencias (usuario_id, competencia_id, origem, nivel, data_atualizacao)
+SELECT m.usuario_id, tc.competencia_id, 'TRILHA',
+    MAX(CASE t.nivel WHEN 'AVANCADO' THEN 4 WHEN 'INTERMEDIARIO' THEN 3 ELSE 2 END),
+    MAX(COALESCE(m.data_conclusao, NOW()))
+FROM matriculas m
+JOIN trilhas t ON t.id = m.trilha_id
+JOIN trilha_competencia tc ON tc.trilha_id = m.trilha_id
+WHERE m.status = 'CONCLUIDA'
+GROUP BY m.usuario_id, tc.competencia_id
+ON CONFLICT (usuario_id, competencia_id, origem) DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_usuario_competencias_competencia ON usuario_competencias (competencia_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS cargo_competencias;
+DROP TABLE IF EXISTS cargos;
@@ -0,0 +1,22 @@
+-- Cargos-alvo: papéis que o usuário deseja alcançar, com as competências e os níveis
+-- mínimos exigidos. Como as trilhas, cargos sem organização formam o catálogo público.
+CREATE TABLE IF NOT EXISTS cargos (
+    id BIGSERIAL PRIMARY KEY,
+    nome VARCHAR(150) NOT NULL,
+    descricao TEXT,
+    organizacao_id BIGINT REFERENCES organizacoes (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS cargo_competencias (
+    cargo_id BIGINT NOT NULL,
+    competencia_id BIGINT NOT NULL,
+    nivel_minimo SMALLINT NOT NULL
+        CONSTRAINT ck_cargo_competencias_nivel CHECK (nivel_minimo BETWEEN 1 AND 5),
+    PRIMARY KEY (cargo_id, competencia_id),
+    CONSTRAINT fk_cargo_competencia_cargo
+        FOREIGN KEY (cargo_id) REFERENCES cargos (id) ON DELETE CASCADE,
+    CONSTRAINT fk_cargo_competencia_competencia
+        FOREIGN KEY (competencia_id) REFERENCES competencias (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_cargos_organizacao ON cargos (organizacao_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS refresh_tokens;
+ALTER TABLE usuarios DROP COLUMN IF EXISTS papel;
+ALTER TABLE usuarios DROP COLUMN IF EXISTS senha_hash;
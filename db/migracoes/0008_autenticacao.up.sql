@@ -0,0 +1,20 @@
+-- Credenciais: hash bcrypt da senha do usuário
+ALTER TABLE usuarios ADD COLUMN IF NOT EXISTS senha_hash VARCHAR(100);
+
+-- Papel (role) do usuário: learner, manager, curator ou admin
+ALTER TABLE usuarios ADD COLUMN IF NOT EXISTS papel VARCHAR(20) NOT NULL DEFAULT 'learner'
+    CONSTRAINT ck_usuarios_papel CHECK (papel IN ('learner', 'manager', 'curator', 'admin'));
+
+-- Refresh tokens emitidos no login (apenas o hash SHA-256 é armazenado)
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    usuario_id BIGINT NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    data_criacao TIMESTAMP NOT NULL DEFAULT NOW(),
+    data_expiracao TIMESTAMP NOT NULL,
+    data_revogacao TIMESTAMP,
+    CONSTRAINT fk_refresh_token_usuario
+        FOREIGN KEY (usuario_id) REFERENCES usuarios (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_usuario ON refresh_tokens (usuario_id) WHERE data_revogacao IS NULL;
@@ -0,0 +1,4 @@
+-- A extensão unaccent é mantida: pode ser usada por outros objetos do banco
+ALTER TABLE competencias DROP COLUMN IF EXISTS busca;
+ALTER TABLE trilhas DROP COLUMN IF EXISTS busca;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS pt_unaccent;
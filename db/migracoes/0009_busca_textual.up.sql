@@ -0,0 +1,25 @@
+-- Busca textual: configuração em português sem acentos (stemming + unaccent)
+CREATE EXTENSION IF NOT EXISTS unaccent;
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'pt_unaccent') THEN
+        CREATE TEXT SEARCH CONFIGURATION pt_unaccent (COPY = portuguese);
+        ALTER TEXT SEARCH CONFIGURATION pt_unaccent
+            ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+    END IF;
+END
+$$;
+
+-- Documentos de busca (pesos: A = nome, B = foco principal, C = descrição)
+ALTER TABLE trilhas ADD COLUMN IF NOT EXISTS busca TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('pt_unaccent', COALESCE(nome, '')), 'A') ||
+    setweight(to_tsvector('pt_unaccent', COALESCE(foco_principal, '')), 'B') ||
+    setweight(to_tsvector('pt_unaccent', COALESCE(descricao, '')), 'C')
+) STORED;
+ALTER TABLE competencias ADD COLUMN IF NOT EXISTS busca TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('pt_unaccent', COALESCE(nome, '')), 'A') ||
+    setweight(to_tsvector('pt_unaccent', COALESCE(descricao, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_trilhas_busca ON trilhas USING GIN (busca);
+CREATE INDEX IF NOT EXISTS idx_competencias_busca ON competencias USING GIN (busca);
@@ -0,0 +1 @@
+ALTER TABLE trilhas DROP CONSTRAINT IF EXISTS ck_trilhas_carga_horaria_positiva;
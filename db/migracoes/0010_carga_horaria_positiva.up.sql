@@ -0,0 +1,14 @@
+-- A carga horária da trilha é sempre positiva: com 0, a primeira sessão de estudo já
+-- concluiria a matrícula. Linhas legadas com 0 voltam a refletir a duração das aulas
+-- (ou 1 hora, se a trilha não tiver aulas) antes de criar a constraint.
+UPDATE trilhas t
+SET carga_horaria = GREATEST(1, CEIL(COALESCE((
+    SELECT SUM(a.duracao_minutos)
+    FROM aulas a
+    JOIN modulos m ON m.id = a.modulo_id
+    WHERE m.trilha_id = t.id
+), 0) / 60.0)::INT)
+WHERE t.carga_horaria <= 0;
+
+ALTER TABLE trilhas DROP CONSTRAINT IF EXISTS ck_trilhas_carga_horaria_positiva;
+ALTER TABLE trilhas ADD CONSTRAINT ck_trilhas_carga_horaria_positiva CHECK (carga_horaria > 0);
@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/matriculas": {
+        "/auth/login": {
             "post": {
-                "description": "Realiza a inscrição de um usuário em uma trilha de aprendizagem.",
+                "description": "Valida email e senha e emite um access token JWT (Bearer) e um refresh token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,25 +25,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Matriculas"
+                    "Auth"
                 ],
-                "summary": "Matricular usuário em uma trilha",
+                "summary": "Autentica um usuário",
                 "parameters": [
                     {
-                        "description": "Dados da Matrícula",
-                        "name": "matricula",
+                        "description": "Email e senha",
+                        "name": "credenciais",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.MatricularRequest"
+                            "$ref": "#/definitions/model.LoginRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Matricula"
+                            "$ref": "#/definitions/model.TokenResponse"
                         }
                     },
                     "400": {
@@ -52,8 +52,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
@@ -67,63 +67,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/trilhas": {
-            "get": {
-                "description": "Retorna uma lista de todas as trilhas cadastradas.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Trilhas"
-                ],
-                "summary": "Lista todas as trilhas de aprendizagem",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.TrilhaResponse"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
-                    }
-                }
-            },
+        "/auth/logout": {
             "post": {
-                "description": "Cria uma nova trilha de upskilling/reskilling.",
+                "description": "Revoga o refresh token informado. O access token permanece válido até expirar.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Trilhas"
+                    "Auth"
                 ],
-                "summary": "Cria uma nova trilha de aprendizagem",
+                "summary": "Encerra a sessão",
                 "parameters": [
                     {
-                        "description": "Dados da Trilha",
-                        "name": "trilha",
+                        "description": "Refresh token",
+                        "name": "token",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateTrilhaRequest"
+                            "$ref": "#/definitions/model.RefreshTokenRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/model.TrilhaResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -140,48 +107,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/trilhas/{id}": {
-            "get": {
-                "description": "Retorna os detalhes de uma trilha específica.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Trilhas"
-                ],
-                "summary": "Busca uma trilha por ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID da Trilha",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.TrilhaResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
-                    }
-                }
-            },
-            "put": {
-                "description": "Atualiza os dados de uma trilha existente.",
+        "/auth/refresh": {
+            "post": {
+                "description": "Troca um refresh token válido por um novo par de tokens. O refresh token usado é revogado.",
                 "consumes": [
                     "application/json"
                 ],
@@ -189,24 +117,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Trilhas"
+                    "Auth"
                 ],
-                "summary": "Atualiza uma trilha",
+                "summary": "Renova os tokens",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID da Trilha",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Dados da Trilha para atualização",
-                        "name": "trilha",
+                        "description": "Refresh token",
+                        "name": "token",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateTrilhaRequest"
+                            "$ref": "#/definitions/model.RefreshTokenRequest"
                         }
                     }
                 ],
@@ -214,7 +135,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.TrilhaResponse"
+                            "$ref": "#/definitions/model.TokenResponse"
                         }
                     },
                     "400": {
@@ -223,8 +144,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
@@ -236,64 +157,71 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove uma trilha de aprendizagem.",
+            }
+        },
+        "/cargos": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma página dos cargos-alvo visíveis para a organização (catálogo público e cargos privados), sem as competências. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Trilhas"
+                    "Cargos"
                 ],
-                "summary": "Deleta uma trilha",
+                "summary": "Lista os cargos-alvo",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID da Trilha",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "Deslocamento (ignorado quando cursor é informado)",
+                        "name": "offset",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página (X-Next-Cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação: id, nome (prefixo '-' para decrescente)",
+                        "name": "sort",
+                        "in": "query"
                     }
-                }
-            }
-        },
-        "/usuarios": {
-            "get": {
-                "description": "Retorna uma lista de todos os usuários cadastrados.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Usuarios"
                 ],
-                "summary": "Lista todos os usuários",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.UsuarioResponse"
+                                "$ref": "#/definitions/model.CargoResponse"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -303,7 +231,12 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Cria um novo usuário na plataforma de upskilling/reskilling.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria um cargo-alvo com as competências e os níveis mínimos (1 a 5) exigidos, privado da organização do usuário. Com publica=true, o cargo entra no catálogo público (apenas curadores da plataforma).",
                 "consumes": [
                     "application/json"
                 ],
@@ -311,17 +244,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Usuarios"
+                    "Cargos"
                 ],
-                "summary": "Cria um novo usuário",
+                "summary": "Cria um cargo-alvo",
                 "parameters": [
                     {
-                        "description": "Dados do Usuário",
-                        "name": "usuario",
+                        "description": "Dados do Cargo",
+                        "name": "cargo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.CreateUsuarioRequest"
+                            "$ref": "#/definitions/model.CreateCargoRequest"
                         }
                     }
                 ],
@@ -329,7 +262,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/model.UsuarioResponse"
+                            "$ref": "#/definitions/model.CargoResponse"
                         }
                     },
                     "400": {
@@ -338,8 +271,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
@@ -353,20 +298,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/usuarios/{id}": {
+        "/cargos/{id}": {
             "get": {
-                "description": "Retorna os detalhes de um usuário específico.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna um cargo-alvo com as competências e os níveis mínimos exigidos.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Usuarios"
+                    "Cargos"
                 ],
-                "summary": "Busca um usuário por ID",
+                "summary": "Busca um cargo-alvo por ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID do Usuário",
+                        "description": "ID do Cargo",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -376,7 +326,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.UsuarioResponse"
+                            "$ref": "#/definitions/model.CargoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "404": {
@@ -394,7 +356,12 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Atualiza os dados de um usuário existente.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza o nome e a descrição de um cargo-alvo.",
                 "consumes": [
                     "application/json"
                 ],
@@ -402,24 +369,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Usuarios"
+                    "Cargos"
                 ],
-                "summary": "Atualiza um usuário",
+                "summary": "Atualiza um cargo-alvo",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID do Usuário",
+                        "description": "ID do Cargo",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Dados do Usuário para atualização",
-                        "name": "usuario",
+                        "description": "Dados do Cargo para atualização",
+                        "name": "cargo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateUsuarioRequest"
+                            "$ref": "#/definitions/model.UpdateCargoRequest"
                         }
                     }
                 ],
@@ -427,7 +394,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.UsuarioResponse"
+                            "$ref": "#/definitions/model.CargoResponse"
                         }
                     },
                     "400": {
@@ -436,6 +403,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -451,18 +430,23 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Remove um usuário da plataforma.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um cargo-alvo e suas competências exigidas.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Usuarios"
+                    "Cargos"
                 ],
-                "summary": "Deleta um usuário",
+                "summary": "Deleta um cargo-alvo",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID do Usuário",
+                        "description": "ID do Cargo",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -472,6 +456,18 @@ const docTemplate = `{
                     "204": {
                         "description": "No Content"
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -487,32 +483,142 @@ const docTemplate = `{
                 }
             }
         },
-        "/usuarios/{id}/matriculas": {
-            "get": {
-                "description": "Retorna todas as matrículas de um usuário específico.",
+        "/cargos/{id}/competencias": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Substitui as competências exigidas pelo cargo e seus níveis mínimos (1 a 5).",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Matriculas"
+                    "Cargos"
                 ],
-                "summary": "Lista matrículas de um usuário",
+                "summary": "Define as competências de um cargo-alvo",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID do Usuário",
+                        "description": "ID do Cargo",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
+                    },
+                    {
+                        "description": "Níveis mínimos por competência",
+                        "name": "competencias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SetCargoCompetenciasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CargoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/competencias": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma página de competências, com filtro opcional por categoria. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Competencias"
+                ],
+                "summary": "Lista as competências",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Categoria da competência (ex: Tecnologia, Humana, Gestão)",
+                        "name": "categoria",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Deslocamento (ignorado quando cursor é informado)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página (X-Next-Cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação: id, nome (prefixo '-' para decrescente)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.Matricula"
+                                "$ref": "#/definitions/model.CompetenciaResponse"
                             }
                         }
                     },
@@ -522,8 +628,195 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra uma nova competência (skill) do futuro do trabalho. As competências são compartilhadas entre as organizações; apenas curadores da plataforma as alteram.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Competencias"
+                ],
+                "summary": "Cria uma nova competência",
+                "parameters": [
+                    {
+                        "description": "Dados da Competência",
+                        "name": "competencia",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateCompetenciaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.CompetenciaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/competencias/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os detalhes de uma competência específica.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Competencias"
+                ],
+                "summary": "Busca uma competência por ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Competência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CompetenciaResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma competência existente.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Competencias"
+                ],
+                "summary": "Atualiza uma competência",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Competência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da Competência para atualização",
+                        "name": "competencia",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateCompetenciaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CompetenciaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
@@ -535,111 +828,4771 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove uma competência e suas associações com trilhas.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Competencias"
+                ],
+                "summary": "Deleta uma competência",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Competência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/competencias/{id}/trilhas": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as trilhas que desenvolvem uma competência específica.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Competencias"
+                ],
+                "summary": "Lista as trilhas de uma competência",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Competência",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.TrilhaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/matriculas": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Realiza a inscrição de um usuário em uma trilha de aprendizagem, após avaliar os requisitos de elegibilidade da trilha. O prazo (data_prazo) é opcional.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Matricular usuário em uma trilha",
+                "parameters": [
+                    {
+                        "description": "Dados da Matrícula",
+                        "name": "matricula",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.MatricularRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.Matricula"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/matriculas/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os detalhes de uma matrícula específica.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Busca uma matrícula por ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Matrícula",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Matricula"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/matriculas/{id}/aulas/{aulaId}/concluir": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marca uma aula da trilha como concluída; sua duração é somada às horas estudadas e pode concluir a matrícula.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Conclui uma aula na matrícula",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Matrícula",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da Aula",
+                        "name": "aulaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProgressoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/matriculas/{id}/cancelar": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marca uma matrícula ATIVA como CANCELADA e registra a data de cancelamento.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Cancela uma matrícula",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Matrícula",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Matricula"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/matriculas/{id}/concluir": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marca uma matrícula ATIVA como CONCLUIDA e registra a data de conclusão. Apenas o gestor direto do usuário ou quem gerencia matrículas pode concluí-la manualmente.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Conclui uma matrícula",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Matrícula",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Matricula"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/matriculas/{id}/progresso": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as horas estudadas, a carga horária da trilha, o percentual concluído e a data da última atividade.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Consulta o progresso de uma matrícula",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Matrícula",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProgressoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/matriculas/{id}/reativar": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma matrícula CANCELADA ao status ATIVA.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Reativa uma matrícula",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Matrícula",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Matricula"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/matriculas/{id}/sessoes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as sessões de estudo registradas, da mais recente para a mais antiga.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Lista as sessões de estudo de uma matrícula",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Matrícula",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.SessaoEstudo"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registra horas estudadas em uma matrícula ATIVA. Ao atingir a carga horária da trilha, a matrícula é concluída automaticamente.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Registra uma sessão de estudo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Matrícula",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da Sessão de Estudo",
+                        "name": "sessao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.RegistrarSessaoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProgressoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/organizacao": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os dados da organização (tenant) à qual o usuário autenticado pertence.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organizacoes"
+                ],
+                "summary": "Retorna a organização do usuário autenticado",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Organizacao"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/organizacao/equipes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as equipes da organização do usuário autenticado, em ordem alfabética.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organizacoes"
+                ],
+                "summary": "Lista as equipes da organização",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Equipe"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma equipe na organização do usuário autenticado. Apenas administradores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organizacoes"
+                ],
+                "summary": "Cria uma equipe",
+                "parameters": [
+                    {
+                        "description": "Dados da Equipe",
+                        "name": "equipe",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.EquipeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.Equipe"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/organizacao/equipes/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Altera o nome de uma equipe da organização. Apenas administradores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organizacoes"
+                ],
+                "summary": "Renomeia uma equipe",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Equipe",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da Equipe",
+                        "name": "equipe",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.EquipeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Equipe"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove uma equipe da organização; os membros ficam sem equipe. Apenas administradores.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organizacoes"
+                ],
+                "summary": "Remove uma equipe",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Equipe",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/organizacoes": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma organização (tenant) e seu primeiro usuário, com papel admin, em uma única operação. Apenas administradores da plataforma.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organizacoes"
+                ],
+                "summary": "Registra uma nova organização",
+                "parameters": [
+                    {
+                        "description": "Dados da Organização e do administrador",
+                        "name": "organizacao",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateOrganizacaoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.RegistroOrganizacaoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Busca textual em trilhas (nome, descrição e foco principal) e competências (nome e descrição), com stemming em português e sem diferenciar acentos. Os resultados vêm ordenados por relevância, com os termos encontrados destacados em \u003cmark\u003e no trecho. Aceita a sintaxe de busca web (\"frase exata\", OR, -termo). Metadados de paginação nos cabeçalhos X-Total-Count e Link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Busca"
+                ],
+                "summary": "Busca trilhas e competências",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Termo de busca (ex: python dados)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Restringe a busca a 'trilha' ou 'competencia'",
+                        "name": "tipo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Deslocamento",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ResultadoBusca"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma página das trilhas visíveis para a organização (catálogo público e trilhas privadas). Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Lista todas as trilhas de aprendizagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Use 'competencias' para incluir as competências de cada trilha",
+                        "name": "incluir",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Deslocamento (ignorado quando cursor é informado)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página (X-Next-Cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação: id, nome, nivel, carga_horaria (prefixo '-' para decrescente)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra por nível (INICIANTE, INTERMEDIARIO, AVANCADO)",
+                        "name": "nivel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra por foco principal",
+                        "name": "foco_principal",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.TrilhaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cria uma nova trilha de upskilling/reskilling, privada da organização do usuário. Com publica=true, a trilha entra no catálogo público (apenas curadores da plataforma).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Cria uma nova trilha de aprendizagem",
+                "parameters": [
+                    {
+                        "description": "Dados da Trilha",
+                        "name": "trilha",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateTrilhaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.TrilhaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os detalhes de uma trilha específica.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Busca uma trilha por ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Use 'competencias' para incluir as competências da trilha",
+                        "name": "incluir",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.TrilhaResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma trilha existente.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Atualiza uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da Trilha para atualização",
+                        "name": "trilha",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateTrilhaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.TrilhaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove uma trilha de aprendizagem.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Deleta uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas/{id}/competencias": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as competências desenvolvidas por uma trilha.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Lista as competências de uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CompetenciaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Substitui todas as competências associadas a uma trilha pela lista informada.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Define as competências de uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "IDs das Competências",
+                        "name": "competencias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SetTrilhaCompetenciasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CompetenciaResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas/{id}/competencias/{competenciaId}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adiciona uma competência ao conjunto de competências desenvolvidas pela trilha.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Associa uma competência a uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da Competência",
+                        "name": "competenciaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Desfaz a associação entre a trilha e a competência.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Remove uma competência de uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da Competência",
+                        "name": "competenciaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas/{id}/modulos": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os módulos da trilha em ordem, cada um com suas aulas/atividades.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modulos"
+                ],
+                "summary": "Lista o conteúdo de uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.ModuloResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adiciona um módulo de conteúdo à trilha. Sem ordem informada, o módulo é posicionado após o último.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modulos"
+                ],
+                "summary": "Cria um módulo na trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Módulo",
+                        "name": "modulo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateModuloRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ModuloResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas/{id}/modulos/{moduloId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna um módulo com suas aulas/atividades em ordem.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modulos"
+                ],
+                "summary": "Busca um módulo da trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID do Módulo",
+                        "name": "moduloId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ModuloResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza título, descrição ou ordem de um módulo.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modulos"
+                ],
+                "summary": "Atualiza um módulo da trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID do Módulo",
+                        "name": "moduloId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Módulo para atualização",
+                        "name": "modulo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateModuloRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ModuloResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove o módulo e suas aulas; a carga horária da trilha é recalculada.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modulos"
+                ],
+                "summary": "Deleta um módulo da trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID do Módulo",
+                        "name": "moduloId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas/{id}/modulos/{moduloId}/aulas": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adiciona uma aula/atividade ao módulo; a carga horária da trilha é recalculada a partir das aulas.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modulos"
+                ],
+                "summary": "Cria uma aula no módulo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID do Módulo",
+                        "name": "moduloId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da Aula",
+                        "name": "aula",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateAulaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.Aula"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas/{id}/modulos/{moduloId}/aulas/{aulaId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma aula/atividade; a carga horária da trilha é recalculada.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modulos"
+                ],
+                "summary": "Atualiza uma aula do módulo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID do Módulo",
+                        "name": "moduloId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da Aula",
+                        "name": "aulaId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da Aula para atualização",
+                        "name": "aula",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateAulaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Aula"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove uma aula/atividade; a carga horária da trilha é recalculada.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Modulos"
+                ],
+                "summary": "Deleta uma aula do módulo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID do Módulo",
+                        "name": "moduloId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da Aula",
+                        "name": "aulaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/trilhas/{id}/requisitos": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna o nível de carreira mínimo, as trilhas pré-requisito e as competências requeridas para a inscrição.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Lista os requisitos de uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.RequisitosResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Substitui o nível de carreira mínimo, as trilhas pré-requisito e as competências requeridas da trilha.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Trilhas"
+                ],
+                "summary": "Define os requisitos de uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Requisitos da Trilha",
+                        "name": "requisitos",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SetRequisitosRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.RequisitosResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma página dos usuários da organização. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Lista todos os usuários",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Deslocamento (ignorado quando cursor é informado)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página (X-Next-Cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação: id, nome, email, data_cadastro (prefixo '-' para decrescente)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra por área de atuação",
+                        "name": "area_atuacao",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra por nível de carreira",
+                        "name": "nivel_carreira",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra por papel (learner, manager, curator, admin)",
+                        "name": "papel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filtra por equipe",
+                        "name": "equipe_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filtra por gestor direto",
+                        "name": "gestor_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.UsuarioResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cadastra um usuário na organização do administrador autenticado, opcionalmente em uma equipe.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Cria um novo usuário",
+                "parameters": [
+                    {
+                        "description": "Dados do Usuário",
+                        "name": "usuario",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CreateUsuarioRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.UsuarioResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os detalhes de um usuário específico.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Busca um usuário por ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UsuarioResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atualiza os dados de um usuário existente. Apenas administradores alteram a equipe e o gestor. Para trocar a própria senha, informe também a senha atual em senha_atual. O nível de carreira só é alterado pelo gestor direto ou por administradores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Atualiza um usuário",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do Usuário para atualização",
+                        "name": "usuario",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.UpdateUsuarioRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UsuarioResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove um usuário da organização.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Deleta um usuário",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}/competencias": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lista as competências do usuário com o nível de proficiência efetivo (1 a 5) e as avaliações de cada origem: AUTOAVALIACAO, TRILHA (concedida ao concluir uma trilha) e GESTOR (validação). A validação do gestor prevalece sobre a trilha, que prevalece sobre a autoavaliação. Acessível ao próprio usuário, ao gestor direto e a administradores.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Perfil de competências do usuário",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CompetenciaUsuario"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Substitui as avaliações de competências feitas por quem envia a requisição: o próprio usuário registra sua autoavaliação; o gestor direto ou um administrador registra a validação (origem GESTOR). Competências concedidas por conclusão de trilha não são alteradas. Uma lista vazia remove as avaliações da origem.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Avalia as competências do usuário",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Níveis de proficiência por competência",
+                        "name": "competencias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SetCompetenciasUsuarioRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.CompetenciaUsuario"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}/elegibilidade/{trilhaId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Avalia os requisitos da trilha para o usuário e lista os que não foram atendidos.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Verifica a elegibilidade de um usuário para uma trilha",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID da Trilha",
+                        "name": "trilhaId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ElegibilidadeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}/gap": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compara o nível efetivo de cada competência do usuário com o mínimo exigido pelo cargo e lista as lacunas, com as trilhas que desenvolvem cada competência e o nível que concedem. Uma trilha é recomendada por lacuna (a de menor carga horária que atinge o nível, reaproveitando recomendações) e carga_horaria_total soma as recomendadas sem repetição. Acessível ao próprio usuário, ao gestor direto e a administradores.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Lacunas de competências para um cargo-alvo",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID do cargo-alvo",
+                        "name": "cargo",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.GapCompetenciasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}/liderados": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna, para cada liderado direto do gestor, matrículas por status, atrasadas (ATIVAS com prazo vencido), taxa de conclusão, horas estudadas e progresso médio, além dos totais da equipe. Acessível ao próprio gestor e a administradores.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gestores"
+                ],
+                "summary": "Painel do gestor",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Gestor",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.PainelGestorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}/liderados/matriculas": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma página das matrículas dos liderados diretos do gestor, com trilha, progresso e indicação de atraso. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Gestores"
+                ],
+                "summary": "Lista as matrículas dos liderados",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Gestor",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Deslocamento (ignorado quando cursor é informado)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página (X-Next-Cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação: id, data_inscricao, horas_estudadas, nome (prefixo '-' para decrescente)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra por status (ATIVA, CONCLUIDA, CANCELADA)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filtra por liderado",
+                        "name": "usuario_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filtra por trilha",
+                        "name": "trilha_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filtra matrículas atrasadas (true) ou em dia (false)",
+                        "name": "atrasada",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.MatriculaLiderado"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}/matriculas": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna uma página das matrículas de um usuário. Metadados de paginação nos cabeçalhos X-Total-Count, X-Next-Cursor e Link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Matriculas"
+                ],
+                "summary": "Lista matrículas de um usuário",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Deslocamento (ignorado quando cursor é informado)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor da próxima página (X-Next-Cursor)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação: id, data_inscricao, status, horas_estudadas (padrão -data_inscricao)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtra por status (ATIVA, CONCLUIDA, CANCELADA)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Filtra por trilha",
+                        "name": "trilha_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Matricula"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}/papel": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define o papel (learner, manager, curator ou admin) de um usuário. Apenas administradores.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Altera o papel de um usuário",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo papel",
+                        "name": "papel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SetPapelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.UsuarioResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/usuarios/{id}/recomendacoes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna as trilhas recomendadas ao usuário, da maior para a menor pontuação. A pontuação combina as competências da trilha que o usuário ainda não possui, a adequação do nível da trilha ao nível de carreira, as matrículas de colegas da mesma área de atuação, a co-inscrição (usuários que fizeram as mesmas trilhas) e a popularidade na organização. Trilhas em que o usuário já se matriculou ficam de fora; cada recomendação traz os motivos e os sinais usados. Acessível ao próprio usuário, ao gestor direto e a administradores. Metadados de paginação nos cabeçalhos X-Total-Count e Link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Usuarios"
+                ],
+                "summary": "Recomenda trilhas para o usuário",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID do Usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Itens por página (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Deslocamento",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.RecomendacaoTrilha"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controller.MatricularRequest": {
+            "type": "object",
+            "required": [
+                "trilha_id",
+                "usuario_id"
+            ],
+            "properties": {
+                "data_prazo": {
+                    "description": "prazo opcional para conclusão",
+                    "type": "string"
+                },
+                "trilha_id": {
+                    "type": "integer"
+                },
+                "usuario_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Aula": {
+            "type": "object",
+            "properties": {
+                "duracao_minutos": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "modulo_id": {
+                    "type": "integer"
+                },
+                "ordem": {
+                    "type": "integer"
+                },
+                "tipo": {
+                    "description": "VIDEO, LEITURA, EXERCICIO, PROJETO, QUIZ",
+                    "type": "string"
+                },
+                "titulo": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AvaliacaoCompetencia": {
+            "type": "object",
+            "properties": {
+                "data_atualizacao": {
+                    "type": "string"
+                },
+                "nivel": {
+                    "type": "integer"
+                },
+                "origem": {
+                    "description": "AUTOAVALIACAO, TRILHA, GESTOR",
+                    "type": "string"
+                }
+            }
+        },
+        "model.CampoInvalido": {
+            "type": "object",
+            "properties": {
+                "campo": {
+                    "type": "string",
+                    "example": "nivel"
+                },
+                "motivo": {
+                    "type": "string",
+                    "example": "deve ser um dos valores: INICIANTE, INTERMEDIARIO, AVANCADO"
+                }
+            }
+        },
+        "model.CargoResponse": {
+            "type": "object",
+            "properties": {
+                "competencias": {
+                    "description": "apenas na busca por ID",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CompetenciaCargo"
+                    }
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "organizacao_id": {
+                    "type": "integer"
+                },
+                "publica": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.CompetenciaCargo": {
+            "type": "object",
+            "properties": {
+                "categoria": {
+                    "type": "string"
+                },
+                "competencia_id": {
+                    "type": "integer"
+                },
+                "nivel_minimo": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CompetenciaResponse": {
+            "type": "object",
+            "properties": {
+                "categoria": {
+                    "type": "string"
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CompetenciaUsuario": {
+            "type": "object",
+            "properties": {
+                "avaliacoes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AvaliacaoCompetencia"
+                    }
+                },
+                "categoria": {
+                    "type": "string"
+                },
+                "competencia_id": {
+                    "type": "integer"
+                },
+                "data_atualizacao": {
+                    "type": "string"
+                },
+                "nivel": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "origem": {
+                    "type": "string"
+                },
+                "verificada": {
+                    "description": "origem TRILHA ou GESTOR",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.CreateAulaRequest": {
+            "type": "object",
+            "required": [
+                "duracao_minutos",
+                "tipo",
+                "titulo"
+            ],
+            "properties": {
+                "duracao_minutos": {
+                    "type": "integer"
+                },
+                "ordem": {
+                    "description": "padrão: após a última aula",
+                    "type": "integer"
+                },
+                "tipo": {
+                    "type": "string",
+                    "enum": [
+                        "VIDEO",
+                        "LEITURA",
+                        "EXERCICIO",
+                        "PROJETO",
+                        "QUIZ"
+                    ]
+                },
+                "titulo": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 3
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CreateCargoRequest": {
+            "type": "object",
+            "required": [
+                "nome"
+            ],
+            "properties": {
+                "competencias": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NivelCompetenciaRequest"
+                    }
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 3
+                },
+                "publica": {
+                    "description": "apenas curadores da plataforma",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.CreateCompetenciaRequest": {
+            "type": "object",
+            "required": [
+                "nome"
+            ],
+            "properties": {
+                "categoria": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                }
+            }
+        },
+        "model.CreateModuloRequest": {
+            "type": "object",
+            "required": [
+                "titulo"
+            ],
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "ordem": {
+                    "description": "padrão: após o último módulo",
+                    "type": "integer"
+                },
+                "titulo": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 3
+                }
+            }
+        },
+        "model.CreateOrganizacaoRequest": {
+            "type": "object",
+            "required": [
+                "admin",
+                "nome",
+                "slug"
+            ],
+            "properties": {
+                "admin": {
+                    "$ref": "#/definitions/model.CreateUsuarioRequest"
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 3
+                },
+                "slug": {
+                    "description": "letras minúsculas, números e hífens",
+                    "type": "string",
+                    "maxLength": 60,
+                    "minLength": 3
+                }
+            }
+        },
+        "model.CreateTrilhaRequest": {
+            "type": "object",
+            "required": [
+                "carga_horaria",
+                "nivel",
+                "nome"
+            ],
+            "properties": {
+                "carga_horaria": {
+                    "type": "integer"
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "foco_principal": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "nivel": {
+                    "type": "string",
+                    "enum": [
+                        "INICIANTE",
+                        "INTERMEDIARIO",
+                        "AVANCADO"
+                    ]
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 5
+                },
+                "publica": {
+                    "description": "apenas curadores da plataforma",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.CreateUsuarioRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "nome",
+                "senha"
+            ],
+            "properties": {
+                "area_atuacao": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "email": {
+                    "type": "string"
+                },
+                "equipe_id": {
+                    "type": "integer"
+                },
+                "gestor_id": {
+                    "type": "integer"
+                },
+                "nivel_carreira": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                },
+                "senha": {
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 8
+                }
+            }
+        },
+        "model.ElegibilidadeResponse": {
+            "type": "object",
+            "properties": {
+                "elegivel": {
+                    "type": "boolean"
+                },
+                "requisitos_nao_atendidos": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "trilha_id": {
+                    "type": "integer"
+                },
+                "usuario_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.Equipe": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "organizacao_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.EquipeRequest": {
+            "type": "object",
+            "required": [
+                "nome"
+            ],
+            "properties": {
+                "nome": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                }
+            }
+        },
+        "model.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "dados_invalidos"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "errors": {
+                    "description": "campos rejeitados (erros de validação)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CampoInvalido"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/trilhas/"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Dados de entrada inválidos."
+                },
+                "type": {
+                    "type": "string",
+                    "example": "urn:upskilling:erro:dados_invalidos"
+                },
+                "violacoes": {
+                    "description": "requisitos não atendidos (regras de negócio)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.GapCompetenciasResponse": {
+            "type": "object",
+            "properties": {
+                "aderencia": {
+                    "description": "% de competências atendidas",
+                    "type": "number"
+                },
+                "carga_horaria_total": {
+                    "description": "soma das trilhas recomendadas, sem repetição",
+                    "type": "integer"
+                },
+                "cargo_id": {
+                    "type": "integer"
+                },
+                "cargo_nome": {
+                    "type": "string"
+                },
+                "competencias_atendidas": {
+                    "type": "integer"
+                },
+                "lacunas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LacunaCompetencia"
+                    }
+                },
+                "total_competencias": {
+                    "description": "exigidas pelo cargo",
+                    "type": "integer"
+                },
+                "usuario_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.LacunaCompetencia": {
+            "type": "object",
+            "properties": {
+                "categoria": {
+                    "type": "string"
+                },
+                "competencia_id": {
+                    "type": "integer"
+                },
+                "nivel_atual": {
+                    "description": "0 quando o usuário não possui a competência",
+                    "type": "integer"
+                },
+                "nivel_requerido": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "origem": {
+                    "description": "origem do nível atual",
+                    "type": "string"
+                },
+                "trilhas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TrilhaLacuna"
+                    }
+                }
+            }
+        },
+        "model.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "senha"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "senha": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Matricula": {
+            "type": "object",
+            "properties": {
+                "data_cancelamento": {
+                    "type": "string"
+                },
+                "data_conclusao": {
+                    "type": "string"
+                },
+                "data_inscricao": {
+                    "type": "string"
+                },
+                "data_prazo": {
+                    "description": "prazo para conclusão (opcional)",
+                    "type": "string"
+                },
+                "data_ultima_atividade": {
+                    "type": "string"
+                },
+                "horas_estudadas": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "ATIVA, CONCLUIDA, CANCELADA",
+                    "type": "string"
+                },
+                "trilha_id": {
+                    "type": "integer"
+                },
+                "usuario_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.MatriculaLiderado": {
+            "type": "object",
+            "properties": {
+                "atrasada": {
+                    "type": "boolean"
+                },
+                "carga_horaria": {
+                    "type": "integer"
+                },
+                "data_conclusao": {
+                    "type": "string"
+                },
+                "data_inscricao": {
+                    "type": "string"
+                },
+                "data_prazo": {
+                    "type": "string"
+                },
+                "data_ultima_atividade": {
+                    "type": "string"
+                },
+                "horas_estudadas": {
+                    "type": "number"
+                },
+                "matricula_id": {
+                    "type": "integer"
+                },
+                "percentual_concluido": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "trilha_id": {
+                    "type": "integer"
+                },
+                "trilha_nome": {
+                    "type": "string"
+                },
+                "usuario_id": {
+                    "type": "integer"
+                },
+                "usuario_nome": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ModuloResponse": {
+            "type": "object",
+            "properties": {
+                "aulas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Aula"
+                    }
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "duracao_minutos": {
+                    "description": "soma das aulas",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ordem": {
+                    "type": "integer"
+                },
+                "titulo": {
+                    "type": "string"
+                },
+                "trilha_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.NivelCompetenciaRequest": {
+            "type": "object",
+            "required": [
+                "competencia_id",
+                "nivel"
+            ],
+            "properties": {
+                "competencia_id": {
+                    "type": "integer"
+                },
+                "nivel": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                }
+            }
+        },
+        "model.Organizacao": {
+            "type": "object",
+            "properties": {
+                "data_criacao": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "plataforma": {
+                    "description": "mantém o catálogo público",
+                    "type": "boolean"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.PainelGestorResponse": {
+            "type": "object",
+            "properties": {
+                "gestor_id": {
+                    "type": "integer"
+                },
+                "horas_estudadas": {
+                    "type": "number"
+                },
+                "liderados": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResumoLiderado"
+                    }
+                },
+                "matriculas_ativas": {
+                    "type": "integer"
+                },
+                "matriculas_atrasadas": {
+                    "type": "integer"
+                },
+                "matriculas_canceladas": {
+                    "type": "integer"
+                },
+                "matriculas_concluidas": {
+                    "type": "integer"
+                },
+                "taxa_conclusao": {
+                    "type": "number"
+                },
+                "total_liderados": {
+                    "type": "integer"
+                },
+                "total_matriculas": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.ProgressoResponse": {
+            "type": "object",
+            "properties": {
+                "aulas_concluidas": {
+                    "type": "integer"
+                },
+                "carga_horaria": {
+                    "type": "integer"
+                },
+                "data_conclusao": {
+                    "type": "string"
+                },
+                "data_ultima_atividade": {
+                    "type": "string"
+                },
+                "horas_estudadas": {
+                    "type": "number"
+                },
+                "matricula_id": {
+                    "type": "integer"
+                },
+                "percentual_concluido": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_aulas": {
+                    "type": "integer"
+                },
+                "trilha_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RecomendacaoTrilha": {
+            "type": "object",
+            "properties": {
+                "carga_horaria": {
+                    "type": "integer"
+                },
+                "foco_principal": {
+                    "type": "string"
+                },
+                "motivos": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "nivel": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string"
+                },
+                "pontuacao": {
+                    "type": "number"
+                },
+                "sinais": {
+                    "$ref": "#/definitions/model.SinaisRecomendacao"
+                },
+                "trilha_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
             }
-        }
-    },
-    "definitions": {
-        "controller.MatricularRequest": {
+        },
+        "model.RegistrarSessaoRequest": {
             "type": "object",
             "required": [
-                "trilha_id",
-                "usuario_id"
+                "horas"
             ],
             "properties": {
+                "data_sessao": {
+                    "description": "padrão: momento do registro",
+                    "type": "string"
+                },
+                "horas": {
+                    "type": "number",
+                    "maximum": 24
+                },
+                "observacao": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "model.RegistroOrganizacaoResponse": {
+            "type": "object",
+            "properties": {
+                "admin": {
+                    "$ref": "#/definitions/model.UsuarioResponse"
+                },
+                "organizacao": {
+                    "$ref": "#/definitions/model.Organizacao"
+                }
+            }
+        },
+        "model.RequisitosResponse": {
+            "type": "object",
+            "properties": {
+                "competencias_requeridas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CompetenciaResponse"
+                    }
+                },
+                "nivel_carreira_minimo": {
+                    "type": "string"
+                },
                 "trilha_id": {
                     "type": "integer"
                 },
-                "usuario_id": {
+                "trilhas_prerequisito": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TrilhaResponse"
+                    }
+                }
+            }
+        },
+        "model.ResultadoBusca": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "integer"
+                },
+                "relevancia": {
+                    "type": "number"
+                },
+                "tipo": {
+                    "description": "\"trilha\" ou \"competencia\"",
+                    "type": "string"
+                },
+                "titulo": {
+                    "type": "string"
+                },
+                "trecho": {
+                    "description": "HTML escapado, com os termos encontrados destacados em \u003cmark\u003e",
+                    "type": "string"
                 }
             }
         },
-        "model.CreateTrilhaRequest": {
+        "model.ResumoLiderado": {
             "type": "object",
-            "required": [
-                "carga_horaria",
-                "nivel",
-                "nome"
-            ],
             "properties": {
-                "carga_horaria": {
+                "data_ultima_atividade": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "equipe_id": {
                     "type": "integer"
                 },
-                "descricao": {
+                "horas_estudadas": {
+                    "type": "number"
+                },
+                "matriculas_ativas": {
+                    "type": "integer"
+                },
+                "matriculas_atrasadas": {
+                    "description": "ATIVAS com prazo vencido",
+                    "type": "integer"
+                },
+                "matriculas_canceladas": {
+                    "type": "integer"
+                },
+                "matriculas_concluidas": {
+                    "type": "integer"
+                },
+                "nome": {
                     "type": "string"
                 },
-                "foco_principal": {
-                    "type": "string",
-                    "maxLength": 100
+                "progresso_medio": {
+                    "description": "% médio das matrículas ATIVAS",
+                    "type": "number"
                 },
-                "nivel": {
+                "taxa_conclusao": {
+                    "description": "% de concluídas entre as não canceladas",
+                    "type": "number"
+                },
+                "total_matriculas": {
+                    "type": "integer"
+                },
+                "usuario_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.SessaoEstudo": {
+            "type": "object",
+            "properties": {
+                "data_sessao": {
+                    "type": "string"
+                },
+                "horas": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matricula_id": {
+                    "type": "integer"
+                },
+                "observacao": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.SetCargoCompetenciasRequest": {
+            "type": "object",
+            "required": [
+                "competencias"
+            ],
+            "properties": {
+                "competencias": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NivelCompetenciaRequest"
+                    }
+                }
+            }
+        },
+        "model.SetCompetenciasUsuarioRequest": {
+            "type": "object",
+            "required": [
+                "competencias"
+            ],
+            "properties": {
+                "competencias": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NivelCompetenciaRequest"
+                    }
+                }
+            }
+        },
+        "model.SetPapelRequest": {
+            "type": "object",
+            "required": [
+                "papel"
+            ],
+            "properties": {
+                "papel": {
                     "type": "string",
                     "enum": [
-                        "INICIANTE",
-                        "INTERMEDIARIO",
-                        "AVANCADO"
+                        "learner",
+                        "manager",
+                        "curator",
+                        "admin"
                     ]
+                }
+            }
+        },
+        "model.SetRequisitosRequest": {
+            "type": "object",
+            "properties": {
+                "competencias_requeridas_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "nome": {
+                "nivel_carreira_minimo": {
+                    "description": "Em transição, Junior, Pleno, Senior",
                     "type": "string",
-                    "maxLength": 150,
-                    "minLength": 5
+                    "maxLength": 50
+                },
+                "trilhas_prerequisito_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "model.CreateUsuarioRequest": {
+        "model.SetTrilhaCompetenciasRequest": {
             "type": "object",
             "required": [
-                "email",
-                "nome"
+                "competencia_ids"
             ],
             "properties": {
-                "area_atuacao": {
-                    "type": "string",
-                    "maxLength": 100
+                "competencia_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "model.SinaisRecomendacao": {
+            "type": "object",
+            "properties": {
+                "co_inscritos": {
+                    "description": "usuários com trilhas em comum que se matricularam",
+                    "type": "integer"
                 },
-                "email": {
-                    "type": "string"
+                "colegas_mesma_area": {
+                    "description": "usuários da mesma área de atuação matriculados",
+                    "type": "integer"
                 },
-                "nivel_carreira": {
-                    "type": "string",
-                    "maxLength": 50
+                "competencias_novas": {
+                    "description": "competências da trilha que o usuário não possui",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "nome": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3
+                "matriculas": {
+                    "description": "matrículas na organização (popularidade)",
+                    "type": "integer"
+                },
+                "nivel_adequado": {
+                    "description": "nível da trilha indicado para o nível de carreira",
+                    "type": "boolean"
                 }
             }
         },
-        "model.ErrorResponse": {
+        "model.TokenResponse": {
             "type": "object",
             "properties": {
-                "details": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_in": {
+                    "description": "validade do access token, em segundos",
+                    "type": "integer"
+                },
+                "refresh_token": {
                     "type": "string"
                 },
-                "message": {
+                "token_type": {
+                    "description": "sempre \"Bearer\"",
                     "type": "string"
                 }
             }
         },
-        "model.Matricula": {
+        "model.TrilhaLacuna": {
             "type": "object",
             "properties": {
-                "data_inscricao": {
+                "carga_horaria": {
+                    "type": "integer"
+                },
+                "fecha_lacuna": {
+                    "description": "o nível concedido atinge o requerido",
+                    "type": "boolean"
+                },
+                "nivel": {
                     "type": "string"
                 },
-                "id": {
+                "nivel_concedido": {
+                    "description": "nível concedido ao concluir a trilha",
                     "type": "integer"
                 },
-                "status": {
-                    "description": "ATIVA, CONCLUIDA, CANCELADA",
+                "nome": {
                     "type": "string"
                 },
-                "trilha_id": {
-                    "type": "integer"
+                "recomendada": {
+                    "description": "considerada na carga horária total",
+                    "type": "boolean"
                 },
-                "usuario_id": {
+                "trilha_id": {
                     "type": "integer"
                 }
             }
@@ -650,6 +5603,13 @@ const docTemplate = `{
                 "carga_horaria": {
                     "type": "integer"
                 },
+                "competencias": {
+                    "description": "apenas com ?incluir=competencias",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CompetenciaResponse"
+                    }
+                },
                 "descricao": {
                     "type": "string"
                 },
@@ -664,6 +5624,88 @@ const docTemplate = `{
                 },
                 "nome": {
                     "type": "string"
+                },
+                "organizacao_id": {
+                    "description": "dona da trilha privada",
+                    "type": "integer"
+                },
+                "publica": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.UpdateAulaRequest": {
+            "type": "object",
+            "properties": {
+                "duracao_minutos": {
+                    "type": "integer"
+                },
+                "ordem": {
+                    "type": "integer"
+                },
+                "tipo": {
+                    "type": "string",
+                    "enum": [
+                        "VIDEO",
+                        "LEITURA",
+                        "EXERCICIO",
+                        "PROJETO",
+                        "QUIZ"
+                    ]
+                },
+                "titulo": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 3
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.UpdateCargoRequest": {
+            "type": "object",
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 3
+                }
+            }
+        },
+        "model.UpdateCompetenciaRequest": {
+            "type": "object",
+            "properties": {
+                "categoria": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "descricao": {
+                    "type": "string"
+                },
+                "nome": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 3
+                }
+            }
+        },
+        "model.UpdateModuloRequest": {
+            "type": "object",
+            "properties": {
+                "descricao": {
+                    "type": "string"
+                },
+                "ordem": {
+                    "type": "integer"
+                },
+                "titulo": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 3
                 }
             }
         },
@@ -702,6 +5744,14 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 100
                 },
+                "equipe_id": {
+                    "description": "apenas administradores",
+                    "type": "integer"
+                },
+                "gestor_id": {
+                    "description": "apenas administradores",
+                    "type": "integer"
+                },
                 "nivel_carreira": {
                     "type": "string",
                     "maxLength": 50
@@ -710,6 +5760,17 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 100,
                     "minLength": 3
+                },
+                "senha": {
+                    "description": "encerra as sessões abertas",
+                    "type": "string",
+                    "maxLength": 72,
+                    "minLength": 8
+                },
+                "senha_atual": {
+                    "description": "obrigatória ao trocar a própria senha",
+                    "type": "string",
+                    "maxLength": 72
                 }
             }
         },
@@ -725,6 +5786,12 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "equipe_id": {
+                    "type": "integer"
+                },
+                "gestor_id": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -733,9 +5800,23 @@ const docTemplate = `{
                 },
                 "nome": {
                     "type": "string"
+                },
+                "organizacao_id": {
+                    "type": "integer"
+                },
+                "papel": {
+                    "type": "string"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Access token obtido em /auth/login, no formato \"Bearer \u003ctoken\u003e\".",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
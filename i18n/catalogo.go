@@ -0,0 +1,321 @@
+package i18n
+
+// catalogos traz, por idioma, o formato (estilo fmt) de cada chave de mensagem. Os
+// argumentos são os mesmos em todos os idiomas e na mesma ordem.
+var catalogos = map[string]map[string]string{
+	PortuguesBrasil: {
+		// Títulos, por código de erro
+		"titulo.dados_invalidos":           "Dados de entrada inválidos.",
+		"titulo.parametro_invalido":        "Parâmetro inválido.",
+		"titulo.nao_autenticado":           "Não autenticado.",
+		"titulo.acesso_negado":             "Acesso negado.",
+		"titulo.recurso_nao_encontrado":    "Recurso não encontrado.",
+		"titulo.conflito":                  "Conflito com o estado atual do recurso.",
+		"titulo.email_ja_cadastrado":       "Email já cadastrado.",
+		"titulo.matricula_ativa_duplicada": "Matrícula ativa já existente.",
+		"titulo.regra_de_negocio":          "Regra de negócio não atendida.",
+		"titulo.requisitos_nao_atendidos":  "Requisitos da trilha não atendidos.",
+		"titulo.transicao_invalida":        "Transição de status inválida.",
+		"titulo.referencia_inexistente":    "Referência inexistente.",
+		"titulo.falha_transitoria":         "A operação conflitou com outra executada ao mesmo tempo. Tente novamente.",
+		"titulo.erro_interno":              "Ocorreu um erro interno no servidor.",
+
+		// Erro interno (o erro original é registrado apenas no log do servidor)
+		"erro.interno": "Não foi possível concluir a requisição. Tente novamente mais tarde; se o problema persistir, contate o suporte.",
+
+		// Autenticação
+		"auth.cabecalho_ausente":      "Informe o cabeçalho 'Authorization: Bearer <token>'.",
+		"auth.nao_autenticado":        "Usuário não autenticado.",
+		"auth.credenciais_invalidas":  "Email ou senha inválidos.",
+		"auth.refresh_token_invalido": "Refresh token inválido, expirado ou revogado.",
+		"auth.access_token_expirado":  "Access token expirado.",
+		"auth.access_token_invalido":  "Access token inválido.",
+		"auth.usuario_removido":       "Usuário do token não existe mais.",
+
+		// Autorização
+		"acesso.permissao_papel":        "O papel '%s' não possui a permissão '%s'.",
+		"acesso.dados_de_outro_usuario": "O usuário %d não pode acessar dados do usuário %d.",
+		"acesso.catalogo_publico":       "Apenas curadores da plataforma podem alterar o catálogo público.",
+		"acesso.registrar_organizacao":  "Apenas administradores da plataforma podem registrar organizações.",
+		"acesso.gerenciar_equipes":      "Apenas administradores podem gerenciar equipes.",
+		"acesso.cadastrar_usuarios":     "Apenas administradores podem cadastrar usuários.",
+		"acesso.listar_usuarios":        "Apenas administradores podem listar usuários.",
+		"acesso.alterar_equipe_usuario": "Apenas administradores podem alterar a equipe de um usuário.",
+		"acesso.alterar_gestor_usuario": "Apenas administradores podem alterar o gestor de um usuário.",
+		"acesso.alterar_nivel_carreira": "Apenas o gestor direto e administradores podem alterar o nível de carreira de um usuário.",
+		"acesso.concluir_matricula":     "Apenas o gestor direto e administradores podem concluir uma matrícula manualmente; o próprio usuário a conclui ao cumprir a carga horária.",
+		"acesso.remover_usuarios":       "Apenas administradores podem remover usuários.",
+		"acesso.alterar_papeis":         "Apenas administradores podem alterar papéis.",
+		"acesso.senha_atual_incorreta":  "Informe a senha atual correta em 'senha_atual' para alterar a própria senha.",
+
+		// Parâmetros de rota e de consulta
+		"parametro.invalido":             "parâmetro '%s' inválido: %s",
+		"parametro.inteiro":              "deve ser um número inteiro",
+		"parametro.inteiro_nao_negativo": "deve ser um número inteiro não negativo",
+		"parametro.sort_valores":         "valores aceitos: %s (prefixo '-' para ordem decrescente)",
+		"parametro.filtro_nao_suportado": "filtro não suportado",
+		"parametro.cursor_invalido":      "cursor inválido ou gerado com outra ordenação",
+		"parametro.recomendacao_sort":    "as recomendações são sempre ordenadas pela pontuação",
+		"parametro.recomendacao_cursor":  "as recomendações aceitam apenas paginação por limit/offset",
+		"parametro.busca_termo_ausente":  "informe o termo de busca",
+		"parametro.busca_termo_longo":    "o termo de busca deve ter no máximo %d caracteres",
+		"parametro.busca_tipo":           "valores aceitos: trilha, competencia",
+		"parametro.busca_sort":           "a busca é sempre ordenada por relevância",
+		"parametro.busca_cursor":         "a busca aceita apenas paginação por limit/offset",
+		"parametro.cargo_ausente":        "informe o ID do cargo-alvo",
+		"parametro.cargo_invalido":       "o ID do cargo-alvo deve ser um número inteiro positivo",
+
+		// Falhas transitórias
+		"transitorio.conflito_concorrencia": "A transação foi interrompida por um conflito de concorrência no banco de dados.",
+
+		// Recursos
+		"recurso.nao_encontrado":                "%s não encontrado(a) com ID: %d",
+		"recurso.Associação trilha-competência": "Associação trilha-competência",
+		"recurso.Aula":                          "Aula",
+		"recurso.Cargo":                         "Cargo",
+		"recurso.Competência":                   "Competência",
+		"recurso.Equipe":                        "Equipe",
+		"recurso.Matrícula":                     "Matrícula",
+		"recurso.Módulo":                        "Módulo",
+		"recurso.Organização":                   "Organização",
+		"recurso.Trilha":                        "Trilha",
+		"recurso.Usuário":                       "Usuário",
+
+		// Violações genéricas de constraints
+		"registro.duplicado":           "Registro já cadastrado.",
+		"registro.referencia_invalida": "O registro referenciado não existe ou ainda está em uso.",
+		"registro.fora_do_dominio":     "Valor fora do domínio permitido.",
+
+		// Organizações e equipes
+		"organizacao.slug_duplicado":   "Slug de organização já cadastrado.",
+		"organizacao.slug_invalido":    "O slug deve conter apenas letras minúsculas, números e hífens.",
+		"organizacao.admin_sem_equipe": "A organização ainda não possui equipes nem gestores; cadastre o administrador sem equipe e sem gestor.",
+		"equipe.nome_em_uso":           "Já existe uma equipe com esse nome na organização.",
+		"equipe.nome_duplicado":        "Já existe uma equipe chamada %q na organização.",
+		"equipe.inexistente":           "Equipe não encontrada.",
+
+		// Usuários
+		"usuario.email_indisponivel":      "Não foi possível cadastrar o usuário com o email informado.",
+		"usuario.email_ja_cadastrado":     "O email '%s' já está cadastrado.",
+		"usuario.inexistente":             "Usuário não encontrado.",
+		"usuario.papel_invalido":          "Papel inválido.",
+		"usuario.nivel_carreira_invalido": "Nível de carreira inválido: '%s'. Valores aceitos: %v.",
+		"usuario.equipe_inexistente":      "Equipe com ID %d não encontrada na organização.",
+		"usuario.gestor_inexistente":      "Gestor com ID %d não encontrado na organização.",
+		"usuario.gestor_de_si_mesmo":      "Um usuário não pode ser gestor de si mesmo.",
+		"usuario.gestor_sem_papel":        "O usuário %d tem papel '%s'; apenas managers e admins podem ser gestores.",
+		"usuario.ciclo_gestor":            "O gestor informado criaria um ciclo na hierarquia (ele é liderado, direta ou indiretamente, pelo usuário).",
+		"usuario.alterar_proprio_papel":   "Um administrador não pode alterar o próprio papel.",
+		"gestor.inexistente":              "Gestor não encontrado.",
+		"refresh_token.duplicado":         "Refresh token já registrado.",
+
+		// Trilhas e conteúdo
+		"trilha.inexistente":                 "Trilha não encontrada.",
+		"trilha.carga_horaria_invalida":      "A carga horária da trilha deve ser maior que zero.",
+		"trilha.nivel_carreira_invalido":     "Nível de carreira mínimo inválido: '%s'. Valores aceitos: %v.",
+		"trilha.prerequisito_de_si_mesma":    "Uma trilha não pode ser pré-requisito de si mesma.",
+		"trilha.prerequisito_inexistente":    "Trilha pré-requisito não encontrada.",
+		"trilha.prerequisito_nao_encontrado": "Trilha pré-requisito com ID %d não encontrada.",
+		"trilha.prerequisito_privado":        "A trilha pré-requisito %d é privada e não pode ser exigida por uma trilha pública.",
+		"trilha.prerequisito_circular":       "Os pré-requisitos informados criariam uma dependência circular entre trilhas.",
+		"modulo.inexistente":                 "Módulo não encontrado.",
+		"aula.inexistente":                   "Aula não encontrada.",
+		"aula.ja_concluida":                  "A aula %d já foi concluída nesta matrícula.",
+
+		// Competências e cargos
+		"competencia.inexistente":           "Competência não encontrada.",
+		"competencia.nao_encontradas":       "Competências não encontradas: %v.",
+		"competencia.repetida":              "A competência %d foi informada mais de uma vez.",
+		"competencia.nivel_fora_da_escala":  "O nível da competência deve estar entre 1 e 5.",
+		"cargo.nivel_minimo_fora_da_escala": "O nível mínimo da competência deve estar entre 1 e 5.",
+
+		// Matrículas e sessões de estudo
+		"matricula.inexistente":          "Matrícula não encontrada.",
+		"matricula.ativa_existente":      "O usuário já possui matrícula ativa nesta trilha.",
+		"matricula.ativa_duplicada":      "Usuário %d já possui matrícula ativa na trilha %d.",
+		"matricula.prazo_passado":        "O prazo da matrícula não pode estar no passado.",
+		"matricula.nao_elegivel":         "Usuário %d não é elegível para a trilha %d.",
+		"matricula.usuario_inexistente":  "Usuário com ID %d não encontrado.",
+		"matricula.trilha_inexistente":   "Trilha com ID %d não encontrada.",
+		"matricula.sessao_status":        "Matrícula %d está %s; só é possível registrar sessões em matrículas ATIVAS.",
+		"matricula.aula_status":          "Matrícula %d está %s; só é possível concluir aulas em matrículas ATIVAS.",
+		"matricula.aula_de_outra_trilha": "A aula %d não pertence à trilha da matrícula %d.",
+		"matricula.transicao_invalida":   "Transição de status inválida: matrícula %d está %s e não pode passar para %s.",
+		"matricula.status_alterado":      "A matrícula %d não está mais %s: o status foi alterado por outra requisição.",
+		"sessao.data_futura":             "A data da sessão de estudo não pode estar no futuro.",
+		"sessao.matricula_inativa":       "Matrícula %d não está ATIVA; não é possível registrar sessões de estudo.",
+
+		// Requisitos de elegibilidade não atendidos
+		"elegibilidade.nivel_carreira":               "Nível de carreira mínimo '%s' (atual: %s).",
+		"elegibilidade.nivel_carreira_nao_informado": "Nível de carreira mínimo '%s' (atual: não informado).",
+		"elegibilidade.trilha_prerequisito":          "Concluir a trilha pré-requisito '%s' (ID %d).",
+		"elegibilidade.competencia":                  "Possuir a competência '%s' (ID %d).",
+
+		// Motivos da recomendação de trilhas
+		"recomendacao.competencia_nova":   "Desenvolve a competência %s, que o usuário ainda não possui.",
+		"recomendacao.competencias_novas": "Desenvolve %d competências que o usuário ainda não possui: %s.",
+		"recomendacao.nivel_adequado":     "Nível %s indicado para o nível de carreira %s.",
+		"recomendacao.co_inscritos":       "%d pessoa(s) que fizeram as mesmas trilhas que o usuário também se matricularam.",
+		"recomendacao.colegas_mesma_area": "Escolhida por %d colega(s) da mesma área de atuação (%s).",
+		"recomendacao.popular":            "Popular na organização: %d matrícula(s).",
+		"recomendacao.disponivel":         "Trilha disponível no catálogo que o usuário ainda não cursou.",
+	},
+
+	InglesEUA: {
+		// Títulos, por código de erro
+		"titulo.dados_invalidos":           "Invalid input data.",
+		"titulo.parametro_invalido":        "Invalid parameter.",
+		"titulo.nao_autenticado":           "Not authenticated.",
+		"titulo.acesso_negado":             "Access denied.",
+		"titulo.recurso_nao_encontrado":    "Resource not found.",
+		"titulo.conflito":                  "Conflict with the current state of the resource.",
+		"titulo.email_ja_cadastrado":       "Email already registered.",
+		"titulo.matricula_ativa_duplicada": "Active enrollment already exists.",
+		"titulo.regra_de_negocio":          "Business rule not satisfied.",
+		"titulo.requisitos_nao_atendidos":  "Learning path requirements not met.",
+		"titulo.transicao_invalida":        "Invalid status transition.",
+		"titulo.referencia_inexistente":    "Referenced record does not exist.",
+		"titulo.falha_transitoria":         "The operation conflicted with another one running at the same time. Please try again.",
+		"titulo.erro_interno":              "An internal server error occurred.",
+
+		// Erro interno (o erro original é registrado apenas no log do servidor)
+		"erro.interno": "The request could not be completed. Try again later; if the problem persists, contact support.",
+
+		// Autenticação
+		"auth.cabecalho_ausente":      "Send the 'Authorization: Bearer <token>' header.",
+		"auth.nao_autenticado":        "User not authenticated.",
+		"auth.credenciais_invalidas":  "Invalid email or password.",
+		"auth.refresh_token_invalido": "Invalid, expired or revoked refresh token.",
+		"auth.access_token_expirado":  "Access token expired.",
+		"auth.access_token_invalido":  "Invalid access token.",
+		"auth.usuario_removido":       "The token's user no longer exists.",
+
+		// Autorização
+		"acesso.permissao_papel":        "Role '%s' does not have the '%s' permission.",
+		"acesso.dados_de_outro_usuario": "User %d cannot access data of user %d.",
+		"acesso.catalogo_publico":       "Only platform curators can change the public catalog.",
+		"acesso.registrar_organizacao":  "Only platform administrators can register organizations.",
+		"acesso.gerenciar_equipes":      "Only administrators can manage teams.",
+		"acesso.cadastrar_usuarios":     "Only administrators can register users.",
+		"acesso.listar_usuarios":        "Only administrators can list users.",
+		"acesso.alterar_equipe_usuario": "Only administrators can change a user's team.",
+		"acesso.alterar_gestor_usuario": "Only administrators can change a user's manager.",
+		"acesso.alterar_nivel_carreira": "Only the direct manager and administrators can change a user's career level.",
+		"acesso.concluir_matricula":     "Only the direct manager and administrators can complete an enrollment manually; users complete it by meeting the workload.",
+		"acesso.remover_usuarios":       "Only administrators can remove users.",
+		"acesso.alterar_papeis":         "Only administrators can change roles.",
+		"acesso.senha_atual_incorreta":  "Send the correct current password in 'senha_atual' to change your own password.",
+
+		// Parâmetros de rota e de consulta
+		"parametro.invalido":             "invalid parameter '%s': %s",
+		"parametro.inteiro":              "must be an integer",
+		"parametro.inteiro_nao_negativo": "must be a non-negative integer",
+		"parametro.sort_valores":         "accepted values: %s ('-' prefix for descending order)",
+		"parametro.filtro_nao_suportado": "unsupported filter",
+		"parametro.cursor_invalido":      "invalid cursor, or cursor generated with another sort order",
+		"parametro.recomendacao_sort":    "recommendations are always sorted by score",
+		"parametro.recomendacao_cursor":  "recommendations only support limit/offset pagination",
+		"parametro.busca_termo_ausente":  "provide the search term",
+		"parametro.busca_termo_longo":    "the search term must have at most %d characters",
+		"parametro.busca_tipo":           "accepted values: trilha, competencia",
+		"parametro.busca_sort":           "search results are always sorted by relevance",
+		"parametro.busca_cursor":         "search only supports limit/offset pagination",
+		"parametro.cargo_ausente":        "provide the target job role ID",
+		"parametro.cargo_invalido":       "the target job role ID must be a positive integer",
+
+		// Falhas transitórias
+		"transitorio.conflito_concorrencia": "The transaction was aborted by a concurrency conflict in the database.",
+
+		// Recursos
+		"recurso.nao_encontrado":                "%s with ID %d not found.",
+		"recurso.Associação trilha-competência": "Learning path–skill association",
+		"recurso.Aula":                          "Lesson",
+		"recurso.Cargo":                         "Job role",
+		"recurso.Competência":                   "Skill",
+		"recurso.Equipe":                        "Team",
+		"recurso.Matrícula":                     "Enrollment",
+		"recurso.Módulo":                        "Module",
+		"recurso.Organização":                   "Organization",
+		"recurso.Trilha":                        "Learning path",
+		"recurso.Usuário":                       "User",
+
+		// Violações genéricas de constraints
+		"registro.duplicado":           "Record already exists.",
+		"registro.referencia_invalida": "The referenced record does not exist or is still in use.",
+		"registro.fora_do_dominio":     "Value outside the allowed range.",
+
+		// Organizações e equipes
+		"organizacao.slug_duplicado":   "Organization slug already registered.",
+		"organizacao.slug_invalido":    "The slug may only contain lowercase letters, digits and hyphens.",
+		"organizacao.admin_sem_equipe": "The organization has no teams or managers yet; register the administrator without a team or manager.",
+		"equipe.nome_em_uso":           "A team with this name already exists in the organization.",
+		"equipe.nome_duplicado":        "A team named %q already exists in the organization.",
+		"equipe.inexistente":           "Team not found.",
+
+		// Usuários
+		"usuario.email_indisponivel":      "The user could not be registered with the given email.",
+		"usuario.email_ja_cadastrado":     "The email '%s' is already registered.",
+		"usuario.inexistente":             "User not found.",
+		"usuario.papel_invalido":          "Invalid role.",
+		"usuario.nivel_carreira_invalido": "Invalid career level: '%s'. Accepted values: %v.",
+		"usuario.equipe_inexistente":      "Team with ID %d not found in the organization.",
+		"usuario.gestor_inexistente":      "Manager with ID %d not found in the organization.",
+		"usuario.gestor_de_si_mesmo":      "A user cannot be their own manager.",
+		"usuario.gestor_sem_papel":        "User %d has role '%s'; only managers and admins can be managers.",
+		"usuario.ciclo_gestor":            "The given manager would create a cycle in the hierarchy (they report, directly or indirectly, to the user).",
+		"usuario.alterar_proprio_papel":   "An administrator cannot change their own role.",
+		"gestor.inexistente":              "Manager not found.",
+		"refresh_token.duplicado":         "Refresh token already registered.",
+
+		// Trilhas e conteúdo
+		"trilha.inexistente":                 "Learning path not found.",
+		"trilha.carga_horaria_invalida":      "The learning path workload must be greater than zero.",
+		"trilha.nivel_carreira_invalido":     "Invalid minimum career level: '%s'. Accepted values: %v.",
+		"trilha.prerequisito_de_si_mesma":    "A learning path cannot be a prerequisite of itself.",
+		"trilha.prerequisito_inexistente":    "Prerequisite learning path not found.",
+		"trilha.prerequisito_nao_encontrado": "Prerequisite learning path with ID %d not found.",
+		"trilha.prerequisito_privado":        "Prerequisite learning path %d is private and cannot be required by a public learning path.",
+		"trilha.prerequisito_circular":       "The given prerequisites would create a circular dependency between learning paths.",
+		"modulo.inexistente":                 "Module not found.",
+		"aula.inexistente":                   "Lesson not found.",
+		"aula.ja_concluida":                  "Lesson %d has already been completed in this enrollment.",
+
+		// Competências e cargos
+		"competencia.inexistente":           "Skill not found.",
+		"competencia.nao_encontradas":       "Skills not found: %v.",
+		"competencia.repetida":              "Skill %d was given more than once.",
+		"competencia.nivel_fora_da_escala":  "The skill level must be between 1 and 5.",
+		"cargo.nivel_minimo_fora_da_escala": "The minimum skill level must be between 1 and 5.",
+
+		// Matrículas e sessões de estudo
+		"matricula.inexistente":          "Enrollment not found.",
+		"matricula.ativa_existente":      "The user already has an active enrollment in this learning path.",
+		"matricula.ativa_duplicada":      "User %d already has an active enrollment in learning path %d.",
+		"matricula.prazo_passado":        "The enrollment deadline cannot be in the past.",
+		"matricula.nao_elegivel":         "User %d is not eligible for learning path %d.",
+		"matricula.usuario_inexistente":  "User with ID %d not found.",
+		"matricula.trilha_inexistente":   "Learning path with ID %d not found.",
+		"matricula.sessao_status":        "Enrollment %d is %s; study sessions can only be logged on ATIVA (active) enrollments.",
+		"matricula.aula_status":          "Enrollment %d is %s; lessons can only be completed on ATIVA (active) enrollments.",
+		"matricula.aula_de_outra_trilha": "Lesson %d does not belong to the learning path of enrollment %d.",
+		"matricula.transicao_invalida":   "Invalid status transition: enrollment %d is %s and cannot move to %s.",
+		"matricula.status_alterado":      "Enrollment %d is no longer %s: its status was changed by another request.",
+		"sessao.data_futura":             "The study session date cannot be in the future.",
+		"sessao.matricula_inativa":       "Enrollment %d is not ATIVA (active); study sessions cannot be logged.",
+
+		// Requisitos de elegibilidade não atendidos
+		"elegibilidade.nivel_carreira":               "Minimum career level '%s' (current: %s).",
+		"elegibilidade.nivel_carreira_nao_informado": "Minimum career level '%s' (current: not provided).",
+		"elegibilidade.trilha_prerequisito":          "Complete the prerequisite learning path '%s' (ID %d).",
+		"elegibilidade.competencia":                  "Have the skill '%s' (ID %d).",
+
+		// Motivos da recomendação de trilhas
+		"recomendacao.competencia_nova":   "Develops the skill %s, which the user does not have yet.",
+		"recomendacao.competencias_novas": "Develops %d skills the user does not have yet: %s.",
+		"recomendacao.nivel_adequado":     "Level %s is suited to the career level %s.",
+		"recomendacao.co_inscritos":       "%d user(s) who took the same learning paths as the user also enrolled.",
+		"recomendacao.colegas_mesma_area": "Chosen by %d colleague(s) in the same field (%s).",
+		"recomendacao.popular":            "Popular in the organization: %d enrollment(s).",
+		"recomendacao.disponivel":         "Learning path available in the catalog that the user has not taken yet.",
+	},
+}
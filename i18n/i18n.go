@@ -0,0 +1,70 @@
+// Package i18n resolve as mensagens exibidas aos clientes da API no idioma negociado pelo
+// cabeçalho Accept-Language, a partir de catálogos indexados por chave.
+package i18n
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// Idiomas suportados (tags BCP 47).
+const (
+	PortuguesBrasil = "pt-BR"
+	InglesEUA       = "en-US"
+
+	// Padrao é usado quando o cliente não informa um idioma suportado e como reserva para
+	// chaves ausentes no catálogo de outro idioma.
+	Padrao = PortuguesBrasil
+)
+
+// Suportados lista os idiomas com catálogo, o padrão primeiro.
+var Suportados = []string{PortuguesBrasil, InglesEUA}
+
+var matcher = language.NewMatcher([]language.Tag{
+	language.BrazilianPortuguese,
+	language.AmericanEnglish,
+})
+
+// Negociar escolhe, entre os idiomas suportados, o que melhor atende ao cabeçalho
+// Accept-Language (ex: "en-GB,en;q=0.9,pt;q=0.5" → en-US). Sem correspondência, ou com o
+// cabeçalho ausente ou malformado, retorna Padrao.
+func Negociar(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Padrao
+	}
+	_, indice, confianca := matcher.Match(tags...)
+	if confianca == language.No {
+		return Padrao
+	}
+	return Suportados[indice]
+}
+
+// Mensagem formata a mensagem da chave no idioma, com os argumentos no estilo fmt. Chaves
+// ausentes no idioma recorrem ao catálogo padrão e, por fim, à própria chave.
+func Mensagem(idioma, chave string, args ...any) string {
+	formato, ok := catalogos[idioma][chave]
+	if !ok {
+		if formato, ok = catalogos[Padrao][chave]; !ok {
+			formato = chave
+		}
+	}
+	if len(args) == 0 {
+		return formato
+	}
+	return fmt.Sprintf(formato, args...)
+}
+
+// Titulo retorna o título (resumo do tipo de erro) associado ao código do erro.
+func Titulo(idioma, codigo string) string {
+	return Mensagem(idioma, "titulo."+codigo)
+}
+
+// Recurso retorna o nome do recurso (ex: "Trilha") no idioma.
+func Recurso(idioma, nome string) string {
+	if traduzido, ok := catalogos[idioma]["recurso."+nome]; ok {
+		return traduzido
+	}
+	return nome
+}
@@ -0,0 +1,78 @@
+package i18n
+
+import "testing"
+
+func TestNegociar(t *testing.T) {
+	casos := []struct {
+		acceptLanguage, esperado string
+	}{
+		{"", Padrao},
+		{"pt-BR", PortuguesBrasil},
+		{"pt", PortuguesBrasil},
+		{"en-US", InglesEUA},
+		{"en-GB,en;q=0.9,pt;q=0.5", InglesEUA},
+		{"pt-BR,en;q=0.5", PortuguesBrasil},
+		{"fr-FR", Padrao},
+		{"fr-FR,en;q=0.8", InglesEUA},
+		{";;;malformado", Padrao},
+	}
+	for _, c := range casos {
+		if idioma := Negociar(c.acceptLanguage); idioma != c.esperado {
+			t.Fatalf("Negociar(%q) = %q, esperado %q", c.acceptLanguage, idioma, c.esperado)
+		}
+	}
+}
+
+func TestMensagem(t *testing.T) {
+	// Chave presente apenas no catálogo padrão, para exercitar a reserva
+	catalogos[Padrao]["teste.so_no_padrao"] = "Só em português: %d."
+	t.Cleanup(func() { delete(catalogos[Padrao], "teste.so_no_padrao") })
+
+	casos := []struct {
+		idioma, chave string
+		args          []any
+		esperado      string
+	}{
+		{InglesEUA, "titulo.conflito", nil, "Conflict with the current state of the resource."},
+		{PortuguesBrasil, "titulo.conflito", nil, "Conflito com o estado atual do recurso."},
+		{InglesEUA, "recurso.nao_encontrado", []any{"Trilha", 7}, "Trilha with ID 7 not found."},
+		{InglesEUA, "teste.so_no_padrao", []any{3}, "Só em português: 3."},
+		{"fr-FR", "titulo.conflito", nil, "Conflito com o estado atual do recurso."},
+		{InglesEUA, "chave.inexistente", nil, "chave.inexistente"},
+	}
+	for _, c := range casos {
+		if msg := Mensagem(c.idioma, c.chave, c.args...); msg != c.esperado {
+			t.Fatalf("Mensagem(%q, %q) = %q, esperado %q", c.idioma, c.chave, msg, c.esperado)
+		}
+	}
+}
+
+func TestRecurso(t *testing.T) {
+	casos := []struct {
+		idioma, nome, esperado string
+	}{
+		{InglesEUA, "Trilha", "Learning path"},
+		{PortuguesBrasil, "Trilha", "Trilha"},
+		{InglesEUA, "Desconhecido", "Desconhecido"},
+		{"fr-FR", "Trilha", "Trilha"},
+	}
+	for _, c := range casos {
+		if nome := Recurso(c.idioma, c.nome); nome != c.esperado {
+			t.Fatalf("Recurso(%q, %q) = %q, esperado %q", c.idioma, c.nome, nome, c.esperado)
+		}
+	}
+}
+
+// Todos os idiomas precisam ter as mesmas chaves: a reserva para o padrão só cobre
+// esquecimentos, não deve ser o comportamento normal.
+func TestCatalogosCompletos(t *testing.T) {
+	for _, idioma := range Suportados {
+		for _, outro := range Suportados {
+			for chave := range catalogos[idioma] {
+				if _, ok := catalogos[outro][chave]; !ok {
+					t.Errorf("chave %q existe em %s, mas não em %s", chave, idioma, outro)
+				}
+			}
+		}
+	}
+}
@@ -1,14 +1,81 @@
 package model
 
 import (
-	"fmt"
+	"strings"
 	"time"
+
+	"upskilling-api/i18n"
 )
 
-// DTOs de Erro
+// ErrorResponse é o corpo das respostas de erro no formato Problem Details (RFC 7807),
+// servido como application/problem+json. Code é estável e pode ser usado pelos clientes;
+// Title e Detail são textos para pessoas e podem mudar.
 type ErrorResponse struct {
-	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
+	Type      string          `json:"type" example:"urn:upskilling:erro:dados_invalidos"`
+	Title     string          `json:"title" example:"Dados de entrada inválidos."`
+	Status    int             `json:"status" example:"400"`
+	Detail    string          `json:"detail,omitempty"`
+	Instance  string          `json:"instance,omitempty" example:"/api/v1/trilhas/"`
+	Code      string          `json:"code" example:"dados_invalidos"`
+	Errors    []CampoInvalido `json:"errors,omitempty"`    // campos rejeitados (erros de validação)
+	Violacoes []string        `json:"violacoes,omitempty"` // requisitos não atendidos (regras de negócio)
+}
+
+// CampoInvalido associa um campo da requisição (nome no JSON, ou parâmetro da URL) ao
+// motivo da rejeição.
+type CampoInvalido struct {
+	Campo  string `json:"campo" example:"nivel"`
+	Motivo string `json:"motivo" example:"deve ser um dos valores: INICIANTE, INTERMEDIARIO, AVANCADO"`
+}
+
+// TipoErroPrefixo é o prefixo do campo type das respostas de erro; o sufixo é o código.
+const TipoErroPrefixo = "urn:upskilling:erro:"
+
+// Códigos estáveis dos erros da API (campo code das respostas de erro).
+const (
+	CodigoDadosInvalidos          = "dados_invalidos"
+	CodigoParametroInvalido       = "parametro_invalido"
+	CodigoNaoAutenticado          = "nao_autenticado"
+	CodigoAcessoNegado            = "acesso_negado"
+	CodigoRecursoNaoEncontrado    = "recurso_nao_encontrado"
+	CodigoConflito                = "conflito"
+	CodigoEmailJaCadastrado       = "email_ja_cadastrado"
+	CodigoMatriculaAtivaDuplicada = "matricula_ativa_duplicada"
+	CodigoRegraNegocio            = "regra_de_negocio"
+	CodigoRequisitosNaoAtendidos  = "requisitos_nao_atendidos"
+	CodigoTransicaoInvalida       = "transicao_invalida"
+	CodigoReferenciaInexistente   = "referencia_inexistente"
+	CodigoFalhaTransitoria        = "falha_transitoria"
+	CodigoErroInterno             = "erro_interno"
+)
+
+// --------------------------------------------------------------------------------
+// Paginação, Ordenação e Filtros
+// --------------------------------------------------------------------------------
+
+// Limites de paginação das listagens.
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// ListParams reúne os parâmetros de listagem: paginação por limit/offset ou por
+// cursor, ordenação (sort=campo ou sort=-campo para decrescente) e filtros.
+type ListParams struct {
+	Limit   int
+	Offset  int
+	Cursor  string
+	Sort    string
+	Filtros map[string]string
+}
+
+// Pagina contém os metadados de uma página de resultados.
+type Pagina struct {
+	Total         int
+	Limit         int
+	Offset        int
+	Sort          string
+	ProximoCursor string // vazio quando não há próxima página
 }
 
 // --------------------------------------------------------------------------------
@@ -23,6 +90,31 @@ type Usuario struct {
 	AreaAtuacao   string    `json:"area_atuacao,omitempty"`
 	NivelCarreira string    `json:"nivel_carreira,omitempty"`
 	DataCadastro  time.Time `json:"data_cadastro"`
+	Papel         string    `json:"papel"`
+	OrganizacaoID int64     `json:"organizacao_id"`
+	EquipeID      *int64    `json:"equipe_id,omitempty"`
+	GestorID      *int64    `json:"gestor_id,omitempty"` // gestor direto
+	SenhaHash     string    `json:"-"`                   // hash bcrypt; nunca exposto
+
+	// OrganizacaoPlataforma indica que o usuário pertence à organização que mantém o
+	// catálogo público. Preenchido apenas para o usuário autenticado.
+	OrganizacaoPlataforma bool `json:"-"`
+}
+
+// Organizacao representa um tenant (empresa cliente) da plataforma.
+type Organizacao struct {
+	ID          int64     `json:"id"`
+	Nome        string    `json:"nome"`
+	Slug        string    `json:"slug"`
+	Plataforma  bool      `json:"plataforma"` // mantém o catálogo público
+	DataCriacao time.Time `json:"data_criacao"`
+}
+
+// Equipe representa um time dentro de uma organização.
+type Equipe struct {
+	ID            int64  `json:"id"`
+	OrganizacaoID int64  `json:"organizacao_id"`
+	Nome          string `json:"nome"`
 }
 
 // Trilha representa uma trilha de aprendizagem.
@@ -33,6 +125,12 @@ type Trilha struct {
 	Nivel         string `json:"nivel"`                    // INICIANTE, INTERMEDIARIO, AVANCADO
 	CargaHoraria  int    `json:"carga_horaria"`            // em horas
 	FocoPrincipal string `json:"foco_principal,omitempty"` // IA, Dados, Soft Skills, Green Tech
+	OrganizacaoID *int64 `json:"organizacao_id,omitempty"` // nil = catálogo público
+}
+
+// Publica indica se a trilha pertence ao catálogo público compartilhado.
+func (t *Trilha) Publica() bool {
+	return t.OrganizacaoID == nil
 }
 
 // Competencia representa uma skill do futuro do trabalho.
@@ -43,13 +141,164 @@ type Competencia struct {
 	Descricao string `json:"descricao,omitempty"`
 }
 
+// Cargo representa um cargo-alvo: um papel que o usuário deseja alcançar, com as
+// competências e os níveis mínimos exigidos.
+type Cargo struct {
+	ID            int64              `json:"id"`
+	Nome          string             `json:"nome"`
+	Descricao     string             `json:"descricao,omitempty"`
+	OrganizacaoID *int64             `json:"organizacao_id,omitempty"` // nil para cargos do catálogo público
+	Competencias  []CompetenciaCargo `json:"competencias,omitempty"`
+}
+
+// Publica indica se o cargo pertence ao catálogo público compartilhado.
+func (c *Cargo) Publica() bool {
+	return c.OrganizacaoID == nil
+}
+
+// CompetenciaCargo é uma competência exigida por um cargo, com o nível mínimo.
+type CompetenciaCargo struct {
+	CompetenciaID int64  `json:"competencia_id"`
+	Nome          string `json:"nome"`
+	Categoria     string `json:"categoria,omitempty"`
+	NivelMinimo   int    `json:"nivel_minimo"`
+}
+
+// Modulo representa um módulo de conteúdo de uma trilha.
+type Modulo struct {
+	ID        int64  `json:"id"`
+	TrilhaID  int64  `json:"trilha_id"`
+	Titulo    string `json:"titulo"`
+	Descricao string `json:"descricao,omitempty"`
+	Ordem     int    `json:"ordem"`
+}
+
+// Aula representa uma aula ou atividade dentro de um módulo.
+type Aula struct {
+	ID             int64  `json:"id"`
+	ModuloID       int64  `json:"modulo_id"`
+	Titulo         string `json:"titulo"`
+	Tipo           string `json:"tipo"` // VIDEO, LEITURA, EXERCICIO, PROJETO, QUIZ
+	Ordem          int    `json:"ordem"`
+	DuracaoMinutos int    `json:"duracao_minutos"`
+	URL            string `json:"url,omitempty"`
+}
+
 // Matricula representa a inscrição de um usuário em uma trilha.
 type Matricula struct {
-	ID            int64     `json:"id"`
-	UsuarioID     int64     `json:"usuario_id"`
-	TrilhaID      int64     `json:"trilha_id"`
-	DataInscricao time.Time `json:"data_inscricao"`
-	Status        string    `json:"status"` // ATIVA, CONCLUIDA, CANCELADA
+	ID                  int64      `json:"id"`
+	UsuarioID           int64      `json:"usuario_id"`
+	TrilhaID            int64      `json:"trilha_id"`
+	DataInscricao       time.Time  `json:"data_inscricao"`
+	Status              string     `json:"status"` // ATIVA, CONCLUIDA, CANCELADA
+	DataConclusao       *time.Time `json:"data_conclusao,omitempty"`
+	DataCancelamento    *time.Time `json:"data_cancelamento,omitempty"`
+	HorasEstudadas      float64    `json:"horas_estudadas"`
+	DataUltimaAtividade *time.Time `json:"data_ultima_atividade,omitempty"`
+	DataPrazo           *time.Time `json:"data_prazo,omitempty"` // prazo para conclusão (opcional)
+}
+
+// SessaoEstudo representa um período de estudo registrado em uma matrícula.
+type SessaoEstudo struct {
+	ID          int64     `json:"id"`
+	MatriculaID int64     `json:"matricula_id"`
+	Horas       float64   `json:"horas"`
+	DataSessao  time.Time `json:"data_sessao"`
+	Observacao  string    `json:"observacao,omitempty"`
+}
+
+// RequisitosTrilha agrupa as regras de elegibilidade para inscrição em uma trilha.
+type RequisitosTrilha struct {
+	TrilhaID               int64
+	NivelCarreiraMinimo    string
+	TrilhasPrerequisito    []Trilha
+	CompetenciasRequeridas []Competencia
+}
+
+// NiveisCarreira lista os níveis de carreira reconhecidos, do menos ao mais experiente.
+var NiveisCarreira = []string{"Em transição", "Junior", "Pleno", "Senior"}
+
+// RankNivelCarreira retorna a posição do nível em NiveisCarreira (sem diferenciar
+// maiúsculas), ou -1 se o nível não for reconhecido.
+func RankNivelCarreira(nivel string) int {
+	for i, n := range NiveisCarreira {
+		if strings.EqualFold(n, strings.TrimSpace(nivel)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// NormalizarNivelCarreira devolve o nível com a grafia canônica de NiveisCarreira,
+// ou o valor original se ele não for reconhecido.
+func NormalizarNivelCarreira(nivel string) string {
+	if rank := RankNivelCarreira(nivel); rank >= 0 {
+		return NiveisCarreira[rank]
+	}
+	return nivel
+}
+
+// Status possíveis de uma matrícula.
+const (
+	StatusMatriculaAtiva     = "ATIVA"
+	StatusMatriculaConcluida = "CONCLUIDA"
+	StatusMatriculaCancelada = "CANCELADA"
+)
+
+// Origens do nível de proficiência de uma competência do usuário.
+const (
+	OrigemAutoavaliacao = "AUTOAVALIACAO" // declarada pelo próprio usuário
+	OrigemTrilha        = "TRILHA"        // concedida ao concluir uma trilha que desenvolve a competência
+	OrigemGestor        = "GESTOR"        // validada pelo gestor direto ou por um admin
+)
+
+// Nível de proficiência mínimo e máximo de uma competência.
+const (
+	NivelProficienciaMinimo = 1
+	NivelProficienciaMaximo = 5
+)
+
+// Papéis (roles) de um usuário na plataforma.
+const (
+	PapelLearner = "learner" // aluno: lê o catálogo e gerencia os próprios dados
+	PapelManager = "manager" // gestor de equipe
+	PapelCurator = "curator" // curador: mantém o catálogo de trilhas e competências
+	PapelAdmin   = "admin"   // administrador: acesso total
+)
+
+// Papeis lista os papéis válidos.
+var Papeis = []string{PapelLearner, PapelManager, PapelCurator, PapelAdmin}
+
+// Permissao identifica uma ação protegida da API.
+type Permissao string
+
+// Permissões atribuídas aos papéis. Ações sobre os próprios dados (perfil e matrículas)
+// não exigem permissão; as permissões abaixo liberam o acesso aos dados de terceiros.
+const (
+	PermGerenciarCatalogo   Permissao = "catalogo:gerenciar"   // criar/alterar/remover trilhas, competências e conteúdo
+	PermGerenciarUsuarios   Permissao = "usuarios:gerenciar"   // listar, alterar e remover qualquer usuário e definir papéis
+	PermGerenciarMatriculas Permissao = "matriculas:gerenciar" // consultar e operar matrículas de qualquer usuário
+)
+
+// PermissoesPorPapel define as permissões de cada papel. O admin possui todas.
+var PermissoesPorPapel = map[string][]Permissao{
+	PapelLearner: {},
+	PapelManager: {},
+	PapelCurator: {PermGerenciarCatalogo},
+	PapelAdmin:   {PermGerenciarCatalogo, PermGerenciarUsuarios, PermGerenciarMatriculas},
+}
+
+// TemPermissao indica se o papel do usuário concede a permissão.
+func (u *Usuario) TemPermissao(p Permissao) bool {
+	if u == nil {
+		return false
+	}
+	for _, permissao := range PermissoesPorPapel[u.Papel] {
+		if permissao == p {
+			return true
+		}
+	}
+	return false
 }
 
 // --------------------------------------------------------------------------------
@@ -62,6 +311,9 @@ type CreateUsuarioRequest struct {
 	Email         string `json:"email" binding:"required,email"`
 	AreaAtuacao   string `json:"area_atuacao,omitempty" binding:"max=100"`
 	NivelCarreira string `json:"nivel_carreira,omitempty" binding:"max=50"`
+	Senha         string `json:"senha" binding:"required,min=8,max=72"`
+	EquipeID      *int64 `json:"equipe_id,omitempty"`
+	GestorID      *int64 `json:"gestor_id,omitempty"`
 }
 
 // UpdateUsuarioRequest é o DTO para atualizar um usuário existente.
@@ -69,6 +321,38 @@ type UpdateUsuarioRequest struct {
 	Nome          string `json:"nome,omitempty" binding:"omitempty,min=3,max=100"`
 	AreaAtuacao   string `json:"area_atuacao,omitempty" binding:"max=100"`
 	NivelCarreira string `json:"nivel_carreira,omitempty" binding:"max=50"`
+	Senha         string `json:"senha,omitempty" binding:"omitempty,min=8,max=72"` // encerra as sessões abertas
+	SenhaAtual    string `json:"senha_atual,omitempty" binding:"max=72"`           // obrigatória ao trocar a própria senha
+	EquipeID      *int64 `json:"equipe_id,omitempty"`                              // apenas administradores
+	GestorID      *int64 `json:"gestor_id,omitempty"`                              // apenas administradores
+}
+
+// CreateOrganizacaoRequest é o DTO para registrar uma organização com seu primeiro administrador.
+type CreateOrganizacaoRequest struct {
+	Nome  string               `json:"nome" binding:"required,min=3,max=150"`
+	Slug  string               `json:"slug" binding:"required,min=3,max=60"` // letras minúsculas, números e hífens
+	Admin CreateUsuarioRequest `json:"admin" binding:"required"`
+}
+
+// EquipeRequest é o DTO para criar ou renomear uma equipe.
+type EquipeRequest struct {
+	Nome string `json:"nome" binding:"required,min=2,max=100"`
+}
+
+// SetPapelRequest é o DTO para alterar o papel de um usuário.
+type SetPapelRequest struct {
+	Papel string `json:"papel" binding:"required,oneof=learner manager curator admin"`
+}
+
+// LoginRequest é o DTO de autenticação por email e senha.
+type LoginRequest struct {
+	Email string `json:"email" binding:"required,email"`
+	Senha string `json:"senha" binding:"required"`
+}
+
+// RefreshTokenRequest é o DTO que carrega um refresh token (renovação e logout).
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
 // CreateTrilhaRequest é o DTO para criar uma nova trilha.
@@ -78,6 +362,7 @@ type CreateTrilhaRequest struct {
 	Nivel         string `json:"nivel" binding:"required,oneof=INICIANTE INTERMEDIARIO AVANCADO"`
 	CargaHoraria  int    `json:"carga_horaria" binding:"required,gt=0"`
 	FocoPrincipal string `json:"foco_principal,omitempty" binding:"max=100"`
+	Publica       bool   `json:"publica,omitempty"` // apenas curadores da plataforma
 }
 
 // UpdateTrilhaRequest é o DTO para atualizar uma trilha existente.
@@ -89,6 +374,104 @@ type UpdateTrilhaRequest struct {
 	FocoPrincipal string `json:"foco_principal,omitempty" binding:"max=100"`
 }
 
+// CreateCompetenciaRequest é o DTO para criar uma nova competência.
+type CreateCompetenciaRequest struct {
+	Nome      string `json:"nome" binding:"required,min=3,max=100"`
+	Categoria string `json:"categoria,omitempty" binding:"max=100"`
+	Descricao string `json:"descricao,omitempty"`
+}
+
+// UpdateCompetenciaRequest é o DTO para atualizar uma competência existente.
+type UpdateCompetenciaRequest struct {
+	Nome      string `json:"nome,omitempty" binding:"omitempty,min=3,max=100"`
+	Categoria string `json:"categoria,omitempty" binding:"max=100"`
+	Descricao string `json:"descricao,omitempty"`
+}
+
+// SetTrilhaCompetenciasRequest é o DTO para substituir as competências de uma trilha.
+type SetTrilhaCompetenciasRequest struct {
+	CompetenciaIDs []int64 `json:"competencia_ids" binding:"required,dive,gt=0"`
+}
+
+// NivelCompetenciaRequest é o nível de proficiência informado para uma competência.
+type NivelCompetenciaRequest struct {
+	CompetenciaID int64 `json:"competencia_id" binding:"required,gt=0"`
+	Nivel         int   `json:"nivel" binding:"required,min=1,max=5"`
+}
+
+// SetCompetenciasUsuarioRequest é o DTO para substituir as avaliações de competências de
+// um usuário. A origem é definida por quem envia: o próprio usuário (autoavaliação) ou
+// o gestor direto/admin (validação).
+type SetCompetenciasUsuarioRequest struct {
+	Competencias []NivelCompetenciaRequest `json:"competencias" binding:"required,dive"`
+}
+
+// CreateCargoRequest é o DTO para criar um cargo-alvo.
+type CreateCargoRequest struct {
+	Nome         string                    `json:"nome" binding:"required,min=3,max=150"`
+	Descricao    string                    `json:"descricao,omitempty"`
+	Publica      bool                      `json:"publica,omitempty"` // apenas curadores da plataforma
+	Competencias []NivelCompetenciaRequest `json:"competencias,omitempty" binding:"dive"`
+}
+
+// UpdateCargoRequest é o DTO para atualizar um cargo-alvo existente.
+type UpdateCargoRequest struct {
+	Nome      string `json:"nome,omitempty" binding:"omitempty,min=3,max=150"`
+	Descricao string `json:"descricao,omitempty"`
+}
+
+// SetCargoCompetenciasRequest é o DTO para substituir as competências exigidas por um
+// cargo-alvo; o nível informado é o mínimo exigido.
+type SetCargoCompetenciasRequest struct {
+	Competencias []NivelCompetenciaRequest `json:"competencias" binding:"required,dive"`
+}
+
+// RegistrarSessaoRequest é o DTO para registrar uma sessão de estudo em uma matrícula.
+type RegistrarSessaoRequest struct {
+	Horas      float64    `json:"horas" binding:"required,gt=0,lte=24"`
+	DataSessao *time.Time `json:"data_sessao,omitempty"` // padrão: momento do registro
+	Observacao string     `json:"observacao,omitempty" binding:"max=500"`
+}
+
+// CreateModuloRequest é o DTO para criar um módulo em uma trilha.
+type CreateModuloRequest struct {
+	Titulo    string `json:"titulo" binding:"required,min=3,max=150"`
+	Descricao string `json:"descricao,omitempty"`
+	Ordem     int    `json:"ordem,omitempty" binding:"omitempty,gt=0"` // padrão: após o último módulo
+}
+
+// UpdateModuloRequest é o DTO para atualizar um módulo existente.
+type UpdateModuloRequest struct {
+	Titulo    string `json:"titulo,omitempty" binding:"omitempty,min=3,max=150"`
+	Descricao string `json:"descricao,omitempty"`
+	Ordem     int    `json:"ordem,omitempty" binding:"omitempty,gt=0"`
+}
+
+// CreateAulaRequest é o DTO para criar uma aula em um módulo.
+type CreateAulaRequest struct {
+	Titulo         string `json:"titulo" binding:"required,min=3,max=150"`
+	Tipo           string `json:"tipo" binding:"required,oneof=VIDEO LEITURA EXERCICIO PROJETO QUIZ"`
+	Ordem          int    `json:"ordem,omitempty" binding:"omitempty,gt=0"` // padrão: após a última aula
+	DuracaoMinutos int    `json:"duracao_minutos" binding:"required,gt=0"`
+	URL            string `json:"url,omitempty" binding:"omitempty,url"`
+}
+
+// UpdateAulaRequest é o DTO para atualizar uma aula existente.
+type UpdateAulaRequest struct {
+	Titulo         string `json:"titulo,omitempty" binding:"omitempty,min=3,max=150"`
+	Tipo           string `json:"tipo,omitempty" binding:"omitempty,oneof=VIDEO LEITURA EXERCICIO PROJETO QUIZ"`
+	Ordem          int    `json:"ordem,omitempty" binding:"omitempty,gt=0"`
+	DuracaoMinutos int    `json:"duracao_minutos,omitempty" binding:"omitempty,gt=0"`
+	URL            string `json:"url,omitempty" binding:"omitempty,url"`
+}
+
+// SetRequisitosRequest é o DTO para substituir os requisitos de elegibilidade de uma trilha.
+type SetRequisitosRequest struct {
+	NivelCarreiraMinimo       string  `json:"nivel_carreira_minimo,omitempty" binding:"max=50"` // Em transição, Junior, Pleno, Senior
+	TrilhasPrerequisitoIDs    []int64 `json:"trilhas_prerequisito_ids" binding:"dive,gt=0"`
+	CompetenciasRequeridasIDs []int64 `json:"competencias_requeridas_ids" binding:"dive,gt=0"`
+}
+
 // --------------------------------------------------------------------------------
 // DTOs de Resposta (Output)
 // --------------------------------------------------------------------------------
@@ -101,16 +484,287 @@ type UsuarioResponse struct {
 	AreaAtuacao   string    `json:"area_atuacao,omitempty"`
 	NivelCarreira string    `json:"nivel_carreira,omitempty"`
 	DataCadastro  time.Time `json:"data_cadastro"`
+	Papel         string    `json:"papel"`
+	OrganizacaoID int64     `json:"organizacao_id"`
+	EquipeID      *int64    `json:"equipe_id,omitempty"`
+	GestorID      *int64    `json:"gestor_id,omitempty"`
+}
+
+// RegistroOrganizacaoResponse é o DTO de resposta do registro de uma organização.
+type RegistroOrganizacaoResponse struct {
+	Organizacao Organizacao     `json:"organizacao"`
+	Admin       UsuarioResponse `json:"admin"`
 }
 
 // TrilhaResponse é o DTO de resposta para uma trilha.
 type TrilhaResponse struct {
-	ID            int64  `json:"id"`
-	Nome          string `json:"nome"`
-	Descricao     string `json:"descricao,omitempty"`
-	Nivel         string `json:"nivel"`
-	CargaHoraria  int    `json:"carga_horaria"`
-	FocoPrincipal string `json:"foco_principal,omitempty"`
+	ID            int64                 `json:"id"`
+	Nome          string                `json:"nome"`
+	Descricao     string                `json:"descricao,omitempty"`
+	Nivel         string                `json:"nivel"`
+	CargaHoraria  int                   `json:"carga_horaria"`
+	FocoPrincipal string                `json:"foco_principal,omitempty"`
+	Publica       bool                  `json:"publica"`
+	OrganizacaoID *int64                `json:"organizacao_id,omitempty"` // dona da trilha privada
+	Competencias  []CompetenciaResponse `json:"competencias,omitempty"`   // apenas com ?incluir=competencias
+}
+
+// CompetenciaResponse é o DTO de resposta para uma competência.
+type CompetenciaResponse struct {
+	ID        int64  `json:"id"`
+	Nome      string `json:"nome"`
+	Categoria string `json:"categoria,omitempty"`
+	Descricao string `json:"descricao,omitempty"`
+}
+
+// AvaliacaoCompetencia é o nível de proficiência registrado por uma origem.
+type AvaliacaoCompetencia struct {
+	Origem          string    `json:"origem"` // AUTOAVALIACAO, TRILHA, GESTOR
+	Nivel           int       `json:"nivel"`
+	DataAtualizacao time.Time `json:"data_atualizacao"`
+}
+
+// CompetenciaUsuario é o DTO de uma competência do perfil do usuário. Nivel e Origem
+// refletem o nível efetivo (GESTOR prevalece sobre TRILHA, que prevalece sobre
+// AUTOAVALIACAO); Avaliacoes traz o registro de cada origem.
+type CompetenciaUsuario struct {
+	CompetenciaID   int64                  `json:"competencia_id"`
+	Nome            string                 `json:"nome"`
+	Categoria       string                 `json:"categoria,omitempty"`
+	Nivel           int                    `json:"nivel"`
+	Origem          string                 `json:"origem"`
+	Verificada      bool                   `json:"verificada"` // origem TRILHA ou GESTOR
+	DataAtualizacao time.Time              `json:"data_atualizacao"`
+	Avaliacoes      []AvaliacaoCompetencia `json:"avaliacoes"`
+}
+
+// CargoResponse é o DTO de resposta para um cargo-alvo.
+type CargoResponse struct {
+	ID            int64              `json:"id"`
+	Nome          string             `json:"nome"`
+	Descricao     string             `json:"descricao,omitempty"`
+	Publica       bool               `json:"publica"`
+	OrganizacaoID *int64             `json:"organizacao_id,omitempty"`
+	Competencias  []CompetenciaCargo `json:"competencias,omitempty"` // apenas na busca por ID
+}
+
+// GapCompetenciasResponse é o DTO de resposta da análise de lacunas entre o perfil de
+// competências do usuário e um cargo-alvo.
+type GapCompetenciasResponse struct {
+	UsuarioID             int64               `json:"usuario_id"`
+	CargoID               int64               `json:"cargo_id"`
+	CargoNome             string              `json:"cargo_nome"`
+	TotalCompetencias     int                 `json:"total_competencias"` // exigidas pelo cargo
+	CompetenciasAtendidas int                 `json:"competencias_atendidas"`
+	Aderencia             float64             `json:"aderencia"`           // % de competências atendidas
+	CargaHorariaTotal     int                 `json:"carga_horaria_total"` // soma das trilhas recomendadas, sem repetição
+	Lacunas               []LacunaCompetencia `json:"lacunas"`
+}
+
+// LacunaCompetencia é uma competência exigida pelo cargo em que o nível do usuário está
+// abaixo do mínimo, com as trilhas que a desenvolvem.
+type LacunaCompetencia struct {
+	CompetenciaID  int64          `json:"competencia_id"`
+	Nome           string         `json:"nome"`
+	Categoria      string         `json:"categoria,omitempty"`
+	NivelRequerido int            `json:"nivel_requerido"`
+	NivelAtual     int            `json:"nivel_atual"`      // 0 quando o usuário não possui a competência
+	Origem         string         `json:"origem,omitempty"` // origem do nível atual
+	Trilhas        []TrilhaLacuna `json:"trilhas"`
+}
+
+// TrilhaLacuna é uma trilha que desenvolve a competência de uma lacuna.
+type TrilhaLacuna struct {
+	TrilhaID       int64  `json:"trilha_id"`
+	Nome           string `json:"nome"`
+	Nivel          string `json:"nivel"`
+	CargaHoraria   int    `json:"carga_horaria"`
+	NivelConcedido int    `json:"nivel_concedido"` // nível concedido ao concluir a trilha
+	FechaLacuna    bool   `json:"fecha_lacuna"`    // o nível concedido atinge o requerido
+	Recomendada    bool   `json:"recomendada"`     // considerada na carga horária total
+}
+
+// RequisitosResponse é o DTO de resposta com os requisitos de elegibilidade de uma trilha.
+type RequisitosResponse struct {
+	TrilhaID               int64                 `json:"trilha_id"`
+	NivelCarreiraMinimo    string                `json:"nivel_carreira_minimo,omitempty"`
+	TrilhasPrerequisito    []TrilhaResponse      `json:"trilhas_prerequisito"`
+	CompetenciasRequeridas []CompetenciaResponse `json:"competencias_requeridas"`
+}
+
+// ElegibilidadeResponse é o DTO de resposta da avaliação de elegibilidade de um usuário.
+// O service preenche apenas Violacoes; o controller traduz cada uma para o idioma da
+// requisição em RequisitosNaoAtendidos.
+type ElegibilidadeResponse struct {
+	UsuarioID              int64      `json:"usuario_id"`
+	TrilhaID               int64      `json:"trilha_id"`
+	Elegivel               bool       `json:"elegivel"`
+	RequisitosNaoAtendidos []string   `json:"requisitos_nao_atendidos"`
+	Violacoes              []Violacao `json:"-"`
+}
+
+// Violacao é um requisito não atendido, identificado pela chave da mensagem no catálogo
+// (i18n) e seus argumentos.
+type Violacao struct {
+	Chave string
+	Args  []any
+}
+
+// Texto traduz a violação para o idioma informado.
+func (v Violacao) Texto(idioma string) string {
+	return i18n.Mensagem(idioma, v.Chave, v.Args...)
+}
+
+// TextosViolacoes traduz cada violação para o idioma informado.
+func TextosViolacoes(idioma string, violacoes []Violacao) []string {
+	textos := make([]string, len(violacoes))
+	for i, v := range violacoes {
+		textos[i] = v.Texto(idioma)
+	}
+	return textos
+}
+
+// ModuloResponse é o DTO de resposta para um módulo, com suas aulas em ordem.
+type ModuloResponse struct {
+	ID             int64  `json:"id"`
+	TrilhaID       int64  `json:"trilha_id"`
+	Titulo         string `json:"titulo"`
+	Descricao      string `json:"descricao,omitempty"`
+	Ordem          int    `json:"ordem"`
+	DuracaoMinutos int    `json:"duracao_minutos"` // soma das aulas
+	Aulas          []Aula `json:"aulas"`
+}
+
+// TokenResponse é o DTO de resposta da autenticação.
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"` // sempre "Bearer"
+	ExpiresIn    int64  `json:"expires_in"` // validade do access token, em segundos
+}
+
+// ResumoLiderado é o DTO com os indicadores de aprendizagem de um liderado.
+type ResumoLiderado struct {
+	UsuarioID            int64      `json:"usuario_id"`
+	Nome                 string     `json:"nome"`
+	Email                string     `json:"email"`
+	EquipeID             *int64     `json:"equipe_id,omitempty"`
+	TotalMatriculas      int        `json:"total_matriculas"`
+	MatriculasAtivas     int        `json:"matriculas_ativas"`
+	MatriculasConcluidas int        `json:"matriculas_concluidas"`
+	MatriculasCanceladas int        `json:"matriculas_canceladas"`
+	MatriculasAtrasadas  int        `json:"matriculas_atrasadas"` // ATIVAS com prazo vencido
+	TaxaConclusao        float64    `json:"taxa_conclusao"`       // % de concluídas entre as não canceladas
+	HorasEstudadas       float64    `json:"horas_estudadas"`
+	ProgressoMedio       float64    `json:"progresso_medio"` // % médio das matrículas ATIVAS
+	DataUltimaAtividade  *time.Time `json:"data_ultima_atividade,omitempty"`
+}
+
+// PainelGestorResponse é o DTO de resposta do painel do gestor: totais da equipe e o
+// resumo de cada liderado direto.
+type PainelGestorResponse struct {
+	GestorID             int64            `json:"gestor_id"`
+	TotalLiderados       int              `json:"total_liderados"`
+	TotalMatriculas      int              `json:"total_matriculas"`
+	MatriculasAtivas     int              `json:"matriculas_ativas"`
+	MatriculasConcluidas int              `json:"matriculas_concluidas"`
+	MatriculasCanceladas int              `json:"matriculas_canceladas"`
+	MatriculasAtrasadas  int              `json:"matriculas_atrasadas"`
+	TaxaConclusao        float64          `json:"taxa_conclusao"`
+	HorasEstudadas       float64          `json:"horas_estudadas"`
+	Liderados            []ResumoLiderado `json:"liderados"`
+}
+
+// MatriculaLiderado é o DTO de uma matrícula de liderado, com trilha e progresso.
+type MatriculaLiderado struct {
+	MatriculaID         int64      `json:"matricula_id"`
+	UsuarioID           int64      `json:"usuario_id"`
+	UsuarioNome         string     `json:"usuario_nome"`
+	TrilhaID            int64      `json:"trilha_id"`
+	TrilhaNome          string     `json:"trilha_nome"`
+	Status              string     `json:"status"`
+	DataInscricao       time.Time  `json:"data_inscricao"`
+	DataPrazo           *time.Time `json:"data_prazo,omitempty"`
+	DataConclusao       *time.Time `json:"data_conclusao,omitempty"`
+	HorasEstudadas      float64    `json:"horas_estudadas"`
+	CargaHoraria        int        `json:"carga_horaria"`
+	PercentualConcluido float64    `json:"percentual_concluido"`
+	Atrasada            bool       `json:"atrasada"`
+	DataUltimaAtividade *time.Time `json:"data_ultima_atividade,omitempty"`
+}
+
+// ProgressoResponse é o DTO de resposta com o progresso de uma matrícula.
+type ProgressoResponse struct {
+	MatriculaID         int64      `json:"matricula_id"`
+	TrilhaID            int64      `json:"trilha_id"`
+	Status              string     `json:"status"`
+	HorasEstudadas      float64    `json:"horas_estudadas"`
+	CargaHoraria        int        `json:"carga_horaria"`
+	PercentualConcluido float64    `json:"percentual_concluido"`
+	TotalAulas          int        `json:"total_aulas"`
+	AulasConcluidas     int        `json:"aulas_concluidas"`
+	DataUltimaAtividade *time.Time `json:"data_ultima_atividade,omitempty"`
+	DataConclusao       *time.Time `json:"data_conclusao,omitempty"`
+}
+
+// SinaisRecomendacao são os indicadores usados para pontuar uma trilha recomendada.
+type SinaisRecomendacao struct {
+	CompetenciasNovas []string `json:"competencias_novas"` // competências da trilha que o usuário não possui
+	NivelAdequado     bool     `json:"nivel_adequado"`     // nível da trilha indicado para o nível de carreira
+	ColegasMesmaArea  int      `json:"colegas_mesma_area"` // usuários da mesma área de atuação matriculados
+	CoInscritos       int      `json:"co_inscritos"`       // usuários com trilhas em comum que se matricularam
+	Matriculas        int      `json:"matriculas"`         // matrículas na organização (popularidade)
+}
+
+// RecomendacaoTrilha é o DTO de uma trilha recomendada ao usuário, com a pontuação e os
+// motivos da recomendação. O service preenche apenas Razoes; o controller traduz cada uma
+// para o idioma da requisição em Motivos.
+type RecomendacaoTrilha struct {
+	TrilhaID      int64              `json:"trilha_id"`
+	Nome          string             `json:"nome"`
+	Nivel         string             `json:"nivel"`
+	CargaHoraria  int                `json:"carga_horaria"`
+	FocoPrincipal string             `json:"foco_principal,omitempty"`
+	Pontuacao     float64            `json:"pontuacao"`
+	Motivos       []string           `json:"motivos"`
+	Sinais        SinaisRecomendacao `json:"sinais"`
+	Razoes        []Motivo           `json:"-"`
+}
+
+// Motivo é um motivo de recomendação, identificado pela chave da mensagem no catálogo
+// (i18n) e seus argumentos.
+type Motivo struct {
+	Chave string
+	Args  []any
+}
+
+// Texto retorna o motivo no idioma informado.
+func (m Motivo) Texto(idioma string) string {
+	return i18n.Mensagem(idioma, m.Chave, m.Args...)
+}
+
+// TextosMotivos traduz cada motivo para o idioma informado.
+func TextosMotivos(idioma string, motivos []Motivo) []string {
+	textos := make([]string, len(motivos))
+	for i, m := range motivos {
+		textos[i] = m.Texto(idioma)
+	}
+	return textos
+}
+
+// Tipos de resultado da busca textual.
+const (
+	TipoBuscaTrilha      = "trilha"
+	TipoBuscaCompetencia = "competencia"
+)
+
+// ResultadoBusca é o DTO de resposta de um item encontrado pela busca textual.
+type ResultadoBusca struct {
+	Tipo       string  `json:"tipo"` // "trilha" ou "competencia"
+	ID         int64   `json:"id"`
+	Titulo     string  `json:"titulo"`
+	Trecho     string  `json:"trecho"` // HTML escapado, com os termos encontrados destacados em <mark>
+	Relevancia float64 `json:"relevancia"`
 }
 
 // --------------------------------------------------------------------------------
@@ -118,10 +772,19 @@ type TrilhaResponse struct {
 // --------------------------------------------------------------------------------
 
 // CustomError é a interface para erros customizados.
+// Message é o resumo do tipo de erro (title) no idioma informado e Code, o código estável
+// (code); o detalhe da ocorrência vem de Error().
 type CustomError interface {
 	Error() string
 	StatusCode() int
-	Message() string
+	Message(idioma string) string
+	Code() string
+}
+
+// ErroTraduzivel é implementado pelos erros cujo detalhe vem do catálogo de mensagens
+// (i18n); Error() corresponde ao detalhe no idioma padrão.
+type ErroTraduzivel interface {
+	Detalhe(idioma string) string
 }
 
 // ResourceNotFoundError representa a exceção TrilhaNaoEncontradaException ou similar.
@@ -131,47 +794,240 @@ type ResourceNotFoundError struct {
 }
 
 func (e *ResourceNotFoundError) Error() string {
-	return fmt.Sprintf("%s não encontrado(a) com ID: %d", e.Resource, e.ID)
+	return e.Detalhe(i18n.Padrao)
+}
+
+func (e *ResourceNotFoundError) Detalhe(idioma string) string {
+	return i18n.Mensagem(idioma, "recurso.nao_encontrado", i18n.Recurso(idioma, e.Resource), e.ID)
 }
 
 func (e *ResourceNotFoundError) StatusCode() int {
 	return 404
 }
 
-func (e *ResourceNotFoundError) Message() string {
-	return e.Resource + " não encontrado(a)."
+func (e *ResourceNotFoundError) Message(idioma string) string {
+	return i18n.Titulo(idioma, e.Code())
+}
+
+func (e *ResourceNotFoundError) Code() string {
+	return CodigoRecursoNaoEncontrado
+}
+
+// ForbiddenError representa uma ação que o usuário autenticado não tem permissão de executar.
+// Chave e Args identificam a mensagem no catálogo (i18n).
+type ForbiddenError struct {
+	Chave string
+	Args  []any
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Detalhe(i18n.Padrao)
+}
+
+func (e *ForbiddenError) Detalhe(idioma string) string {
+	return i18n.Mensagem(idioma, e.Chave, e.Args...)
+}
+
+func (e *ForbiddenError) StatusCode() int {
+	return 403
+}
+
+func (e *ForbiddenError) Message(idioma string) string {
+	return i18n.Titulo(idioma, e.Code())
 }
 
-// ConflictError representa um erro de conflito (ex: email já cadastrado).
+func (e *ForbiddenError) Code() string {
+	return CodigoAcessoNegado
+}
+
+// ConflictError representa um erro de conflito (ex: email já cadastrado). Chave e Args
+// identificam a mensagem no catálogo (i18n). Codigo, quando preenchido, substitui o código
+// genérico CodigoConflito.
 type ConflictError struct {
-	Msg string
+	Chave  string
+	Args   []any
+	Codigo string
 }
 
 func (e *ConflictError) Error() string {
-	return e.Msg
+	return e.Detalhe(i18n.Padrao)
+}
+
+func (e *ConflictError) Detalhe(idioma string) string {
+	return i18n.Mensagem(idioma, e.Chave, e.Args...)
 }
 
 func (e *ConflictError) StatusCode() int {
 	return 409
 }
 
-func (e *ConflictError) Message() string {
-	return e.Msg
+func (e *ConflictError) Message(idioma string) string {
+	return i18n.Titulo(idioma, e.Code())
+}
+
+func (e *ConflictError) Code() string {
+	if e.Codigo != "" {
+		return e.Codigo
+	}
+	return CodigoConflito
+}
+
+// UnauthorizedError representa uma requisição sem credenciais válidas. Chave e Args
+// identificam a mensagem no catálogo (i18n).
+type UnauthorizedError struct {
+	Chave string
+	Args  []any
+}
+
+func (e *UnauthorizedError) Error() string {
+	return e.Detalhe(i18n.Padrao)
+}
+
+func (e *UnauthorizedError) Detalhe(idioma string) string {
+	return i18n.Mensagem(idioma, e.Chave, e.Args...)
+}
+
+func (e *UnauthorizedError) StatusCode() int {
+	return 401
+}
+
+func (e *UnauthorizedError) Message(idioma string) string {
+	return i18n.Titulo(idioma, e.Code())
+}
+
+func (e *UnauthorizedError) Code() string {
+	return CodigoNaoAutenticado
+}
+
+// InvalidParameterError representa um parâmetro de consulta inválido (ex: sort ou cursor).
+// Chave e Args identificam, no catálogo (i18n), o motivo da rejeição.
+type InvalidParameterError struct {
+	Param string
+	Chave string
+	Args  []any
+}
+
+func (e *InvalidParameterError) Error() string {
+	return e.Detalhe(i18n.Padrao)
+}
+
+func (e *InvalidParameterError) Detalhe(idioma string) string {
+	return i18n.Mensagem(idioma, "parametro.invalido", e.Param, e.Motivo(idioma))
+}
+
+// Motivo descreve, no idioma informado, por que o parâmetro foi rejeitado.
+func (e *InvalidParameterError) Motivo(idioma string) string {
+	return i18n.Mensagem(idioma, e.Chave, e.Args...)
+}
+
+func (e *InvalidParameterError) StatusCode() int {
+	return 400
+}
+
+func (e *InvalidParameterError) Message(idioma string) string {
+	return i18n.Titulo(idioma, e.Code())
+}
+
+func (e *InvalidParameterError) Code() string {
+	return CodigoParametroInvalido
+}
+
+// ValidationError representa um corpo de requisição inválido (JSON malformado ou campos
+// que não atendem às regras de binding). Campos lista cada campo rejeitado.
+type ValidationError struct {
+	Msg    string // motivo geral, quando não há campos (ex: JSON malformado)
+	Campos []CampoInvalido
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Campos) == 0 {
+		return e.Msg
+	}
+	motivos := make([]string, len(e.Campos))
+	for i, c := range e.Campos {
+		motivos[i] = c.Campo + ": " + c.Motivo
+	}
+	return strings.Join(motivos, "; ")
+}
+
+func (e *ValidationError) StatusCode() int {
+	return 400
+}
+
+func (e *ValidationError) Message(idioma string) string {
+	return i18n.Titulo(idioma, e.Code())
+}
+
+func (e *ValidationError) Code() string {
+	return CodigoDadosInvalidos
 }
 
 // BusinessRuleError representa a exceção UsuarioNaoElegivelParaTrilhaException ou similar.
+// Chave e Args identificam a mensagem no catálogo (i18n). Violacoes, quando preenchido,
+// lista cada regra não atendida. Codigo, quando preenchido, substitui o código genérico
+// CodigoRegraNegocio.
 type BusinessRuleError struct {
-	Msg string
+	Chave     string
+	Args      []any
+	Violacoes []Violacao
+	Codigo    string
 }
 
 func (e *BusinessRuleError) Error() string {
-	return e.Msg
+	return e.Detalhe(i18n.Padrao)
+}
+
+// Detalhe traduz a mensagem e, em seguida, cada violação.
+func (e *BusinessRuleError) Detalhe(idioma string) string {
+	msg := i18n.Mensagem(idioma, e.Chave, e.Args...)
+	if len(e.Violacoes) > 0 {
+		return msg + " " + strings.Join(TextosViolacoes(idioma, e.Violacoes), " ")
+	}
+	return msg
 }
 
 func (e *BusinessRuleError) StatusCode() int {
 	return 422
 }
 
-func (e *BusinessRuleError) Message() string {
-	return e.Msg
+func (e *BusinessRuleError) Message(idioma string) string {
+	return i18n.Titulo(idioma, e.Code())
+}
+
+func (e *BusinessRuleError) Code() string {
+	if e.Codigo != "" {
+		return e.Codigo
+	}
+	return CodigoRegraNegocio
+}
+
+// TransientError representa uma falha temporária do banco de dados (conflito de
+// serialização ou deadlock): a operação pode ser repetida. Err é o erro original, que só
+// aparece em Error() (logs); o cliente recebe o detalhe do catálogo.
+type TransientError struct {
+	Err error
+}
+
+func (e *TransientError) Error() string {
+	return "falha transitória no banco de dados: " + e.Err.Error()
+}
+
+func (e *TransientError) Detalhe(idioma string) string {
+	return i18n.Mensagem(idioma, "transitorio.conflito_concorrencia")
+}
+
+func (e *TransientError) Unwrap() error {
+	return e.Err
+}
+
+func (e *TransientError) StatusCode() int {
+	return 503
+}
+
+func (e *TransientError) Message(idioma string) string {
+	return i18n.Titulo(idioma, e.Code())
+}
+
+func (e *TransientError) Code() string {
+	return CodigoFalhaTransitoria
 }
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Validade dos tokens emitidos no login.
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 7 * 24 * time.Hour
+	jwtIssuer       = "upskilling-api"
+)
+
+// hashSenhaFalso é comparado quando o email não existe, para que o tempo de resposta do
+// login não revele quais emails estão cadastrados.
+var hashSenhaFalso, _ = bcrypt.GenerateFromPassword([]byte("senha-inexistente"), bcrypt.DefaultCost)
+
+// AuthService é a interface para autenticação e emissão de tokens.
+type AuthService interface {
+	Login(ctx context.Context, req *model.LoginRequest) (*model.TokenResponse, error)
+	Refresh(ctx context.Context, refreshToken string) (*model.TokenResponse, error)
+	Logout(ctx context.Context, refreshToken string) error
+	Authenticate(ctx context.Context, accessToken string) (*model.Usuario, error)
+}
+
+// authServiceImpl implementa a interface AuthService.
+type authServiceImpl struct {
+	tx              dao.Transacionador
+	usuarioDAO      dao.UsuarioDAO
+	refreshTokenDAO dao.RefreshTokenDAO
+	jwtSecret       []byte // chave HMAC dos access tokens
+}
+
+// NewAuthService cria uma nova instância de AuthService.
+func NewAuthService(tx dao.Transacionador, usuarioDAO dao.UsuarioDAO, refreshTokenDAO dao.RefreshTokenDAO, jwtSecret []byte) AuthService {
+	return &authServiceImpl{
+		tx:              tx,
+		usuarioDAO:      usuarioDAO,
+		refreshTokenDAO: refreshTokenDAO,
+		jwtSecret:       jwtSecret,
+	}
+}
+
+// Login valida email e senha e emite um par de tokens.
+func (s *authServiceImpl) Login(ctx context.Context, req *model.LoginRequest) (*model.TokenResponse, error) {
+	credenciaisInvalidas := &model.UnauthorizedError{Chave: "auth.credenciais_invalidas"}
+
+	// 1. Busca do usuário (sem revelar se o email existe)
+	usuario, err := s.usuarioDAO.FindByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if usuario == nil || usuario.SenhaHash == "" {
+		bcrypt.CompareHashAndPassword(hashSenhaFalso, []byte(req.Senha))
+		return nil, credenciaisInvalidas
+	}
+
+	// 2. Conferência da senha
+	if err := bcrypt.CompareHashAndPassword([]byte(usuario.SenhaHash), []byte(req.Senha)); err != nil {
+		return nil, credenciaisInvalidas
+	}
+
+	// 3. Emissão dos tokens
+	return s.emitirTokens(ctx, usuario.ID)
+}
+
+// Refresh troca um refresh token válido por um novo par de tokens. O token usado é
+// revogado (rotação), então cada refresh token só pode ser usado uma vez. A revogação e
+// o registro do novo token acontecem na mesma transação: se a emissão falhar, o token
+// usado continua válido.
+func (s *authServiceImpl) Refresh(ctx context.Context, refreshToken string) (*model.TokenResponse, error) {
+	var tokens *model.TokenResponse
+	err := WithTx(ctx, s.tx, func(ctx context.Context) error {
+		usuarioID, err := s.refreshTokenDAO.Consume(ctx, hashToken(refreshToken))
+		if err != nil {
+			return err
+		}
+		if usuarioID == 0 {
+			return &model.UnauthorizedError{Chave: "auth.refresh_token_invalido"}
+		}
+		tokens, err = s.emitirTokens(ctx, usuarioID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// Logout revoga o refresh token informado. O access token continua válido até expirar.
+func (s *authServiceImpl) Logout(ctx context.Context, refreshToken string) error {
+	return s.refreshTokenDAO.Revoke(ctx, hashToken(refreshToken))
+}
+
+// Authenticate valida a assinatura e a validade do access token e carrega o usuário.
+func (s *authServiceImpl) Authenticate(ctx context.Context, accessToken string) (*model.Usuario, error) {
+	// 1. Validação do JWT (algoritmo, emissor e expiração)
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(*jwt.Token) (any, error) {
+		return s.jwtSecret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(jwtIssuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, &model.UnauthorizedError{Chave: "auth.access_token_expirado"}
+		}
+		return nil, &model.UnauthorizedError{Chave: "auth.access_token_invalido"}
+	}
+
+	usuarioID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, &model.UnauthorizedError{Chave: "auth.access_token_invalido"}
+	}
+
+	// 2. O usuário precisa continuar existindo
+	usuario, err := s.usuarioDAO.FindAutenticado(ctx, usuarioID)
+	if err != nil {
+		var notFound *model.ResourceNotFoundError
+		if errors.As(err, &notFound) {
+			return nil, &model.UnauthorizedError{Chave: "auth.usuario_removido"}
+		}
+		return nil, err
+	}
+	return usuario, nil
+}
+
+// emitirTokens assina um access token JWT e registra um novo refresh token opaco.
+func (s *authServiceImpl) emitirTokens(ctx context.Context, usuarioID int64) (*model.TokenResponse, error) {
+	agora := time.Now()
+
+	// 1. Access token (JWT HS256)
+	jti, err := tokenAleatorio(16)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Issuer:    jwtIssuer,
+		Subject:   strconv.FormatInt(usuarioID, 10),
+		ID:        jti,
+		IssuedAt:  jwt.NewNumericDate(agora),
+		ExpiresAt: jwt.NewNumericDate(agora.Add(accessTokenTTL)),
+	}).SignedString(s.jwtSecret)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao assinar access token: %w", err)
+	}
+
+	// 2. Refresh token (persistido apenas como hash)
+	refreshToken, err := tokenAleatorio(32)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenDAO.Create(ctx, usuarioID, hashToken(refreshToken), agora.Add(refreshTokenTTL)); err != nil {
+		return nil, err
+	}
+
+	return &model.TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(accessTokenTTL.Seconds()),
+	}, nil
+}
+
+// hashSenha gera o hash bcrypt de uma senha.
+func hashSenha(senha string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(senha), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("erro ao gerar hash da senha: %w", err)
+	}
+	return string(hash), nil
+}
+
+// senhaConfere indica se a senha corresponde ao hash bcrypt armazenado.
+func senhaConfere(senhaHash, senha string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(senhaHash), []byte(senha)) == nil
+}
+
+// tokenAleatorio gera n bytes aleatórios codificados em base64 URL-safe.
+func tokenAleatorio(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("erro ao gerar token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken retorna o SHA-256 (hex) do refresh token, que é o valor persistido.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
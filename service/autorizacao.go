@@ -0,0 +1,50 @@
+package service
+
+import (
+	"upskilling-api/model"
+)
+
+// autorizarUsuario verifica se o ator pode acessar os dados do usuário informado: o próprio
+// usuário sempre pode; terceiros precisam da permissão indicada (o admin possui todas).
+func autorizarUsuario(ator *model.Usuario, usuarioID int64, permissao model.Permissao) error {
+	if ator == nil {
+		return &model.UnauthorizedError{Chave: "auth.nao_autenticado"}
+	}
+	if ator.ID == usuarioID || ator.TemPermissao(permissao) {
+		return nil
+	}
+	return &model.ForbiddenError{Chave: "acesso.dados_de_outro_usuario", Args: []any{ator.ID, usuarioID}}
+}
+
+// autorizarCatalogoPublico verifica se o ator pode alterar o catálogo compartilhado entre
+// as organizações (competências e trilhas públicas): apenas curadores da plataforma.
+func autorizarCatalogoPublico(ator *model.Usuario) error {
+	if ator == nil {
+		return &model.UnauthorizedError{Chave: "auth.nao_autenticado"}
+	}
+	if !ator.OrganizacaoPlataforma || !ator.TemPermissao(model.PermGerenciarCatalogo) {
+		return &model.ForbiddenError{Chave: "acesso.catalogo_publico"}
+	}
+	return nil
+}
+
+// autorizarAdminPlataforma verifica se o ator pode registrar novas organizações: apenas
+// administradores da organização da plataforma.
+func autorizarAdminPlataforma(ator *model.Usuario) error {
+	if ator == nil {
+		return &model.UnauthorizedError{Chave: "auth.nao_autenticado"}
+	}
+	if !ator.OrganizacaoPlataforma || !ator.TemPermissao(model.PermGerenciarUsuarios) {
+		return &model.ForbiddenError{Chave: "acesso.registrar_organizacao"}
+	}
+	return nil
+}
+
+// autorizarUsuarioOuGestor estende autorizarUsuario ao gestor direto do usuário, que
+// também pode acessar os dados de desenvolvimento dos liderados.
+func autorizarUsuarioOuGestor(ator, usuario *model.Usuario, permissao model.Permissao) error {
+	if ator != nil && usuario.GestorID != nil && *usuario.GestorID == ator.ID {
+		return nil
+	}
+	return autorizarUsuario(ator, usuario.ID, permissao)
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// tamanhoMaximoBusca limita o termo de busca, evitando consultas desnecessariamente caras.
+const tamanhoMaximoBusca = 200
+
+// BuscaService é a interface para a busca textual no catálogo.
+type BuscaService interface {
+	Buscar(ctx context.Context, ator *model.Usuario, termo, tipo string, params model.ListParams) ([]model.ResultadoBusca, *model.Pagina, error)
+}
+
+// buscaServiceImpl implementa a interface BuscaService.
+type buscaServiceImpl struct {
+	dao dao.BuscaDAO
+}
+
+// NewBuscaService cria uma nova instância de BuscaService.
+func NewBuscaService(buscaDAO dao.BuscaDAO) BuscaService {
+	return &buscaServiceImpl{
+		dao: buscaDAO,
+	}
+}
+
+// Buscar pesquisa trilhas e competências pelo termo informado. O tipo, quando informado,
+// restringe a busca a "trilha" ou "competencia". Os resultados vêm ordenados por relevância
+// e incluem apenas as trilhas visíveis para a organização do ator.
+func (s *buscaServiceImpl) Buscar(ctx context.Context, ator *model.Usuario, termo, tipo string, params model.ListParams) ([]model.ResultadoBusca, *model.Pagina, error) {
+	// 1. Validação do termo e do tipo
+	termo = strings.TrimSpace(termo)
+	if termo == "" {
+		return nil, nil, &model.InvalidParameterError{Param: "q", Chave: "parametro.busca_termo_ausente"}
+	}
+	if len(termo) > tamanhoMaximoBusca {
+		return nil, nil, &model.InvalidParameterError{Param: "q", Chave: "parametro.busca_termo_longo", Args: []any{tamanhoMaximoBusca}}
+	}
+
+	var tipos []string
+	switch strings.ToLower(strings.TrimSpace(tipo)) {
+	case "":
+		tipos = []string{model.TipoBuscaTrilha, model.TipoBuscaCompetencia}
+	case model.TipoBuscaTrilha:
+		tipos = []string{model.TipoBuscaTrilha}
+	case model.TipoBuscaCompetencia:
+		tipos = []string{model.TipoBuscaCompetencia}
+	default:
+		return nil, nil, &model.InvalidParameterError{Param: "tipo", Chave: "parametro.busca_tipo"}
+	}
+
+	// 2. A ordenação é sempre por relevância; cursor não se aplica
+	if params.Sort != "" {
+		return nil, nil, &model.InvalidParameterError{Param: "sort", Chave: "parametro.busca_sort"}
+	}
+	if params.Cursor != "" {
+		return nil, nil, &model.InvalidParameterError{Param: "cursor", Chave: "parametro.busca_cursor"}
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = model.DefaultPageLimit
+	}
+	if limit > model.MaxPageLimit {
+		limit = model.MaxPageLimit
+	}
+
+	// 3. Consulta
+	resultados, total, err := s.dao.Buscar(ctx, ator.OrganizacaoID, termo, tipos, limit, params.Offset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultados, &model.Pagina{Total: total, Limit: limit, Offset: params.Offset}, nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// CargoService é a interface para as operações de negócio de Cargo-alvo.
+// Como nas trilhas, o ator enxerga o catálogo público e os cargos da própria
+// organização; os cargos públicos só podem ser alterados por curadores da plataforma.
+type CargoService interface {
+	Create(ctx context.Context, ator *model.Usuario, req *model.CreateCargoRequest) (*model.CargoResponse, error)
+	FindByID(ctx context.Context, ator *model.Usuario, id int64) (*model.CargoResponse, error)
+	FindAll(ctx context.Context, ator *model.Usuario, params model.ListParams) ([]model.CargoResponse, *model.Pagina, error)
+	Update(ctx context.Context, ator *model.Usuario, id int64, req *model.UpdateCargoRequest) (*model.CargoResponse, error)
+	Delete(ctx context.Context, ator *model.Usuario, id int64) error
+	SetCompetencias(ctx context.Context, ator *model.Usuario, id int64, req *model.SetCargoCompetenciasRequest) (*model.CargoResponse, error)
+}
+
+// cargoServiceImpl implementa a interface CargoService.
+type cargoServiceImpl struct {
+	tx             dao.Transacionador
+	dao            dao.CargoDAO
+	competenciaDAO dao.CompetenciaDAO
+}
+
+// NewCargoService cria uma nova instância de CargoService.
+func NewCargoService(tx dao.Transacionador, cargoDAO dao.CargoDAO, competenciaDAO dao.CompetenciaDAO) CargoService {
+	return &cargoServiceImpl{
+		tx:             tx,
+		dao:            cargoDAO,
+		competenciaDAO: competenciaDAO,
+	}
+}
+
+// Create cria um novo cargo-alvo, privado da organização do ator ou, quando publica,
+// no catálogo público (apenas curadores da plataforma).
+func (s *cargoServiceImpl) Create(ctx context.Context, ator *model.Usuario, req *model.CreateCargoRequest) (*model.CargoResponse, error) {
+	// 1. Mapeamento DTO para Entidade
+	cargo := &model.Cargo{
+		Nome:      req.Nome,
+		Descricao: req.Descricao,
+	}
+	if req.Publica {
+		if err := autorizarCatalogoPublico(ator); err != nil {
+			return nil, err
+		}
+	} else {
+		organizacaoID := ator.OrganizacaoID
+		cargo.OrganizacaoID = &organizacaoID
+	}
+
+	// 2. Validação de Negócio: competências exigidas únicas e existentes
+	niveis, err := niveisCompetencias(ctx, s.competenciaDAO, req.Competencias)
+	if err != nil {
+		return nil, err
+	}
+	for competenciaID, nivel := range niveis {
+		cargo.Competencias = append(cargo.Competencias, model.CompetenciaCargo{CompetenciaID: competenciaID, NivelMinimo: nivel})
+	}
+
+	// 3. Persistência (cargo + competências exigidas em uma única transação)
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		return s.dao.Create(ctx, cargo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Recarrega com os nomes das competências
+	return s.FindByID(ctx, ator, cargo.ID)
+}
+
+// FindByID busca um cargo-alvo pelo ID, com as competências exigidas.
+func (s *cargoServiceImpl) FindByID(ctx context.Context, ator *model.Usuario, id int64) (*model.CargoResponse, error) {
+	cargo, err := s.dao.FindByID(ctx, ator.OrganizacaoID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toCargoResponse(cargo), nil
+}
+
+// FindAll busca uma página dos cargos-alvo visíveis para o ator.
+func (s *cargoServiceImpl) FindAll(ctx context.Context, ator *model.Usuario, params model.ListParams) ([]model.CargoResponse, *model.Pagina, error) {
+	cargos, pagina, err := s.dao.FindAll(ctx, ator.OrganizacaoID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responses := make([]model.CargoResponse, len(cargos))
+	for i := range cargos {
+		responses[i] = *toCargoResponse(&cargos[i])
+	}
+	return responses, pagina, nil
+}
+
+// Update atualiza o nome e a descrição de um cargo-alvo.
+func (s *cargoServiceImpl) Update(ctx context.Context, ator *model.Usuario, id int64, req *model.UpdateCargoRequest) (*model.CargoResponse, error) {
+	// 1. Buscar o cargo existente
+	cargo, err := s.findCargoEditavel(ctx, ator, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aplicar as atualizações (apenas campos fornecidos)
+	if req.Nome != "" {
+		cargo.Nome = req.Nome
+	}
+	if req.Descricao != "" {
+		cargo.Descricao = req.Descricao
+	}
+
+	// 3. Persistência
+	if err := s.dao.Update(ctx, ator.OrganizacaoID, cargo); err != nil {
+		return nil, err
+	}
+
+	return toCargoResponse(cargo), nil
+}
+
+// Delete remove um cargo-alvo pelo ID.
+func (s *cargoServiceImpl) Delete(ctx context.Context, ator *model.Usuario, id int64) error {
+	if _, err := s.findCargoEditavel(ctx, ator, id); err != nil {
+		return err
+	}
+	return s.dao.Delete(ctx, ator.OrganizacaoID, id)
+}
+
+// SetCompetencias substitui as competências exigidas pelo cargo e seus níveis mínimos.
+func (s *cargoServiceImpl) SetCompetencias(ctx context.Context, ator *model.Usuario, id int64, req *model.SetCargoCompetenciasRequest) (*model.CargoResponse, error) {
+	// 1. Validação de Existência e Autorização: Cargo
+	if _, err := s.findCargoEditavel(ctx, ator, id); err != nil {
+		return nil, err
+	}
+
+	// 2. Validação de Negócio: competências únicas e existentes
+	niveis, err := niveisCompetencias(ctx, s.competenciaDAO, req.Competencias)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Persistência
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		return s.dao.ReplaceCompetencias(ctx, id, niveis)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.FindByID(ctx, ator, id)
+}
+
+// findCargoEditavel busca um cargo visível para o ator e verifica se ele pode alterá-lo:
+// cargos privados pela própria organização, cargos públicos apenas pela plataforma.
+func (s *cargoServiceImpl) findCargoEditavel(ctx context.Context, ator *model.Usuario, id int64) (*model.Cargo, error) {
+	cargo, err := s.dao.FindByID(ctx, ator.OrganizacaoID, id)
+	if err != nil {
+		return nil, err
+	}
+	if cargo.Publica() {
+		if err := autorizarCatalogoPublico(ator); err != nil {
+			return nil, err
+		}
+	}
+	return cargo, nil
+}
+
+// toCargoResponse mapeia a entidade Cargo para o DTO de resposta.
+func toCargoResponse(c *model.Cargo) *model.CargoResponse {
+	return &model.CargoResponse{
+		ID:            c.ID,
+		Nome:          c.Nome,
+		Descricao:     c.Descricao,
+		Publica:       c.Publica(),
+		OrganizacaoID: c.OrganizacaoID,
+		Competencias:  c.Competencias,
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// CompetenciaService é a interface para as operações de negócio de Competência.
+// As competências são compartilhadas por todas as organizações; só a plataforma as altera.
+type CompetenciaService interface {
+	Create(ctx context.Context, ator *model.Usuario, req *model.CreateCompetenciaRequest) (*model.CompetenciaResponse, error)
+	FindByID(ctx context.Context, id int64) (*model.CompetenciaResponse, error)
+	FindAll(ctx context.Context, params model.ListParams) ([]model.CompetenciaResponse, *model.Pagina, error)
+	Update(ctx context.Context, ator *model.Usuario, id int64, req *model.UpdateCompetenciaRequest) (*model.CompetenciaResponse, error)
+	Delete(ctx context.Context, ator *model.Usuario, id int64) error
+	GetTrilhas(ctx context.Context, ator *model.Usuario, competenciaID int64) ([]model.TrilhaResponse, error)
+}
+
+// competenciaServiceImpl implementa a interface CompetenciaService.
+type competenciaServiceImpl struct {
+	dao                  dao.CompetenciaDAO
+	trilhaCompetenciaDAO dao.TrilhaCompetenciaDAO
+}
+
+// NewCompetenciaService cria uma nova instância de CompetenciaService.
+func NewCompetenciaService(competenciaDAO dao.CompetenciaDAO, trilhaCompetenciaDAO dao.TrilhaCompetenciaDAO) CompetenciaService {
+	return &competenciaServiceImpl{
+		dao:                  competenciaDAO,
+		trilhaCompetenciaDAO: trilhaCompetenciaDAO,
+	}
+}
+
+// Create cria uma nova competência.
+func (s *competenciaServiceImpl) Create(ctx context.Context, ator *model.Usuario, req *model.CreateCompetenciaRequest) (*model.CompetenciaResponse, error) {
+	// 0. Autorização
+	if err := autorizarCatalogoPublico(ator); err != nil {
+		return nil, err
+	}
+
+	// 1. Mapeamento DTO para Entidade
+	competencia := &model.Competencia{
+		Nome:      req.Nome,
+		Categoria: req.Categoria,
+		Descricao: req.Descricao,
+	}
+
+	// 2. Persistência
+	if err := s.dao.Create(ctx, competencia); err != nil {
+		return nil, err
+	}
+
+	// 3. Mapeamento Entidade para Response DTO
+	return toCompetenciaResponse(competencia), nil
+}
+
+// FindByID busca uma competência pelo ID.
+func (s *competenciaServiceImpl) FindByID(ctx context.Context, id int64) (*model.CompetenciaResponse, error) {
+	competencia, err := s.dao.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCompetenciaResponse(competencia), nil
+}
+
+// FindAll busca uma página de competências (filtro opcional por categoria).
+func (s *competenciaServiceImpl) FindAll(ctx context.Context, params model.ListParams) ([]model.CompetenciaResponse, *model.Pagina, error) {
+	competencias, pagina, err := s.dao.FindAll(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return toCompetenciaResponses(competencias), pagina, nil
+}
+
+// Update atualiza uma competência existente.
+func (s *competenciaServiceImpl) Update(ctx context.Context, ator *model.Usuario, id int64, req *model.UpdateCompetenciaRequest) (*model.CompetenciaResponse, error) {
+	// 0. Autorização
+	if err := autorizarCatalogoPublico(ator); err != nil {
+		return nil, err
+	}
+
+	// 1. Buscar a competência existente
+	competencia, err := s.dao.FindByID(ctx, id)
+	if err != nil {
+		return nil, err // Trata ResourceNotFoundError
+	}
+
+	// 2. Aplicar as atualizações (apenas campos fornecidos)
+	if req.Nome != "" {
+		competencia.Nome = req.Nome
+	}
+	if req.Categoria != "" {
+		competencia.Categoria = req.Categoria
+	}
+	if req.Descricao != "" {
+		competencia.Descricao = req.Descricao
+	}
+
+	// 3. Persistência
+	if err := s.dao.Update(ctx, competencia); err != nil {
+		return nil, err
+	}
+
+	// 4. Mapeamento Entidade para Response DTO
+	return toCompetenciaResponse(competencia), nil
+}
+
+// Delete remove uma competência pelo ID.
+func (s *competenciaServiceImpl) Delete(ctx context.Context, ator *model.Usuario, id int64) error {
+	if err := autorizarCatalogoPublico(ator); err != nil {
+		return err
+	}
+	return s.dao.Delete(ctx, id)
+}
+
+// GetTrilhas lista as trilhas visíveis para a organização do ator que desenvolvem uma competência.
+func (s *competenciaServiceImpl) GetTrilhas(ctx context.Context, ator *model.Usuario, competenciaID int64) ([]model.TrilhaResponse, error) {
+	// 1. Validação de Existência: Competência
+	if _, err := s.dao.FindByID(ctx, competenciaID); err != nil {
+		return nil, err
+	}
+
+	// 2. Busca no DAO
+	trilhas, err := s.trilhaCompetenciaDAO.FindTrilhasByCompetenciaID(ctx, ator.OrganizacaoID, competenciaID)
+	if err != nil {
+		return nil, err
+	}
+	return toTrilhaResponses(trilhas), nil
+}
+
+// toCompetenciaResponse mapeia a entidade Competencia para o DTO de resposta.
+func toCompetenciaResponse(c *model.Competencia) *model.CompetenciaResponse {
+	return &model.CompetenciaResponse{
+		ID:        c.ID,
+		Nome:      c.Nome,
+		Categoria: c.Categoria,
+		Descricao: c.Descricao,
+	}
+}
+
+// toCompetenciaResponses mapeia uma lista de competências para DTOs de resposta.
+func toCompetenciaResponses(competencias []model.Competencia) []model.CompetenciaResponse {
+	responses := make([]model.CompetenciaResponse, len(competencias))
+	for i := range competencias {
+		responses[i] = *toCompetenciaResponse(&competencias[i])
+	}
+	return responses
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// GapCompetenciasService é a interface para a análise de lacunas de competências entre
+// o perfil do usuário e um cargo-alvo.
+type GapCompetenciasService interface {
+	Analisar(ctx context.Context, ator *model.Usuario, usuarioID int64, cargo string) (*model.GapCompetenciasResponse, error)
+}
+
+// gapCompetenciasServiceImpl implementa a interface GapCompetenciasService.
+type gapCompetenciasServiceImpl struct {
+	dao        dao.GapCompetenciasDAO
+	cargoDAO   dao.CargoDAO
+	usuarioDAO dao.UsuarioDAO
+}
+
+// NewGapCompetenciasService cria uma nova instância de GapCompetenciasService.
+func NewGapCompetenciasService(gapCompetenciasDAO dao.GapCompetenciasDAO, cargoDAO dao.CargoDAO, usuarioDAO dao.UsuarioDAO) GapCompetenciasService {
+	return &gapCompetenciasServiceImpl{
+		dao:        gapCompetenciasDAO,
+		cargoDAO:   cargoDAO,
+		usuarioDAO: usuarioDAO,
+	}
+}
+
+// Analisar compara o nível efetivo de cada competência do usuário com o mínimo exigido
+// pelo cargo informado (ID) e lista as lacunas com as trilhas que as desenvolvem. Para
+// cada lacuna é recomendada uma trilha — a de menor carga horária entre as que atingem
+// o nível requerido, reaproveitando trilhas já recomendadas para outras lacunas — e a
+// carga horária total soma as trilhas recomendadas, sem repetição. O próprio usuário,
+// seu gestor direto e os administradores podem consultá-la.
+func (s *gapCompetenciasServiceImpl) Analisar(ctx context.Context, ator *model.Usuario, usuarioID int64, cargo string) (*model.GapCompetenciasResponse, error) {
+	// 0. Validação do parâmetro cargo
+	if cargo == "" {
+		return nil, &model.InvalidParameterError{Param: "cargo", Chave: "parametro.cargo_ausente"}
+	}
+	cargoID, err := strconv.ParseInt(cargo, 10, 64)
+	if err != nil || cargoID <= 0 {
+		return nil, &model.InvalidParameterError{Param: "cargo", Chave: "parametro.cargo_invalido"}
+	}
+
+	// 1. Autorização e existência do usuário e do cargo
+	usuario, err := s.usuarioDAO.FindByID(ctx, ator.OrganizacaoID, usuarioID)
+	if err != nil {
+		return nil, err
+	}
+	if err := autorizarUsuarioOuGestor(ator, usuario, model.PermGerenciarUsuarios); err != nil {
+		return nil, err
+	}
+	cargoAlvo, err := s.cargoDAO.FindByID(ctx, ator.OrganizacaoID, cargoID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Comparação do perfil com as competências exigidas
+	exigidas, err := s.dao.CompararPerfil(ctx, cargoID, usuarioID)
+	if err != nil {
+		return nil, err
+	}
+	gap := &model.GapCompetenciasResponse{
+		UsuarioID:         usuarioID,
+		CargoID:           cargoID,
+		CargoNome:         cargoAlvo.Nome,
+		TotalCompetencias: len(exigidas),
+		Lacunas:           make([]model.LacunaCompetencia, 0),
+	}
+	lacunaIDs := make([]int64, 0)
+	for _, c := range exigidas {
+		if c.NivelAtual >= c.NivelRequerido {
+			gap.CompetenciasAtendidas++
+			continue
+		}
+		gap.Lacunas = append(gap.Lacunas, c)
+		lacunaIDs = append(lacunaIDs, c.CompetenciaID)
+	}
+	gap.Aderencia = taxaConclusao(gap.CompetenciasAtendidas, gap.TotalCompetencias)
+
+	// 3. Trilhas que fecham cada lacuna e carga horária estimada
+	trilhas, err := s.dao.FindTrilhasPorCompetencias(ctx, ator.OrganizacaoID, usuarioID, lacunaIDs)
+	if err != nil {
+		return nil, err
+	}
+	recomendadas := make(map[int64]bool)
+	for i := range gap.Lacunas {
+		lacuna := &gap.Lacunas[i]
+		lacuna.Trilhas = trilhas[lacuna.CompetenciaID]
+		if lacuna.Trilhas == nil {
+			lacuna.Trilhas = make([]model.TrilhaLacuna, 0)
+		}
+		for j := range lacuna.Trilhas {
+			lacuna.Trilhas[j].FechaLacuna = lacuna.Trilhas[j].NivelConcedido >= lacuna.NivelRequerido
+		}
+
+		escolhida := recomendarTrilha(lacuna.Trilhas, recomendadas)
+		if escolhida < 0 {
+			continue
+		}
+		t := &lacuna.Trilhas[escolhida]
+		t.Recomendada = true
+		if !recomendadas[t.TrilhaID] {
+			recomendadas[t.TrilhaID] = true
+			gap.CargaHorariaTotal += t.CargaHoraria
+		}
+	}
+
+	return gap, nil
+}
+
+// recomendarTrilha escolhe a trilha recomendada para uma lacuna (índice em trilhas, ou
+// -1 se não houver trilhas). São candidatas as trilhas que fecham a lacuna ou, se
+// nenhuma fecha, as que concedem o maior nível. Entre as candidatas, prefere uma já
+// recomendada para outra lacuna e, em seguida, a de menor carga horária.
+func recomendarTrilha(trilhas []model.TrilhaLacuna, recomendadas map[int64]bool) int {
+	maiorNivel := 0
+	for _, t := range trilhas {
+		if t.NivelConcedido > maiorNivel {
+			maiorNivel = t.NivelConcedido
+		}
+	}
+
+	escolhida := -1
+	for i, t := range trilhas {
+		if !t.FechaLacuna && t.NivelConcedido < maiorNivel {
+			continue
+		}
+		if escolhida < 0 {
+			escolhida = i
+			continue
+		}
+		atual := trilhas[escolhida]
+		switch {
+		case recomendadas[t.TrilhaID] != recomendadas[atual.TrilhaID]:
+			if recomendadas[t.TrilhaID] {
+				escolhida = i
+			}
+		case t.CargaHoraria < atual.CargaHoraria:
+			escolhida = i
+		}
+	}
+	return escolhida
+}
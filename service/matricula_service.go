@@ -1,86 +1,484 @@
 package service
 
 import (
-	"fmt"
+	"context"
+	"math"
+	"time"
 
 	"upskilling-api/dao"
 	"upskilling-api/model"
 )
 
 // MatriculaService é a interface para as operações de negócio de Matrícula.
+// Todas as operações recebem o usuário autenticado (ator): learners só acessam as
+// próprias matrículas; terceiros precisam de PermGerenciarMatriculas.
 type MatriculaService interface {
-	Matricular(usuarioID, trilhaID int64) (*model.Matricula, error)
-	GetMatriculasByUsuario(usuarioID int64) ([]model.Matricula, error)
+	Matricular(ctx context.Context, ator *model.Usuario, usuarioID, trilhaID int64, dataPrazo *time.Time) (*model.Matricula, error)
+	GetMatriculasByUsuario(ctx context.Context, ator *model.Usuario, usuarioID int64, params model.ListParams) ([]model.Matricula, *model.Pagina, error)
+	FindByID(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error)
+	Concluir(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error)
+	Cancelar(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error)
+	Reativar(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error)
+	RegistrarSessao(ctx context.Context, ator *model.Usuario, matriculaID int64, req *model.RegistrarSessaoRequest) (*model.ProgressoResponse, error)
+	GetSessoes(ctx context.Context, ator *model.Usuario, matriculaID int64) ([]model.SessaoEstudo, error)
+	GetProgresso(ctx context.Context, ator *model.Usuario, matriculaID int64) (*model.ProgressoResponse, error)
+	ConcluirAula(ctx context.Context, ator *model.Usuario, matriculaID, aulaID int64) (*model.ProgressoResponse, error)
+	VerificarElegibilidade(ctx context.Context, ator *model.Usuario, usuarioID, trilhaID int64) (*model.ElegibilidadeResponse, error)
+}
+
+// transicoesMatricula define a máquina de estados da matrícula: para cada status
+// de origem, os status de destino permitidos. CONCLUIDA é um estado final.
+var transicoesMatricula = map[string][]string{
+	model.StatusMatriculaAtiva:     {model.StatusMatriculaConcluida, model.StatusMatriculaCancelada},
+	model.StatusMatriculaCancelada: {model.StatusMatriculaAtiva},
+	model.StatusMatriculaConcluida: {},
 }
 
 // matriculaServiceImpl implementa a interface MatriculaService.
 type matriculaServiceImpl struct {
-	matriculaDAO dao.MatriculaDAO
-	usuarioDAO   dao.UsuarioDAO
-	trilhaDAO    dao.TrilhaDAO
+	tx              dao.Transacionador
+	matriculaDAO    dao.MatriculaDAO
+	usuarioDAO      dao.UsuarioDAO
+	trilhaDAO       dao.TrilhaDAO
+	sessaoEstudoDAO dao.SessaoEstudoDAO
+	aulaDAO         dao.AulaDAO
+	requisitoDAO    dao.RequisitoDAO
 }
 
 // NewMatriculaService cria uma nova instância de MatriculaService.
-func NewMatriculaService() MatriculaService {
+func NewMatriculaService(tx dao.Transacionador, matriculaDAO dao.MatriculaDAO, usuarioDAO dao.UsuarioDAO, trilhaDAO dao.TrilhaDAO, sessaoEstudoDAO dao.SessaoEstudoDAO, aulaDAO dao.AulaDAO, requisitoDAO dao.RequisitoDAO) MatriculaService {
 	return &matriculaServiceImpl{
-		matriculaDAO: dao.NewMatriculaDAO(),
-		usuarioDAO:   dao.NewUsuarioDAO(),
-		trilhaDAO:    dao.NewTrilhaDAO(),
+		tx:              tx,
+		matriculaDAO:    matriculaDAO,
+		usuarioDAO:      usuarioDAO,
+		trilhaDAO:       trilhaDAO,
+		sessaoEstudoDAO: sessaoEstudoDAO,
+		aulaDAO:         aulaDAO,
+		requisitoDAO:    requisitoDAO,
 	}
 }
 
 // Matricular realiza a inscrição de um usuário em uma trilha.
-func (s *matriculaServiceImpl) Matricular(usuarioID, trilhaID int64) (*model.Matricula, error) {
+// Antes da inscrição, os requisitos da trilha (trilhas pré-requisito concluídas, nível
+// de carreira mínimo e competências requeridas) são avaliados; se algum não for
+// atendido, retorna BusinessRuleError (422) listando todos eles.
+// A avaliação dos requisitos, a verificação de existência, a checagem de duplicidade e
+// a inserção acontecem em uma única transação; uma segunda matrícula ATIVA para o mesmo par
+// usuário/trilha resulta em ConflictError (409). O prazo, quando informado, não pode
+// estar no passado; matrículas ATIVAS após o prazo aparecem como atrasadas no painel do gestor.
+func (s *matriculaServiceImpl) Matricular(ctx context.Context, ator *model.Usuario, usuarioID, trilhaID int64, dataPrazo *time.Time) (*model.Matricula, error) {
+	// 0. Autorização: learners só podem matricular a si mesmos
+	if err := autorizarUsuario(ator, usuarioID, model.PermGerenciarMatriculas); err != nil {
+		return nil, err
+	}
+	if dataPrazo != nil {
+		ano, mes, dia := time.Now().Date()
+		if dataPrazo.Before(time.Date(ano, mes, dia, 0, 0, 0, 0, dataPrazo.Location())) {
+			return nil, &model.BusinessRuleError{Chave: "matricula.prazo_passado"}
+		}
+	}
+
+	matricula := &model.Matricula{
+		UsuarioID: usuarioID,
+		TrilhaID:  trilhaID,
+		Status:    model.StatusMatriculaAtiva,
+		DataPrazo: dataPrazo,
+	}
+	err := WithTx(ctx, s.tx, func(ctx context.Context) error {
+		// 1. Validação de Elegibilidade
+		elegibilidade, err := s.elegibilidade(ctx, ator.OrganizacaoID, usuarioID, trilhaID)
+		if err != nil {
+			return err
+		}
+		if !elegibilidade.Elegivel {
+			return &model.BusinessRuleError{
+				Chave:     "matricula.nao_elegivel",
+				Args:      []any{usuarioID, trilhaID},
+				Violacoes: elegibilidade.Violacoes,
+				Codigo:    model.CodigoRequisitosNaoAtendidos,
+			}
+		}
+
+		// 2. Criação da Matrícula
+		if err := s.matriculaDAO.CreateAtiva(ctx, ator.OrganizacaoID, matricula); err != nil {
+			return matriculaNotFoundAsBusinessRule(err, usuarioID, trilhaID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matricula, nil
+}
+
+// VerificarElegibilidade avalia todos os requisitos da trilha para o usuário e devolve
+// a lista completa de requisitos não atendidos.
+func (s *matriculaServiceImpl) VerificarElegibilidade(ctx context.Context, ator *model.Usuario, usuarioID, trilhaID int64) (*model.ElegibilidadeResponse, error) {
+	if err := autorizarUsuario(ator, usuarioID, model.PermGerenciarMatriculas); err != nil {
+		return nil, err
+	}
+	return s.elegibilidade(ctx, ator.OrganizacaoID, usuarioID, trilhaID)
+}
+
+// elegibilidade implementa VerificarElegibilidade, sem a checagem de autorização. Usuário
+// e trilha precisam pertencer (ou ser visíveis) à organização informada.
+func (s *matriculaServiceImpl) elegibilidade(ctx context.Context, organizacaoID, usuarioID, trilhaID int64) (*model.ElegibilidadeResponse, error) {
+	// 1. Validação de Existência: Usuário e Trilha
+	usuario, err := s.usuarioDAO.FindByID(ctx, organizacaoID, usuarioID)
+	if err != nil {
+		return nil, matriculaNotFoundAsBusinessRule(err, usuarioID, trilhaID)
+	}
+	requisitos, err := s.requisitoDAO.FindByTrilhaID(ctx, organizacaoID, trilhaID)
+	if err != nil {
+		return nil, matriculaNotFoundAsBusinessRule(err, usuarioID, trilhaID)
+	}
+
+	naoAtendidos := make([]model.Violacao, 0)
+
+	// 2. Nível de carreira mínimo
+	if requisitos.NivelCarreiraMinimo != "" {
+		minimo := model.RankNivelCarreira(requisitos.NivelCarreiraMinimo)
+		if model.RankNivelCarreira(usuario.NivelCarreira) < minimo {
+			violacao := model.Violacao{
+				Chave: "elegibilidade.nivel_carreira",
+				Args:  []any{requisitos.NivelCarreiraMinimo, usuario.NivelCarreira},
+			}
+			if usuario.NivelCarreira == "" {
+				violacao = model.Violacao{
+					Chave: "elegibilidade.nivel_carreira_nao_informado",
+					Args:  []any{requisitos.NivelCarreiraMinimo},
+				}
+			}
+			naoAtendidos = append(naoAtendidos, violacao)
+		}
+	}
+
+	// 3. Trilhas pré-requisito concluídas
+	if len(requisitos.TrilhasPrerequisito) > 0 {
+		concluidas, err := s.requisitoDAO.FindTrilhasConcluidas(ctx, usuarioID)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range requisitos.TrilhasPrerequisito {
+			if !concluidas[t.ID] {
+				naoAtendidos = append(naoAtendidos, model.Violacao{Chave: "elegibilidade.trilha_prerequisito", Args: []any{t.Nome, t.ID}})
+			}
+		}
+	}
+
+	// 4. Competências requeridas
+	if len(requisitos.CompetenciasRequeridas) > 0 {
+		adquiridas, err := s.requisitoDAO.FindCompetenciasAdquiridas(ctx, usuarioID)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range requisitos.CompetenciasRequeridas {
+			if !adquiridas[c.ID] {
+				naoAtendidos = append(naoAtendidos, model.Violacao{Chave: "elegibilidade.competencia", Args: []any{c.Nome, c.ID}})
+			}
+		}
+	}
+
+	return &model.ElegibilidadeResponse{
+		UsuarioID: usuarioID,
+		TrilhaID:  trilhaID,
+		Elegivel:  len(naoAtendidos) == 0,
+		Violacoes: naoAtendidos,
+	}, nil
+}
+
+// matriculaNotFoundAsBusinessRule converte usuário ou trilha inexistente em violação
+// de regra de negócio da inscrição, preservando os demais erros.
+func matriculaNotFoundAsBusinessRule(err error, usuarioID, trilhaID int64) error {
+	if notFound, ok := err.(*model.ResourceNotFoundError); ok {
+		switch notFound.Resource {
+		case "Usuário":
+			return &model.BusinessRuleError{Chave: "matricula.usuario_inexistente", Args: []any{usuarioID}}
+		case "Trilha":
+			return &model.BusinessRuleError{Chave: "matricula.trilha_inexistente", Args: []any{trilhaID}}
+		}
+	}
+	return err
+}
+
+// GetMatriculasByUsuario busca uma página das matrículas de um usuário.
+func (s *matriculaServiceImpl) GetMatriculasByUsuario(ctx context.Context, ator *model.Usuario, usuarioID int64, params model.ListParams) ([]model.Matricula, *model.Pagina, error) {
+	// 0. Autorização
+	if err := autorizarUsuario(ator, usuarioID, model.PermGerenciarMatriculas); err != nil {
+		return nil, nil, err
+	}
+
 	// 1. Validação de Existência: Usuário
-	_, err := s.usuarioDAO.FindByID(usuarioID)
+	_, err := s.usuarioDAO.FindByID(ctx, ator.OrganizacaoID, usuarioID)
 	if err != nil {
 		// Se for ResourceNotFoundError, retorna o erro
 		if _, ok := err.(*model.ResourceNotFoundError); ok {
-			return nil, &model.BusinessRuleError{Msg: fmt.Sprintf("Usuário com ID %d não encontrado.", usuarioID)}
+			return nil, nil, &model.BusinessRuleError{Chave: "matricula.usuario_inexistente", Args: []any{usuarioID}}
 		}
+		return nil, nil, err
+	}
+
+	// 2. Busca no DAO
+	return s.matriculaDAO.FindByUsuarioID(ctx, ator.OrganizacaoID, usuarioID, params)
+}
+
+// FindByID busca uma matrícula pelo ID.
+func (s *matriculaServiceImpl) FindByID(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error) {
+	return s.findMatricula(ctx, ator, id)
+}
+
+// findMatricula busca a matrícula na organização do ator e verifica se ele pode
+// acessá-la (dono ou PermGerenciarMatriculas).
+func (s *matriculaServiceImpl) findMatricula(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error) {
+	matricula, err := s.matriculaDAO.FindByID(ctx, ator.OrganizacaoID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := autorizarUsuario(ator, matricula.UsuarioID, model.PermGerenciarMatriculas); err != nil {
 		return nil, err
 	}
+	return matricula, nil
+}
+
+// Concluir marca uma matrícula ATIVA como CONCLUIDA, registrando a data de conclusão.
+// A conclusão manual dispensa a carga horária e concede as competências da trilha como
+// verificadas, por isso exige o gestor direto do usuário ou a permissão de gerenciar
+// matrículas; o próprio usuário conclui a matrícula estudando (sessões e aulas).
+func (s *matriculaServiceImpl) Concluir(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error) {
+	return s.transicionar(ctx, ator, id, model.StatusMatriculaConcluida)
+}
+
+// Cancelar marca uma matrícula ATIVA como CANCELADA, registrando a data de cancelamento.
+func (s *matriculaServiceImpl) Cancelar(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error) {
+	return s.transicionar(ctx, ator, id, model.StatusMatriculaCancelada)
+}
+
+// Reativar retorna uma matrícula CANCELADA ao status ATIVA.
+func (s *matriculaServiceImpl) Reativar(ctx context.Context, ator *model.Usuario, id int64) (*model.Matricula, error) {
+	return s.transicionar(ctx, ator, id, model.StatusMatriculaAtiva)
+}
 
-	// 2. Validação de Existência: Trilha
-	_, err = s.trilhaDAO.FindByID(trilhaID)
+// RegistrarSessao registra horas de estudo em uma matrícula ATIVA e devolve o progresso
+// atualizado. Ao atingir a carga horária da trilha, a matrícula é concluída automaticamente.
+func (s *matriculaServiceImpl) RegistrarSessao(ctx context.Context, ator *model.Usuario, matriculaID int64, req *model.RegistrarSessaoRequest) (*model.ProgressoResponse, error) {
+	// 1. Buscar a matrícula e a trilha (para a carga horária)
+	matricula, err := s.findMatricula(ctx, ator, matriculaID)
 	if err != nil {
-		// Se for ResourceNotFoundError, retorna o erro
-		if _, ok := err.(*model.ResourceNotFoundError); ok {
-			return nil, &model.BusinessRuleError{Msg: fmt.Sprintf("Trilha com ID %d não encontrada.", trilhaID)}
+		return nil, err
+	}
+	if matricula.Status != model.StatusMatriculaAtiva {
+		return nil, &model.BusinessRuleError{Chave: "matricula.sessao_status", Args: []any{matriculaID, matricula.Status}}
+	}
+	trilha, err := s.trilhaDAO.FindByID(ctx, ator.OrganizacaoID, matricula.TrilhaID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validação de Negócio: a sessão não pode estar no futuro
+	sessao := &model.SessaoEstudo{
+		MatriculaID: matriculaID,
+		Horas:       req.Horas,
+		DataSessao:  time.Now(),
+		Observacao:  req.Observacao,
+	}
+	if req.DataSessao != nil {
+		if req.DataSessao.After(sessao.DataSessao) {
+			return nil, &model.BusinessRuleError{Chave: "sessao.data_futura"}
 		}
+		sessao.DataSessao = *req.DataSessao
+	}
+
+	// 3. Persistência (sessão + progresso + conclusão automática) em uma única transação
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		matricula, err = s.sessaoEstudoDAO.Registrar(ctx, sessao, trilha.CargaHoraria)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// 3. Validação de Negócio: Verificar se o usuário já está matriculado (simplificado)
-	// Em um cenário real, seria necessário verificar se já existe uma matrícula ATIVA.
-	// Por simplicidade, vamos apenas criar a matrícula.
+	return s.progresso(ctx, matricula, trilha.CargaHoraria)
+}
 
-	// 4. Criação da Matrícula
-	matricula := &model.Matricula{
-		UsuarioID: usuarioID,
-		TrilhaID:  trilhaID,
-		Status:    "ATIVA",
+// GetSessoes lista as sessões de estudo de uma matrícula.
+func (s *matriculaServiceImpl) GetSessoes(ctx context.Context, ator *model.Usuario, matriculaID int64) ([]model.SessaoEstudo, error) {
+	if _, err := s.findMatricula(ctx, ator, matriculaID); err != nil {
+		return nil, err
 	}
 
-	if err := s.matriculaDAO.Create(matricula); err != nil {
+	return s.sessaoEstudoDAO.FindByMatriculaID(ctx, matriculaID)
+}
+
+// GetProgresso calcula o progresso de uma matrícula em relação à carga horária da trilha.
+func (s *matriculaServiceImpl) GetProgresso(ctx context.Context, ator *model.Usuario, matriculaID int64) (*model.ProgressoResponse, error) {
+	matricula, err := s.findMatricula(ctx, ator, matriculaID)
+	if err != nil {
+		return nil, err
+	}
+	trilha, err := s.trilhaDAO.FindByID(ctx, ator.OrganizacaoID, matricula.TrilhaID)
+	if err != nil {
 		return nil, err
 	}
 
-	return matricula, nil
+	return s.progresso(ctx, matricula, trilha.CargaHoraria)
 }
 
-// GetMatriculasByUsuario busca todas as matrículas de um usuário.
-func (s *matriculaServiceImpl) GetMatriculasByUsuario(usuarioID int64) ([]model.Matricula, error) {
-	// 1. Validação de Existência: Usuário
-	_, err := s.usuarioDAO.FindByID(usuarioID)
+// ConcluirAula marca uma aula da trilha como concluída na matrícula; a duração da aula
+// é contabilizada como horas estudadas e pode concluir a matrícula automaticamente.
+func (s *matriculaServiceImpl) ConcluirAula(ctx context.Context, ator *model.Usuario, matriculaID, aulaID int64) (*model.ProgressoResponse, error) {
+	// 1. Buscar matrícula, aula e trilha
+	matricula, err := s.findMatricula(ctx, ator, matriculaID)
 	if err != nil {
-		// Se for ResourceNotFoundError, retorna o erro
-		if _, ok := err.(*model.ResourceNotFoundError); ok {
-			return nil, &model.BusinessRuleError{Msg: fmt.Sprintf("Usuário com ID %d não encontrado.", usuarioID)}
+		return nil, err
+	}
+	aula, err := s.aulaDAO.FindByID(ctx, aulaID)
+	if err != nil {
+		return nil, err
+	}
+	trilhaDaAula, err := s.aulaDAO.FindTrilhaID(ctx, aulaID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validações de Negócio
+	if trilhaDaAula != matricula.TrilhaID {
+		return nil, &model.BusinessRuleError{Chave: "matricula.aula_de_outra_trilha", Args: []any{aulaID, matriculaID}}
+	}
+	if matricula.Status != model.StatusMatriculaAtiva {
+		return nil, &model.BusinessRuleError{Chave: "matricula.aula_status", Args: []any{matriculaID, matricula.Status}}
+	}
+	trilha, err := s.trilhaDAO.FindByID(ctx, ator.OrganizacaoID, matricula.TrilhaID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Persistência (conclusão da aula + progresso) em uma única transação
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		matricula, err = s.aulaDAO.Concluir(ctx, matriculaID, aula, trilha.CargaHoraria)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.progresso(ctx, matricula, trilha.CargaHoraria)
+}
+
+// progresso complementa o DTO de progresso com a contagem de aulas da trilha.
+func (s *matriculaServiceImpl) progresso(ctx context.Context, m *model.Matricula, cargaHoraria int) (*model.ProgressoResponse, error) {
+	response := toProgressoResponse(m, cargaHoraria)
+
+	totalAulas, err := s.aulaDAO.CountByTrilhaID(ctx, m.TrilhaID)
+	if err != nil {
+		return nil, err
+	}
+	aulasConcluidas, err := s.aulaDAO.CountConcluidas(ctx, m.ID)
+	if err != nil {
+		return nil, err
+	}
+	response.TotalAulas = totalAulas
+	response.AulasConcluidas = aulasConcluidas
+
+	return response, nil
+}
+
+// toProgressoResponse monta o DTO de progresso; o percentual é limitado a 100 e
+// matrículas CONCLUIDAS sempre aparecem como 100%.
+func toProgressoResponse(m *model.Matricula, cargaHoraria int) *model.ProgressoResponse {
+	percentual := 0.0
+	if cargaHoraria > 0 {
+		percentual = math.Min(100, m.HorasEstudadas/float64(cargaHoraria)*100)
+	}
+	if m.Status == model.StatusMatriculaConcluida {
+		percentual = 100
+	}
+
+	return &model.ProgressoResponse{
+		MatriculaID:         m.ID,
+		TrilhaID:            m.TrilhaID,
+		Status:              m.Status,
+		HorasEstudadas:      m.HorasEstudadas,
+		CargaHoraria:        cargaHoraria,
+		PercentualConcluido: math.Round(percentual*10) / 10,
+		DataUltimaAtividade: m.DataUltimaAtividade,
+		DataConclusao:       m.DataConclusao,
+	}
+}
+
+// autorizarConclusaoManual verifica se o ator pode concluir a matrícula sem que a carga
+// horária tenha sido cumprida: quem gerencia matrículas ou o gestor direto do usuário.
+func (s *matriculaServiceImpl) autorizarConclusaoManual(ctx context.Context, ator *model.Usuario, matricula *model.Matricula) error {
+	if ator.TemPermissao(model.PermGerenciarMatriculas) {
+		return nil
+	}
+	usuario, err := s.usuarioDAO.FindByID(ctx, ator.OrganizacaoID, matricula.UsuarioID)
+	if err != nil {
+		return err
+	}
+	if usuario.GestorID == nil || *usuario.GestorID != ator.ID {
+		return &model.ForbiddenError{Chave: "acesso.concluir_matricula"}
+	}
+	return nil
+}
+
+// transicionar aplica uma transição de status validando-a contra a máquina de estados.
+func (s *matriculaServiceImpl) transicionar(ctx context.Context, ator *model.Usuario, id int64, novoStatus string) (*model.Matricula, error) {
+	// 1. Buscar a matrícula existente
+	matricula, err := s.matriculaDAO.FindByID(ctx, ator.OrganizacaoID, id)
+	if err != nil {
+		return nil, err // Trata ResourceNotFoundError
+	}
+	if novoStatus == model.StatusMatriculaConcluida {
+		err = s.autorizarConclusaoManual(ctx, ator, matricula)
+	} else {
+		err = autorizarUsuario(ator, matricula.UsuarioID, model.PermGerenciarMatriculas)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validação de Negócio: a transição precisa ser permitida
+	if !transicaoPermitida(matricula.Status, novoStatus) {
+		return nil, &model.BusinessRuleError{
+			Chave:  "matricula.transicao_invalida",
+			Args:   []any{id, matricula.Status, novoStatus},
+			Codigo: model.CodigoTransicaoInvalida,
 		}
+	}
+
+	// 3. Aplicar o novo status e as datas do ciclo de vida
+	statusOrigem := matricula.Status
+	agora := time.Now()
+	matricula.Status = novoStatus
+	switch novoStatus {
+	case model.StatusMatriculaConcluida:
+		matricula.DataConclusao = &agora
+	case model.StatusMatriculaCancelada:
+		matricula.DataCancelamento = &agora
+	case model.StatusMatriculaAtiva:
+		matricula.DataCancelamento = nil
+	}
+
+	// 4. Persistência, condicionada ao status validado acima (transições concorrentes
+	// resultam em ConflictError); na conclusão, as competências da trilha são concedidas
+	// na mesma transação
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		return s.matriculaDAO.UpdateStatus(ctx, matricula, statusOrigem)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// 2. Busca no DAO
-	return s.matriculaDAO.FindByUsuarioID(usuarioID)
+	return matricula, nil
+}
+
+// transicaoPermitida indica se a máquina de estados permite ir de origem para destino.
+func transicaoPermitida(origem, destino string) bool {
+	for _, permitido := range transicoesMatricula[origem] {
+		if permitido == destino {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// ModuloService é a interface para as operações de negócio do conteúdo estruturado
+// de uma trilha (módulos e aulas). A trilha precisa ser visível para a organização do
+// ator; o conteúdo de trilhas públicas só é alterado por curadores da plataforma.
+type ModuloService interface {
+	FindByTrilha(ctx context.Context, ator *model.Usuario, trilhaID int64) ([]model.ModuloResponse, error)
+	FindByID(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64) (*model.ModuloResponse, error)
+	Create(ctx context.Context, ator *model.Usuario, trilhaID int64, req *model.CreateModuloRequest) (*model.ModuloResponse, error)
+	Update(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64, req *model.UpdateModuloRequest) (*model.ModuloResponse, error)
+	Delete(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64) error
+
+	CreateAula(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64, req *model.CreateAulaRequest) (*model.Aula, error)
+	UpdateAula(ctx context.Context, ator *model.Usuario, trilhaID, moduloID, aulaID int64, req *model.UpdateAulaRequest) (*model.Aula, error)
+	DeleteAula(ctx context.Context, ator *model.Usuario, trilhaID, moduloID, aulaID int64) error
+}
+
+// moduloServiceImpl implementa a interface ModuloService.
+type moduloServiceImpl struct {
+	tx        dao.Transacionador
+	dao       dao.ModuloDAO
+	aulaDAO   dao.AulaDAO
+	trilhaDAO dao.TrilhaDAO
+}
+
+// NewModuloService cria uma nova instância de ModuloService.
+func NewModuloService(tx dao.Transacionador, moduloDAO dao.ModuloDAO, aulaDAO dao.AulaDAO, trilhaDAO dao.TrilhaDAO) ModuloService {
+	return &moduloServiceImpl{
+		tx:        tx,
+		dao:       moduloDAO,
+		aulaDAO:   aulaDAO,
+		trilhaDAO: trilhaDAO,
+	}
+}
+
+// FindByTrilha lista os módulos de uma trilha, cada um com suas aulas em ordem.
+func (s *moduloServiceImpl) FindByTrilha(ctx context.Context, ator *model.Usuario, trilhaID int64) ([]model.ModuloResponse, error) {
+	// 1. Validação de Existência: Trilha
+	if err := s.findTrilha(ctx, ator, trilhaID, false); err != nil {
+		return nil, err
+	}
+
+	// 2. Busca dos módulos e de todas as aulas em uma única consulta
+	modulos, err := s.dao.FindByTrilhaID(ctx, trilhaID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, len(modulos))
+	for i, m := range modulos {
+		ids[i] = m.ID
+	}
+	aulasPorModulo, err := s.aulaDAO.FindByModuloIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]model.ModuloResponse, len(modulos))
+	for i := range modulos {
+		responses[i] = *toModuloResponse(&modulos[i], aulasPorModulo[modulos[i].ID])
+	}
+	return responses, nil
+}
+
+// FindByID busca um módulo da trilha com suas aulas.
+func (s *moduloServiceImpl) FindByID(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64) (*model.ModuloResponse, error) {
+	modulo, err := s.findModulo(ctx, ator, trilhaID, moduloID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.moduloComAulas(ctx, modulo)
+}
+
+// Create cria um novo módulo na trilha.
+func (s *moduloServiceImpl) Create(ctx context.Context, ator *model.Usuario, trilhaID int64, req *model.CreateModuloRequest) (*model.ModuloResponse, error) {
+	// 1. Validação de Existência e Autorização: Trilha
+	if err := s.findTrilha(ctx, ator, trilhaID, true); err != nil {
+		return nil, err
+	}
+
+	// 2. Mapeamento DTO para Entidade e persistência
+	modulo := &model.Modulo{
+		TrilhaID:  trilhaID,
+		Titulo:    req.Titulo,
+		Descricao: req.Descricao,
+		Ordem:     req.Ordem,
+	}
+	if err := s.dao.Create(ctx, modulo); err != nil {
+		return nil, err
+	}
+
+	return toModuloResponse(modulo, nil), nil
+}
+
+// Update atualiza um módulo da trilha.
+func (s *moduloServiceImpl) Update(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64, req *model.UpdateModuloRequest) (*model.ModuloResponse, error) {
+	// 1. Buscar o módulo existente
+	modulo, err := s.findModulo(ctx, ator, trilhaID, moduloID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aplicar as atualizações (apenas campos fornecidos)
+	if req.Titulo != "" {
+		modulo.Titulo = req.Titulo
+	}
+	if req.Descricao != "" {
+		modulo.Descricao = req.Descricao
+	}
+	if req.Ordem != 0 {
+		modulo.Ordem = req.Ordem
+	}
+
+	// 3. Persistência
+	if err := s.dao.Update(ctx, modulo); err != nil {
+		return nil, err
+	}
+
+	return s.moduloComAulas(ctx, modulo)
+}
+
+// Delete remove um módulo (e suas aulas) e recalcula a carga horária da trilha, na
+// mesma transação.
+func (s *moduloServiceImpl) Delete(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64) error {
+	if _, err := s.findModulo(ctx, ator, trilhaID, moduloID, true); err != nil {
+		return err
+	}
+
+	return WithTx(ctx, s.tx, func(ctx context.Context) error {
+		if err := s.dao.Delete(ctx, moduloID); err != nil {
+			return err
+		}
+		return s.dao.RecalcularCargaHoraria(ctx, trilhaID)
+	})
+}
+
+// CreateAula cria uma aula no módulo e recalcula a carga horária da trilha.
+func (s *moduloServiceImpl) CreateAula(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64, req *model.CreateAulaRequest) (*model.Aula, error) {
+	// 1. Validação de Existência: Módulo pertencente à trilha
+	if _, err := s.findModulo(ctx, ator, trilhaID, moduloID, true); err != nil {
+		return nil, err
+	}
+
+	// 2. Mapeamento DTO para Entidade
+	aula := &model.Aula{
+		ModuloID:       moduloID,
+		Titulo:         req.Titulo,
+		Tipo:           req.Tipo,
+		Ordem:          req.Ordem,
+		DuracaoMinutos: req.DuracaoMinutos,
+		URL:            req.URL,
+	}
+	// 3. Persistência; a carga horária da trilha passa a refletir o conteúdo cadastrado,
+	// na mesma transação
+	err := WithTx(ctx, s.tx, func(ctx context.Context) error {
+		if err := s.aulaDAO.Create(ctx, aula); err != nil {
+			return err
+		}
+		return s.dao.RecalcularCargaHoraria(ctx, trilhaID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return aula, nil
+}
+
+// UpdateAula atualiza uma aula do módulo e recalcula a carga horária da trilha.
+func (s *moduloServiceImpl) UpdateAula(ctx context.Context, ator *model.Usuario, trilhaID, moduloID, aulaID int64, req *model.UpdateAulaRequest) (*model.Aula, error) {
+	// 1. Buscar a aula existente
+	aula, err := s.findAula(ctx, ator, trilhaID, moduloID, aulaID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Aplicar as atualizações (apenas campos fornecidos)
+	if req.Titulo != "" {
+		aula.Titulo = req.Titulo
+	}
+	if req.Tipo != "" {
+		aula.Tipo = req.Tipo
+	}
+	if req.Ordem != 0 {
+		aula.Ordem = req.Ordem
+	}
+	if req.DuracaoMinutos != 0 {
+		aula.DuracaoMinutos = req.DuracaoMinutos
+	}
+	if req.URL != "" {
+		aula.URL = req.URL
+	}
+
+	// 3. Persistência e recálculo da carga horária, na mesma transação
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		if err := s.aulaDAO.Update(ctx, aula); err != nil {
+			return err
+		}
+		return s.dao.RecalcularCargaHoraria(ctx, trilhaID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return aula, nil
+}
+
+// DeleteAula remove uma aula do módulo e recalcula a carga horária da trilha, na mesma
+// transação.
+func (s *moduloServiceImpl) DeleteAula(ctx context.Context, ator *model.Usuario, trilhaID, moduloID, aulaID int64) error {
+	if _, err := s.findAula(ctx, ator, trilhaID, moduloID, aulaID); err != nil {
+		return err
+	}
+
+	return WithTx(ctx, s.tx, func(ctx context.Context) error {
+		if err := s.aulaDAO.Delete(ctx, aulaID); err != nil {
+			return err
+		}
+		return s.dao.RecalcularCargaHoraria(ctx, trilhaID)
+	})
+}
+
+// findTrilha verifica se a trilha é visível para o ator e, quando editar é verdadeiro,
+// se ele pode alterar seu conteúdo (trilhas públicas apenas pela plataforma).
+func (s *moduloServiceImpl) findTrilha(ctx context.Context, ator *model.Usuario, trilhaID int64, editar bool) error {
+	trilha, err := s.trilhaDAO.FindByID(ctx, ator.OrganizacaoID, trilhaID)
+	if err != nil {
+		return err
+	}
+	if editar && trilha.Publica() {
+		return autorizarCatalogoPublico(ator)
+	}
+	return nil
+}
+
+// findModulo busca o módulo garantindo que ele pertence à trilha informada.
+func (s *moduloServiceImpl) findModulo(ctx context.Context, ator *model.Usuario, trilhaID, moduloID int64, editar bool) (*model.Modulo, error) {
+	if err := s.findTrilha(ctx, ator, trilhaID, editar); err != nil {
+		return nil, err
+	}
+	modulo, err := s.dao.FindByID(ctx, moduloID)
+	if err != nil {
+		return nil, err
+	}
+	if modulo.TrilhaID != trilhaID {
+		return nil, &model.ResourceNotFoundError{Resource: "Módulo", ID: moduloID}
+	}
+	return modulo, nil
+}
+
+// findAula busca a aula, para alteração, garantindo que ela pertence ao módulo e à
+// trilha informados.
+func (s *moduloServiceImpl) findAula(ctx context.Context, ator *model.Usuario, trilhaID, moduloID, aulaID int64) (*model.Aula, error) {
+	if _, err := s.findModulo(ctx, ator, trilhaID, moduloID, true); err != nil {
+		return nil, err
+	}
+	aula, err := s.aulaDAO.FindByID(ctx, aulaID)
+	if err != nil {
+		return nil, err
+	}
+	if aula.ModuloID != moduloID {
+		return nil, &model.ResourceNotFoundError{Resource: "Aula", ID: aulaID}
+	}
+	return aula, nil
+}
+
+// moduloComAulas monta a resposta de um módulo carregando suas aulas.
+func (s *moduloServiceImpl) moduloComAulas(ctx context.Context, modulo *model.Modulo) (*model.ModuloResponse, error) {
+	aulasPorModulo, err := s.aulaDAO.FindByModuloIDs(ctx, []int64{modulo.ID})
+	if err != nil {
+		return nil, err
+	}
+	return toModuloResponse(modulo, aulasPorModulo[modulo.ID]), nil
+}
+
+// toModuloResponse mapeia o módulo e suas aulas para o DTO de resposta.
+func toModuloResponse(m *model.Modulo, aulas []model.Aula) *model.ModuloResponse {
+	if aulas == nil {
+		aulas = make([]model.Aula, 0)
+	}
+	duracao := 0
+	for _, a := range aulas {
+		duracao += a.DuracaoMinutos
+	}
+
+	return &model.ModuloResponse{
+		ID:             m.ID,
+		TrilhaID:       m.TrilhaID,
+		Titulo:         m.Titulo,
+		Descricao:      m.Descricao,
+		Ordem:          m.Ordem,
+		DuracaoMinutos: duracao,
+		Aulas:          aulas,
+	}
+}
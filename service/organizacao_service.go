@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// slugValido aceita letras minúsculas, números e hífens entre eles (ex: "acme-brasil").
+var slugValido = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// OrganizacaoService é a interface para as operações de negócio de Organização e Equipe.
+type OrganizacaoService interface {
+	Registrar(ctx context.Context, ator *model.Usuario, req *model.CreateOrganizacaoRequest) (*model.RegistroOrganizacaoResponse, error)
+	FindAtual(ctx context.Context, ator *model.Usuario) (*model.Organizacao, error)
+
+	FindEquipes(ctx context.Context, ator *model.Usuario) ([]model.Equipe, error)
+	CreateEquipe(ctx context.Context, ator *model.Usuario, req *model.EquipeRequest) (*model.Equipe, error)
+	UpdateEquipe(ctx context.Context, ator *model.Usuario, id int64, req *model.EquipeRequest) (*model.Equipe, error)
+	DeleteEquipe(ctx context.Context, ator *model.Usuario, id int64) error
+}
+
+// organizacaoServiceImpl implementa a interface OrganizacaoService.
+type organizacaoServiceImpl struct {
+	tx         dao.Transacionador
+	dao        dao.OrganizacaoDAO
+	usuarioDAO dao.UsuarioDAO
+}
+
+// NewOrganizacaoService cria uma nova instância de OrganizacaoService.
+func NewOrganizacaoService(tx dao.Transacionador, organizacaoDAO dao.OrganizacaoDAO, usuarioDAO dao.UsuarioDAO) OrganizacaoService {
+	return &organizacaoServiceImpl{
+		tx:         tx,
+		dao:        organizacaoDAO,
+		usuarioDAO: usuarioDAO,
+	}
+}
+
+// Registrar cria uma nova organização (tenant) junto com seu primeiro administrador.
+// Apenas administradores da plataforma podem registrar organizações.
+func (s *organizacaoServiceImpl) Registrar(ctx context.Context, ator *model.Usuario, req *model.CreateOrganizacaoRequest) (*model.RegistroOrganizacaoResponse, error) {
+	// 0. Autorização
+	if err := autorizarAdminPlataforma(ator); err != nil {
+		return nil, err
+	}
+
+	// 1. Validação de Negócio: slug e email do administrador
+	slug := strings.ToLower(strings.TrimSpace(req.Slug))
+	if !slugValido.MatchString(slug) {
+		return nil, &model.BusinessRuleError{Chave: "organizacao.slug_invalido"}
+	}
+	if req.Admin.EquipeID != nil || req.Admin.GestorID != nil {
+		return nil, &model.BusinessRuleError{Chave: "organizacao.admin_sem_equipe"}
+	}
+	admin, err := novoUsuario(ctx, s.usuarioDAO, 0, &req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Persistência (organização + administrador na mesma transação)
+	organizacao := &model.Organizacao{
+		Nome: req.Nome,
+		Slug: slug,
+	}
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		return s.dao.CreateComAdmin(ctx, organizacao, admin)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Mapeamento Entidade para Response DTO
+	return &model.RegistroOrganizacaoResponse{
+		Organizacao: *organizacao,
+		Admin:       *toUsuarioResponse(admin),
+	}, nil
+}
+
+// FindAtual retorna a organização do usuário autenticado.
+func (s *organizacaoServiceImpl) FindAtual(ctx context.Context, ator *model.Usuario) (*model.Organizacao, error) {
+	return s.dao.FindByID(ctx, ator.OrganizacaoID)
+}
+
+// FindEquipes lista as equipes da organização do usuário autenticado.
+func (s *organizacaoServiceImpl) FindEquipes(ctx context.Context, ator *model.Usuario) ([]model.Equipe, error) {
+	return s.dao.FindEquipes(ctx, ator.OrganizacaoID)
+}
+
+// CreateEquipe cria uma equipe na organização. Apenas administradores.
+func (s *organizacaoServiceImpl) CreateEquipe(ctx context.Context, ator *model.Usuario, req *model.EquipeRequest) (*model.Equipe, error) {
+	if err := autorizarGestaoEquipes(ator); err != nil {
+		return nil, err
+	}
+
+	equipe := &model.Equipe{
+		OrganizacaoID: ator.OrganizacaoID,
+		Nome:          strings.TrimSpace(req.Nome),
+	}
+	if err := s.dao.CreateEquipe(ctx, equipe); err != nil {
+		return nil, err
+	}
+	return equipe, nil
+}
+
+// UpdateEquipe renomeia uma equipe da organização. Apenas administradores.
+func (s *organizacaoServiceImpl) UpdateEquipe(ctx context.Context, ator *model.Usuario, id int64, req *model.EquipeRequest) (*model.Equipe, error) {
+	if err := autorizarGestaoEquipes(ator); err != nil {
+		return nil, err
+	}
+
+	equipe := &model.Equipe{
+		ID:            id,
+		OrganizacaoID: ator.OrganizacaoID,
+		Nome:          strings.TrimSpace(req.Nome),
+	}
+	if err := s.dao.UpdateEquipe(ctx, equipe); err != nil {
+		return nil, err
+	}
+	return equipe, nil
+}
+
+// DeleteEquipe remove uma equipe da organização; seus membros ficam sem equipe.
+// Apenas administradores.
+func (s *organizacaoServiceImpl) DeleteEquipe(ctx context.Context, ator *model.Usuario, id int64) error {
+	if err := autorizarGestaoEquipes(ator); err != nil {
+		return err
+	}
+	return s.dao.DeleteEquipe(ctx, ator.OrganizacaoID, id)
+}
+
+// autorizarGestaoEquipes exige a permissão de gerenciar usuários para alterar equipes.
+func autorizarGestaoEquipes(ator *model.Usuario) error {
+	if !ator.TemPermissao(model.PermGerenciarUsuarios) {
+		return &model.ForbiddenError{Chave: "acesso.gerenciar_equipes"}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// PainelGestorService é a interface para o painel do gestor: acompanhamento das
+// matrículas e do progresso dos liderados diretos.
+type PainelGestorService interface {
+	Resumo(ctx context.Context, ator *model.Usuario, gestorID int64) (*model.PainelGestorResponse, error)
+	GetMatriculas(ctx context.Context, ator *model.Usuario, gestorID int64, params model.ListParams) ([]model.MatriculaLiderado, *model.Pagina, error)
+}
+
+// painelGestorServiceImpl implementa a interface PainelGestorService.
+type painelGestorServiceImpl struct {
+	dao        dao.PainelGestorDAO
+	usuarioDAO dao.UsuarioDAO
+}
+
+// NewPainelGestorService cria uma nova instância de PainelGestorService.
+func NewPainelGestorService(painelGestorDAO dao.PainelGestorDAO, usuarioDAO dao.UsuarioDAO) PainelGestorService {
+	return &painelGestorServiceImpl{
+		dao:        painelGestorDAO,
+		usuarioDAO: usuarioDAO,
+	}
+}
+
+// Resumo retorna os indicadores de cada liderado direto do gestor e os totais da equipe.
+// O próprio gestor e os administradores da organização podem consultá-lo.
+func (s *painelGestorServiceImpl) Resumo(ctx context.Context, ator *model.Usuario, gestorID int64) (*model.PainelGestorResponse, error) {
+	// 1. Autorização e existência do gestor
+	if err := s.autorizar(ctx, ator, gestorID); err != nil {
+		return nil, err
+	}
+
+	// 2. Indicadores por liderado (agregados no SQL)
+	liderados, err := s.dao.ResumoLiderados(ctx, ator.OrganizacaoID, gestorID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Totais da equipe
+	painel := &model.PainelGestorResponse{
+		GestorID:       gestorID,
+		TotalLiderados: len(liderados),
+		Liderados:      liderados,
+	}
+	for i := range liderados {
+		l := &liderados[i]
+		l.TaxaConclusao = taxaConclusao(l.MatriculasConcluidas, l.TotalMatriculas-l.MatriculasCanceladas)
+		l.ProgressoMedio = arredondarPercentual(l.ProgressoMedio)
+
+		painel.TotalMatriculas += l.TotalMatriculas
+		painel.MatriculasAtivas += l.MatriculasAtivas
+		painel.MatriculasConcluidas += l.MatriculasConcluidas
+		painel.MatriculasCanceladas += l.MatriculasCanceladas
+		painel.MatriculasAtrasadas += l.MatriculasAtrasadas
+		painel.HorasEstudadas += l.HorasEstudadas
+	}
+	painel.TaxaConclusao = taxaConclusao(painel.MatriculasConcluidas, painel.TotalMatriculas-painel.MatriculasCanceladas)
+	painel.HorasEstudadas = math.Round(painel.HorasEstudadas*100) / 100
+
+	return painel, nil
+}
+
+// GetMatriculas busca uma página das matrículas dos liderados diretos, com progresso e
+// indicação de atraso.
+func (s *painelGestorServiceImpl) GetMatriculas(ctx context.Context, ator *model.Usuario, gestorID int64, params model.ListParams) ([]model.MatriculaLiderado, *model.Pagina, error) {
+	if err := s.autorizar(ctx, ator, gestorID); err != nil {
+		return nil, nil, err
+	}
+
+	matriculas, pagina, err := s.dao.FindMatriculasLiderados(ctx, ator.OrganizacaoID, gestorID, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range matriculas {
+		matriculas[i].PercentualConcluido = arredondarPercentual(matriculas[i].PercentualConcluido)
+	}
+	return matriculas, pagina, nil
+}
+
+// autorizar permite o acesso ao próprio gestor e a quem gerencia matrículas, e confirma
+// que o gestor pertence à organização do ator.
+func (s *painelGestorServiceImpl) autorizar(ctx context.Context, ator *model.Usuario, gestorID int64) error {
+	if err := autorizarUsuario(ator, gestorID, model.PermGerenciarMatriculas); err != nil {
+		return err
+	}
+	_, err := s.usuarioDAO.FindByID(ctx, ator.OrganizacaoID, gestorID)
+	return err
+}
+
+// taxaConclusao calcula o percentual de concluídas sobre as matrículas consideradas.
+func taxaConclusao(concluidas, consideradas int) float64 {
+	if consideradas <= 0 {
+		return 0
+	}
+	return arredondarPercentual(float64(concluidas) / float64(consideradas) * 100)
+}
+
+// arredondarPercentual arredonda um percentual para uma casa decimal.
+func arredondarPercentual(v float64) float64 {
+	return math.Round(v*10) / 10
+}
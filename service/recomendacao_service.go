@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// nivelTrilhaPorCarreira indica o nível de trilha adequado a cada nível de carreira.
+var nivelTrilhaPorCarreira = map[string]string{
+	"Em transição": "INICIANTE",
+	"Junior":       "INICIANTE",
+	"Pleno":        "INTERMEDIARIO",
+	"Senior":       "AVANCADO",
+}
+
+// RecomendacaoService é a interface para a recomendação personalizada de trilhas.
+type RecomendacaoService interface {
+	Recomendar(ctx context.Context, ator *model.Usuario, usuarioID int64, params model.ListParams) ([]model.RecomendacaoTrilha, *model.Pagina, error)
+}
+
+// recomendacaoServiceImpl implementa a interface RecomendacaoService.
+type recomendacaoServiceImpl struct {
+	dao        dao.RecomendacaoDAO
+	usuarioDAO dao.UsuarioDAO
+}
+
+// NewRecomendacaoService cria uma nova instância de RecomendacaoService.
+func NewRecomendacaoService(recomendacaoDAO dao.RecomendacaoDAO, usuarioDAO dao.UsuarioDAO) RecomendacaoService {
+	return &recomendacaoServiceImpl{
+		dao:        recomendacaoDAO,
+		usuarioDAO: usuarioDAO,
+	}
+}
+
+// Recomendar retorna uma página das trilhas recomendadas ao usuário, da maior para a
+// menor pontuação, cada uma com os motivos da recomendação. Ficam de fora as trilhas
+// em que o usuário já se matriculou e as que exigem nível de carreira acima do dele.
+// O próprio usuário, seu gestor direto e os administradores podem consultá-las.
+func (s *recomendacaoServiceImpl) Recomendar(ctx context.Context, ator *model.Usuario, usuarioID int64, params model.ListParams) ([]model.RecomendacaoTrilha, *model.Pagina, error) {
+	// 1. Autorização e existência do usuário
+	usuario, err := s.usuarioDAO.FindByID(ctx, ator.OrganizacaoID, usuarioID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := autorizarUsuarioOuGestor(ator, usuario, model.PermGerenciarUsuarios); err != nil {
+		return nil, nil, err
+	}
+
+	// 2. A ordenação é sempre pela pontuação; cursor não se aplica
+	if params.Sort != "" {
+		return nil, nil, &model.InvalidParameterError{Param: "sort", Chave: "parametro.recomendacao_sort"}
+	}
+	if params.Cursor != "" {
+		return nil, nil, &model.InvalidParameterError{Param: "cursor", Chave: "parametro.recomendacao_cursor"}
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = model.DefaultPageLimit
+	}
+	if limit > model.MaxPageLimit {
+		limit = model.MaxPageLimit
+	}
+
+	// 3. Nível de trilha indicado e níveis de carreira atendidos pelo usuário
+	nivelCarreira := model.NormalizarNivelCarreira(usuario.NivelCarreira)
+	niveisCarreira := make([]string, 0, len(model.NiveisCarreira))
+	for _, n := range model.NiveisCarreira[:model.RankNivelCarreira(nivelCarreira)+1] {
+		niveisCarreira = append(niveisCarreira, strings.ToLower(n))
+	}
+
+	// 4. Consulta (sinais e pontuação calculados no SQL)
+	recomendacoes, total, err := s.dao.FindRecomendacoes(ctx, ator.OrganizacaoID, usuario, nivelTrilhaPorCarreira[nivelCarreira], niveisCarreira, limit, params.Offset)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range recomendacoes {
+		r := &recomendacoes[i]
+		r.Pontuacao = math.Round(r.Pontuacao*100) / 100
+		r.Razoes = motivosRecomendacao(r, usuario.AreaAtuacao, nivelCarreira)
+	}
+
+	return recomendacoes, &model.Pagina{Total: total, Limit: limit, Offset: params.Offset}, nil
+}
+
+// motivosRecomendacao descreve, em ordem de peso, os sinais que levaram à recomendação.
+func motivosRecomendacao(r *model.RecomendacaoTrilha, areaAtuacao, nivelCarreira string) []model.Motivo {
+	motivos := make([]model.Motivo, 0, 5)
+	sinais := r.Sinais
+
+	switch len(sinais.CompetenciasNovas) {
+	case 0:
+	case 1:
+		motivos = append(motivos, model.Motivo{Chave: "recomendacao.competencia_nova", Args: []any{sinais.CompetenciasNovas[0]}})
+	default:
+		motivos = append(motivos, model.Motivo{Chave: "recomendacao.competencias_novas",
+			Args: []any{len(sinais.CompetenciasNovas), strings.Join(sinais.CompetenciasNovas, ", ")}})
+	}
+	if sinais.NivelAdequado {
+		motivos = append(motivos, model.Motivo{Chave: "recomendacao.nivel_adequado", Args: []any{r.Nivel, nivelCarreira}})
+	}
+	if sinais.CoInscritos > 0 {
+		motivos = append(motivos, model.Motivo{Chave: "recomendacao.co_inscritos", Args: []any{sinais.CoInscritos}})
+	}
+	if sinais.ColegasMesmaArea > 0 {
+		motivos = append(motivos, model.Motivo{Chave: "recomendacao.colegas_mesma_area", Args: []any{sinais.ColegasMesmaArea, areaAtuacao}})
+	}
+	if sinais.Matriculas > 0 {
+		motivos = append(motivos, model.Motivo{Chave: "recomendacao.popular", Args: []any{sinais.Matriculas}})
+	}
+	if len(motivos) == 0 {
+		motivos = append(motivos, model.Motivo{Chave: "recomendacao.disponivel"})
+	}
+	return motivos
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// maxTentativasTx é o número máximo de execuções de uma transação que falhou por
+// conflito de serialização ou deadlock.
+const maxTentativasTx = 3
+
+// esperaEntreTentativasTx é a espera base entre tentativas; cresce linearmente.
+const esperaEntreTentativasTx = 20 * time.Millisecond
+
+// WithTx executa fn como uma unidade de trabalho: todos os DAOs chamados com o contexto
+// recebido por fn compartilham a mesma transação, confirmada se fn não retornar erro e
+// desfeita caso contrário. Falhas de serialização e deadlocks repetem a transação
+// inteira (até maxTentativasTx vezes), portanto fn não deve ter efeitos colaterais fora
+// do banco. Chamadas aninhadas participam da transação externa, que decide as retentativas.
+// Esgotadas as tentativas, retorna model.TransientError (503, o cliente pode repetir).
+// Como as transações rodam em READ COMMITTED (ver dao.Conexao.EmTransacao), na prática a
+// retentativa cobre deadlocks (40P01); falhas de serialização (40001) só ocorrem se o
+// servidor for configurado com um isolamento mais restritivo.
+func WithTx(ctx context.Context, tx dao.Transacionador, fn func(ctx context.Context) error) error {
+	if dao.EmTransacaoAtiva(ctx) {
+		return fn(ctx)
+	}
+
+	var err error
+	for tentativa := 1; tentativa <= maxTentativasTx; tentativa++ {
+		err = tx.EmTransacao(ctx, fn)
+		if err == nil || !dao.ErroTransitorio(err) {
+			return err
+		}
+		log.Printf("Transação abortada por conflito (tentativa %d de %d): %v", tentativa, maxTentativasTx, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(tentativa) * esperaEntreTentativasTx):
+		}
+	}
+
+	if _, ok := err.(*model.TransientError); !ok {
+		err = &model.TransientError{Err: err}
+	}
+	return err
+}
@@ -1,33 +1,54 @@
 package service
 
 import (
+	"context"
+
 	"upskilling-api/dao"
 	"upskilling-api/model"
 )
 
 // TrilhaService é a interface para as operações de negócio de Trilha.
+// O ator enxerga o catálogo público e as trilhas privadas da própria organização; as
+// trilhas públicas só podem ser alteradas por curadores da plataforma.
 type TrilhaService interface {
-	Create(req *model.CreateTrilhaRequest) (*model.TrilhaResponse, error)
-	FindByID(id int64) (*model.TrilhaResponse, error)
-	FindAll() ([]model.TrilhaResponse, error)
-	Update(id int64, req *model.UpdateTrilhaRequest) (*model.TrilhaResponse, error)
-	Delete(id int64) error
+	Create(ctx context.Context, ator *model.Usuario, req *model.CreateTrilhaRequest) (*model.TrilhaResponse, error)
+	FindByID(ctx context.Context, ator *model.Usuario, id int64, incluirCompetencias bool) (*model.TrilhaResponse, error)
+	FindAll(ctx context.Context, ator *model.Usuario, params model.ListParams, incluirCompetencias bool) ([]model.TrilhaResponse, *model.Pagina, error)
+	Update(ctx context.Context, ator *model.Usuario, id int64, req *model.UpdateTrilhaRequest) (*model.TrilhaResponse, error)
+	Delete(ctx context.Context, ator *model.Usuario, id int64) error
+
+	GetCompetencias(ctx context.Context, ator *model.Usuario, trilhaID int64) ([]model.CompetenciaResponse, error)
+	SetCompetencias(ctx context.Context, ator *model.Usuario, trilhaID int64, competenciaIDs []int64) ([]model.CompetenciaResponse, error)
+	AddCompetencia(ctx context.Context, ator *model.Usuario, trilhaID, competenciaID int64) error
+	RemoveCompetencia(ctx context.Context, ator *model.Usuario, trilhaID, competenciaID int64) error
+
+	GetRequisitos(ctx context.Context, ator *model.Usuario, trilhaID int64) (*model.RequisitosResponse, error)
+	SetRequisitos(ctx context.Context, ator *model.Usuario, trilhaID int64, req *model.SetRequisitosRequest) (*model.RequisitosResponse, error)
 }
 
 // trilhaServiceImpl implementa a interface TrilhaService.
 type trilhaServiceImpl struct {
-	dao dao.TrilhaDAO
+	tx                   dao.Transacionador
+	dao                  dao.TrilhaDAO
+	competenciaDAO       dao.CompetenciaDAO
+	trilhaCompetenciaDAO dao.TrilhaCompetenciaDAO
+	requisitoDAO         dao.RequisitoDAO
 }
 
 // NewTrilhaService cria uma nova instância de TrilhaService.
-func NewTrilhaService() TrilhaService {
+func NewTrilhaService(tx dao.Transacionador, trilhaDAO dao.TrilhaDAO, competenciaDAO dao.CompetenciaDAO, trilhaCompetenciaDAO dao.TrilhaCompetenciaDAO, requisitoDAO dao.RequisitoDAO) TrilhaService {
 	return &trilhaServiceImpl{
-		dao: dao.NewTrilhaDAO(),
+		tx:                   tx,
+		dao:                  trilhaDAO,
+		competenciaDAO:       competenciaDAO,
+		trilhaCompetenciaDAO: trilhaCompetenciaDAO,
+		requisitoDAO:         requisitoDAO,
 	}
 }
 
-// Create cria uma nova trilha.
-func (s *trilhaServiceImpl) Create(req *model.CreateTrilhaRequest) (*model.TrilhaResponse, error) {
+// Create cria uma nova trilha, privada da organização do ator ou, quando publica, no
+// catálogo público (apenas curadores da plataforma).
+func (s *trilhaServiceImpl) Create(ctx context.Context, ator *model.Usuario, req *model.CreateTrilhaRequest) (*model.TrilhaResponse, error) {
 	// 1. Mapeamento DTO para Entidade
 	trilha := &model.Trilha{
 		Nome:          req.Nome,
@@ -36,65 +57,76 @@ func (s *trilhaServiceImpl) Create(req *model.CreateTrilhaRequest) (*model.Trilh
 		CargaHoraria:  req.CargaHoraria,
 		FocoPrincipal: req.FocoPrincipal,
 	}
+	if req.Publica {
+		if err := autorizarCatalogoPublico(ator); err != nil {
+			return nil, err
+		}
+	} else {
+		organizacaoID := ator.OrganizacaoID
+		trilha.OrganizacaoID = &organizacaoID
+	}
 
 	// 2. Persistência
-	if err := s.dao.Create(trilha); err != nil {
+	if err := s.dao.Create(ctx, trilha); err != nil {
 		return nil, err
 	}
 
 	// 3. Mapeamento Entidade para Response DTO
-	return &model.TrilhaResponse{
-		ID:            trilha.ID,
-		Nome:          trilha.Nome,
-		Descricao:     trilha.Descricao,
-		Nivel:         trilha.Nivel,
-		CargaHoraria:  trilha.CargaHoraria,
-		FocoPrincipal: trilha.FocoPrincipal,
-	}, nil
+	return toTrilhaResponse(trilha), nil
 }
 
-// FindByID busca uma trilha pelo ID.
-func (s *trilhaServiceImpl) FindByID(id int64) (*model.TrilhaResponse, error) {
-	trilha, err := s.dao.FindByID(id)
+// FindByID busca uma trilha pelo ID, opcionalmente incluindo as competências que ela desenvolve.
+func (s *trilhaServiceImpl) FindByID(ctx context.Context, ator *model.Usuario, id int64, incluirCompetencias bool) (*model.TrilhaResponse, error) {
+	trilha, err := s.dao.FindByID(ctx, ator.OrganizacaoID, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.TrilhaResponse{
-		ID:            trilha.ID,
-		Nome:          trilha.Nome,
-		Descricao:     trilha.Descricao,
-		Nivel:         trilha.Nivel,
-		CargaHoraria:  trilha.CargaHoraria,
-		FocoPrincipal: trilha.FocoPrincipal,
-	}, nil
+	response := toTrilhaResponse(trilha)
+	if incluirCompetencias {
+		competencias, err := s.trilhaCompetenciaDAO.FindCompetenciasByTrilhaID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		response.Competencias = toCompetenciaResponses(competencias)
+	}
+	return response, nil
 }
 
-// FindAll busca todas as trilhas.
-func (s *trilhaServiceImpl) FindAll() ([]model.TrilhaResponse, error) {
-	trilhas, err := s.dao.FindAll()
+// FindAll busca uma página das trilhas visíveis para o ator, opcionalmente incluindo as
+// competências de cada uma.
+func (s *trilhaServiceImpl) FindAll(ctx context.Context, ator *model.Usuario, params model.ListParams, incluirCompetencias bool) ([]model.TrilhaResponse, *model.Pagina, error) {
+	trilhas, pagina, err := s.dao.FindAll(ctx, ator.OrganizacaoID, params)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	var competenciasPorTrilha map[int64][]model.Competencia
+	if incluirCompetencias {
+		ids := make([]int64, len(trilhas))
+		for i, t := range trilhas {
+			ids[i] = t.ID
+		}
+		competenciasPorTrilha, err = s.trilhaCompetenciaDAO.FindCompetenciasByTrilhaIDs(ctx, ids)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	responses := make([]model.TrilhaResponse, len(trilhas))
-	for i, t := range trilhas {
-		responses[i] = model.TrilhaResponse{
-			ID:            t.ID,
-			Nome:          t.Nome,
-			Descricao:     t.Descricao,
-			Nivel:         t.Nivel,
-			CargaHoraria:  t.CargaHoraria,
-			FocoPrincipal: t.FocoPrincipal,
+	for i := range trilhas {
+		responses[i] = *toTrilhaResponse(&trilhas[i])
+		if incluirCompetencias {
+			responses[i].Competencias = toCompetenciaResponses(competenciasPorTrilha[trilhas[i].ID])
 		}
 	}
-	return responses, nil
+	return responses, pagina, nil
 }
 
 // Update atualiza uma trilha existente.
-func (s *trilhaServiceImpl) Update(id int64, req *model.UpdateTrilhaRequest) (*model.TrilhaResponse, error) {
+func (s *trilhaServiceImpl) Update(ctx context.Context, ator *model.Usuario, id int64, req *model.UpdateTrilhaRequest) (*model.TrilhaResponse, error) {
 	// 1. Buscar a trilha existente
-	trilha, err := s.dao.FindByID(id)
+	trilha, err := s.findTrilhaEditavel(ctx, ator, id)
 	if err != nil {
 		return nil, err // Trata ResourceNotFoundError
 	}
@@ -117,22 +149,231 @@ func (s *trilhaServiceImpl) Update(id int64, req *model.UpdateTrilhaRequest) (*m
 	}
 
 	// 3. Persistência
-	if err := s.dao.Update(trilha); err != nil {
+	if err := s.dao.Update(ctx, ator.OrganizacaoID, trilha); err != nil {
 		return nil, err
 	}
 
 	// 4. Mapeamento Entidade para Response DTO
-	return &model.TrilhaResponse{
-		ID:            trilha.ID,
-		Nome:          trilha.Nome,
-		Descricao:     trilha.Descricao,
-		Nivel:         trilha.Nivel,
-		CargaHoraria:  trilha.CargaHoraria,
-		FocoPrincipal: trilha.FocoPrincipal,
-	}, nil
+	return toTrilhaResponse(trilha), nil
 }
 
 // Delete remove uma trilha pelo ID.
-func (s *trilhaServiceImpl) Delete(id int64) error {
-	return s.dao.Delete(id)
+func (s *trilhaServiceImpl) Delete(ctx context.Context, ator *model.Usuario, id int64) error {
+	if _, err := s.findTrilhaEditavel(ctx, ator, id); err != nil {
+		return err
+	}
+	return s.dao.Delete(ctx, ator.OrganizacaoID, id)
+}
+
+// findTrilhaEditavel busca uma trilha visível para o ator e verifica se ele pode alterá-la:
+// trilhas privadas pela própria organização, trilhas públicas apenas pela plataforma.
+func (s *trilhaServiceImpl) findTrilhaEditavel(ctx context.Context, ator *model.Usuario, id int64) (*model.Trilha, error) {
+	trilha, err := s.dao.FindByID(ctx, ator.OrganizacaoID, id)
+	if err != nil {
+		return nil, err
+	}
+	if trilha.Publica() {
+		if err := autorizarCatalogoPublico(ator); err != nil {
+			return nil, err
+		}
+	}
+	return trilha, nil
+}
+
+// GetCompetencias lista as competências desenvolvidas por uma trilha.
+func (s *trilhaServiceImpl) GetCompetencias(ctx context.Context, ator *model.Usuario, trilhaID int64) ([]model.CompetenciaResponse, error) {
+	// 1. Validação de Existência: Trilha
+	if _, err := s.dao.FindByID(ctx, ator.OrganizacaoID, trilhaID); err != nil {
+		return nil, err
+	}
+
+	// 2. Busca no DAO
+	competencias, err := s.trilhaCompetenciaDAO.FindCompetenciasByTrilhaID(ctx, trilhaID)
+	if err != nil {
+		return nil, err
+	}
+	return toCompetenciaResponses(competencias), nil
+}
+
+// SetCompetencias substitui o conjunto de competências associadas a uma trilha.
+func (s *trilhaServiceImpl) SetCompetencias(ctx context.Context, ator *model.Usuario, trilhaID int64, competenciaIDs []int64) ([]model.CompetenciaResponse, error) {
+	// 1. Validação de Existência e Autorização: Trilha
+	if _, err := s.findTrilhaEditavel(ctx, ator, trilhaID); err != nil {
+		return nil, err
+	}
+
+	// 2. Validação de Existência: todas as competências informadas
+	ids := uniqueIDs(competenciaIDs)
+	if err := validarCompetencias(ctx, s.competenciaDAO, ids); err != nil {
+		return nil, err
+	}
+
+	// 3. Persistência
+	err := WithTx(ctx, s.tx, func(ctx context.Context) error {
+		return s.trilhaCompetenciaDAO.ReplaceAll(ctx, trilhaID, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetCompetencias(ctx, ator, trilhaID)
+}
+
+// AddCompetencia associa uma competência a uma trilha.
+func (s *trilhaServiceImpl) AddCompetencia(ctx context.Context, ator *model.Usuario, trilhaID, competenciaID int64) error {
+	if _, err := s.findTrilhaEditavel(ctx, ator, trilhaID); err != nil {
+		return err
+	}
+	if _, err := s.competenciaDAO.FindByID(ctx, competenciaID); err != nil {
+		return err
+	}
+
+	return s.trilhaCompetenciaDAO.Add(ctx, trilhaID, competenciaID)
+}
+
+// RemoveCompetencia desfaz a associação entre uma trilha e uma competência.
+func (s *trilhaServiceImpl) RemoveCompetencia(ctx context.Context, ator *model.Usuario, trilhaID, competenciaID int64) error {
+	if _, err := s.findTrilhaEditavel(ctx, ator, trilhaID); err != nil {
+		return err
+	}
+
+	return s.trilhaCompetenciaDAO.Remove(ctx, trilhaID, competenciaID)
+}
+
+// GetRequisitos retorna os requisitos de elegibilidade de uma trilha.
+func (s *trilhaServiceImpl) GetRequisitos(ctx context.Context, ator *model.Usuario, trilhaID int64) (*model.RequisitosResponse, error) {
+	requisitos, err := s.requisitoDAO.FindByTrilhaID(ctx, ator.OrganizacaoID, trilhaID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RequisitosResponse{
+		TrilhaID:               requisitos.TrilhaID,
+		NivelCarreiraMinimo:    requisitos.NivelCarreiraMinimo,
+		TrilhasPrerequisito:    toTrilhaResponses(requisitos.TrilhasPrerequisito),
+		CompetenciasRequeridas: toCompetenciaResponses(requisitos.CompetenciasRequeridas),
+	}, nil
+}
+
+// SetRequisitos substitui os requisitos de elegibilidade de uma trilha.
+func (s *trilhaServiceImpl) SetRequisitos(ctx context.Context, ator *model.Usuario, trilhaID int64, req *model.SetRequisitosRequest) (*model.RequisitosResponse, error) {
+	// 1. Validação de Existência e Autorização: Trilha
+	trilha, err := s.findTrilhaEditavel(ctx, ator, trilhaID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validação de Negócio: nível de carreira reconhecido
+	if req.NivelCarreiraMinimo != "" && model.RankNivelCarreira(req.NivelCarreiraMinimo) < 0 {
+		return nil, &model.BusinessRuleError{
+			Chave: "trilha.nivel_carreira_invalido",
+			Args:  []any{req.NivelCarreiraMinimo, model.NiveisCarreira},
+		}
+	}
+
+	// 3. Validação de Negócio: trilhas pré-requisito existentes e sem dependência circular.
+	// Uma trilha pública só pode depender de trilhas públicas, já que é vista por todas as
+	// organizações.
+	prerequisitoIDs := uniqueIDs(req.TrilhasPrerequisitoIDs)
+	for _, id := range prerequisitoIDs {
+		if id == trilhaID {
+			return nil, &model.BusinessRuleError{Chave: "trilha.prerequisito_de_si_mesma"}
+		}
+		prerequisito, err := s.dao.FindByID(ctx, ator.OrganizacaoID, id)
+		if err != nil {
+			if _, ok := err.(*model.ResourceNotFoundError); ok {
+				return nil, &model.BusinessRuleError{Chave: "trilha.prerequisito_nao_encontrado", Args: []any{id}}
+			}
+			return nil, err
+		}
+		if trilha.Publica() && !prerequisito.Publica() {
+			return nil, &model.BusinessRuleError{Chave: "trilha.prerequisito_privado", Args: []any{id}}
+		}
+	}
+	ciclo, err := s.requisitoDAO.CriaCiclo(ctx, trilhaID, prerequisitoIDs)
+	if err != nil {
+		return nil, err
+	}
+	if ciclo {
+		return nil, &model.BusinessRuleError{Chave: "trilha.prerequisito_circular"}
+	}
+
+	// 4. Validação de Existência: competências requeridas
+	competenciaIDs := uniqueIDs(req.CompetenciasRequeridasIDs)
+	if err := validarCompetencias(ctx, s.competenciaDAO, competenciaIDs); err != nil {
+		return nil, err
+	}
+
+	// 5. Persistência
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		return s.requisitoDAO.Replace(ctx, trilhaID, model.NormalizarNivelCarreira(req.NivelCarreiraMinimo), prerequisitoIDs, competenciaIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRequisitos(ctx, ator, trilhaID)
+}
+
+// validarCompetencias garante que todas as competências informadas existem.
+func validarCompetencias(ctx context.Context, competenciaDAO dao.CompetenciaDAO, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	encontradas, err := competenciaDAO.FindByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(encontradas) == len(ids) {
+		return nil
+	}
+
+	existentes := make(map[int64]bool, len(encontradas))
+	for _, c := range encontradas {
+		existentes[c.ID] = true
+	}
+	faltantes := make([]int64, 0)
+	for _, id := range ids {
+		if !existentes[id] {
+			faltantes = append(faltantes, id)
+		}
+	}
+	return &model.BusinessRuleError{Chave: "competencia.nao_encontradas", Args: []any{faltantes}}
+}
+
+// toTrilhaResponse mapeia a entidade Trilha para o DTO de resposta.
+func toTrilhaResponse(t *model.Trilha) *model.TrilhaResponse {
+	return &model.TrilhaResponse{
+		ID:            t.ID,
+		Nome:          t.Nome,
+		Descricao:     t.Descricao,
+		Nivel:         t.Nivel,
+		CargaHoraria:  t.CargaHoraria,
+		FocoPrincipal: t.FocoPrincipal,
+		Publica:       t.Publica(),
+		OrganizacaoID: t.OrganizacaoID,
+	}
+}
+
+// toTrilhaResponses mapeia uma lista de trilhas para DTOs de resposta.
+func toTrilhaResponses(trilhas []model.Trilha) []model.TrilhaResponse {
+	responses := make([]model.TrilhaResponse, len(trilhas))
+	for i := range trilhas {
+		responses[i] = *toTrilhaResponse(&trilhas[i])
+	}
+	return responses
+}
+
+// uniqueIDs remove IDs duplicados preservando a ordem original.
+func uniqueIDs(ids []int64) []int64 {
+	vistos := make(map[int64]bool, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !vistos[id] {
+			vistos[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
 }
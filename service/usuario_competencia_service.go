@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	"upskilling-api/dao"
+	"upskilling-api/model"
+)
+
+// UsuarioCompetenciaService é a interface para as operações de negócio do perfil de
+// competências do usuário.
+type UsuarioCompetenciaService interface {
+	FindByUsuario(ctx context.Context, ator *model.Usuario, usuarioID int64) ([]model.CompetenciaUsuario, error)
+	SetCompetencias(ctx context.Context, ator *model.Usuario, usuarioID int64, req *model.SetCompetenciasUsuarioRequest) ([]model.CompetenciaUsuario, error)
+}
+
+// usuarioCompetenciaServiceImpl implementa a interface UsuarioCompetenciaService.
+type usuarioCompetenciaServiceImpl struct {
+	tx             dao.Transacionador
+	dao            dao.UsuarioCompetenciaDAO
+	usuarioDAO     dao.UsuarioDAO
+	competenciaDAO dao.CompetenciaDAO
+}
+
+// NewUsuarioCompetenciaService cria uma nova instância de UsuarioCompetenciaService.
+func NewUsuarioCompetenciaService(tx dao.Transacionador, usuarioCompetenciaDAO dao.UsuarioCompetenciaDAO, usuarioDAO dao.UsuarioDAO, competenciaDAO dao.CompetenciaDAO) UsuarioCompetenciaService {
+	return &usuarioCompetenciaServiceImpl{
+		tx:             tx,
+		dao:            usuarioCompetenciaDAO,
+		usuarioDAO:     usuarioDAO,
+		competenciaDAO: competenciaDAO,
+	}
+}
+
+// FindByUsuario busca o perfil de competências de um usuário da organização. O próprio
+// usuário, seu gestor direto e os administradores podem consultá-lo.
+func (s *usuarioCompetenciaServiceImpl) FindByUsuario(ctx context.Context, ator *model.Usuario, usuarioID int64) ([]model.CompetenciaUsuario, error) {
+	if _, err := s.origemAvaliacao(ctx, ator, usuarioID); err != nil {
+		return nil, err
+	}
+	return s.dao.FindByUsuarioID(ctx, usuarioID)
+}
+
+// SetCompetencias substitui as avaliações de competências do usuário feitas por quem
+// envia a requisição: o próprio usuário registra sua autoavaliação; o gestor direto ou
+// um administrador registra a validação (origem GESTOR). As competências concedidas
+// por conclusão de trilha não são alteradas.
+func (s *usuarioCompetenciaServiceImpl) SetCompetencias(ctx context.Context, ator *model.Usuario, usuarioID int64, req *model.SetCompetenciasUsuarioRequest) ([]model.CompetenciaUsuario, error) {
+	// 1. Autorização (define a origem da avaliação)
+	origem, err := s.origemAvaliacao(ctx, ator, usuarioID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validação de Negócio: competências únicas e existentes
+	niveis, err := niveisCompetencias(ctx, s.competenciaDAO, req.Competencias)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Persistência
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		return s.dao.Replace(ctx, usuarioID, origem, niveis)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.dao.FindByUsuarioID(ctx, usuarioID)
+}
+
+// origemAvaliacao confirma que o usuário pertence à organização do ator e que o ator
+// pode acessar seu perfil de competências, devolvendo a origem das avaliações que ele
+// registra: AUTOAVALIACAO para o próprio usuário e GESTOR para o gestor direto ou admin.
+func (s *usuarioCompetenciaServiceImpl) origemAvaliacao(ctx context.Context, ator *model.Usuario, usuarioID int64) (string, error) {
+	usuario, err := s.usuarioDAO.FindByID(ctx, ator.OrganizacaoID, usuarioID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := autorizarUsuarioOuGestor(ator, usuario, model.PermGerenciarUsuarios); err != nil {
+		return "", err
+	}
+	if ator.ID == usuarioID {
+		return model.OrigemAutoavaliacao, nil
+	}
+	return model.OrigemGestor, nil
+}
+
+// niveisCompetencias converte a lista de níveis por competência em um mapa
+// (competência → nível), rejeitando competências repetidas ou inexistentes.
+func niveisCompetencias(ctx context.Context, competenciaDAO dao.CompetenciaDAO, itens []model.NivelCompetenciaRequest) (map[int64]int, error) {
+	niveis := make(map[int64]int, len(itens))
+	ids := make([]int64, 0, len(itens))
+	for _, c := range itens {
+		if _, duplicada := niveis[c.CompetenciaID]; duplicada {
+			return nil, &model.BusinessRuleError{Chave: "competencia.repetida", Args: []any{c.CompetenciaID}}
+		}
+		niveis[c.CompetenciaID] = c.Nivel
+		ids = append(ids, c.CompetenciaID)
+	}
+	if err := validarCompetencias(ctx, competenciaDAO, ids); err != nil {
+		return nil, err
+	}
+	return niveis, nil
+}
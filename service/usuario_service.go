@@ -1,7 +1,7 @@
 package service
 
 import (
-	"fmt"
+	"context"
 	"time"
 
 	"upskilling-api/dao"
@@ -10,106 +10,194 @@ import (
 
 // UsuarioService é a interface para as operações de negócio de Usuário.
 type UsuarioService interface {
-	Create(req *model.CreateUsuarioRequest) (*model.UsuarioResponse, error)
-	FindByID(id int64) (*model.UsuarioResponse, error)
-	FindAll() ([]model.UsuarioResponse, error)
-	Update(id int64, req *model.UpdateUsuarioRequest) (*model.UsuarioResponse, error)
-	Delete(id int64) error
+	Create(ctx context.Context, ator *model.Usuario, req *model.CreateUsuarioRequest) (*model.UsuarioResponse, error)
+	FindByID(ctx context.Context, ator *model.Usuario, id int64) (*model.UsuarioResponse, error)
+	FindAll(ctx context.Context, ator *model.Usuario, params model.ListParams) ([]model.UsuarioResponse, *model.Pagina, error)
+	Update(ctx context.Context, ator *model.Usuario, id int64, req *model.UpdateUsuarioRequest) (*model.UsuarioResponse, error)
+	Delete(ctx context.Context, ator *model.Usuario, id int64) error
+	SetPapel(ctx context.Context, ator *model.Usuario, id int64, papel string) (*model.UsuarioResponse, error)
 }
 
 // usuarioServiceImpl implementa a interface UsuarioService.
 type usuarioServiceImpl struct {
-	dao dao.UsuarioDAO
+	tx              dao.Transacionador
+	dao             dao.UsuarioDAO
+	organizacaoDAO  dao.OrganizacaoDAO
+	refreshTokenDAO dao.RefreshTokenDAO
 }
 
 // NewUsuarioService cria uma nova instância de UsuarioService.
-func NewUsuarioService() UsuarioService {
+func NewUsuarioService(tx dao.Transacionador, usuarioDAO dao.UsuarioDAO, organizacaoDAO dao.OrganizacaoDAO, refreshTokenDAO dao.RefreshTokenDAO) UsuarioService {
 	return &usuarioServiceImpl{
-		dao: dao.NewUsuarioDAO(),
+		tx:              tx,
+		dao:             usuarioDAO,
+		organizacaoDAO:  organizacaoDAO,
+		refreshTokenDAO: refreshTokenDAO,
 	}
 }
 
-// Create cria um novo usuário após validações de negócio.
-func (s *usuarioServiceImpl) Create(req *model.CreateUsuarioRequest) (*model.UsuarioResponse, error) {
-	// 1. Validação de Negócio: Verificar se o email já existe
-	existingUser, err := s.dao.FindByEmail(req.Email)
+// Create cadastra um novo usuário na organização do administrador autenticado.
+func (s *usuarioServiceImpl) Create(ctx context.Context, ator *model.Usuario, req *model.CreateUsuarioRequest) (*model.UsuarioResponse, error) {
+	// 0. Autorização
+	if !ator.TemPermissao(model.PermGerenciarUsuarios) {
+		return nil, &model.ForbiddenError{Chave: "acesso.cadastrar_usuarios"}
+	}
+
+	// 1. Validação de Negócio: email único; equipe e gestor da mesma organização
+	usuario, err := novoUsuario(ctx, s.dao, ator.OrganizacaoID, req)
+	if err != nil {
+		return nil, err
+	}
+	usuario.OrganizacaoID = ator.OrganizacaoID
+	if err := s.validarEquipe(ctx, ator.OrganizacaoID, req.EquipeID); err != nil {
+		return nil, err
+	}
+	if err := s.validarGestor(ctx, ator.OrganizacaoID, 0, req.GestorID); err != nil {
+		return nil, err
+	}
+	usuario.GestorID = req.GestorID
+
+	// 2. Persistência
+	if err := s.dao.Create(ctx, usuario); err != nil {
+		return nil, err
+	}
+
+	// 3. Mapeamento Entidade para Response DTO
+	return toUsuarioResponse(usuario), nil
+}
+
+// novoUsuario valida o email e o nível de carreira e mapeia o DTO para a entidade (a senha é armazenada
+// apenas como hash). Também usado no registro de organizações, com organizacaoID 0.
+//
+// O email é único em toda a plataforma, mas só se confirma que ele já está cadastrado quando
+// o usuário existente pertence à mesma organização; de outra organização, o conflito é
+// genérico, para não revelar quem tem conta em outros tenants.
+func novoUsuario(ctx context.Context, usuarioDAO dao.UsuarioDAO, organizacaoID int64, req *model.CreateUsuarioRequest) (*model.Usuario, error) {
+	existingUser, err := usuarioDAO.FindByEmail(ctx, req.Email)
 	if err != nil {
 		return nil, err
 	}
 	if existingUser != nil {
-		return nil, &model.ConflictError{Msg: fmt.Sprintf("O email '%s' já está cadastrado.", req.Email)}
+		if existingUser.OrganizacaoID == organizacaoID {
+			return nil, &model.ConflictError{Chave: "usuario.email_ja_cadastrado", Args: []any{req.Email}, Codigo: model.CodigoEmailJaCadastrado}
+		}
+		return nil, &model.ConflictError{Chave: "usuario.email_indisponivel"}
 	}
 
-	// 2. Mapeamento DTO para Entidade
-	usuario := &model.Usuario{
+	if err := validarNivelCarreira(req.NivelCarreira); err != nil {
+		return nil, err
+	}
+
+	senhaHash, err := hashSenha(req.Senha)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Usuario{
 		Nome:          req.Nome,
 		Email:         req.Email,
 		AreaAtuacao:   req.AreaAtuacao,
-		NivelCarreira: req.NivelCarreira,
+		NivelCarreira: model.NormalizarNivelCarreira(req.NivelCarreira),
 		DataCadastro:  time.Now(), // Será sobrescrito pelo valor do DB, mas é bom ter um default
+		SenhaHash:     senhaHash,
+		EquipeID:      req.EquipeID,
+	}, nil
+}
+
+// validarEquipe garante que a equipe informada (opcional) pertence à organização.
+func (s *usuarioServiceImpl) validarEquipe(ctx context.Context, organizacaoID int64, equipeID *int64) error {
+	if equipeID == nil {
+		return nil
 	}
+	if _, err := s.organizacaoDAO.FindEquipeByID(ctx, organizacaoID, *equipeID); err != nil {
+		if _, ok := err.(*model.ResourceNotFoundError); ok {
+			return &model.BusinessRuleError{Chave: "usuario.equipe_inexistente", Args: []any{*equipeID}}
+		}
+		return err
+	}
+	return nil
+}
 
-	// 3. Persistência
-	if err := s.dao.Create(usuario); err != nil {
-		return nil, err
+// validarGestor garante que o gestor informado (opcional) pertence à organização, tem papel
+// manager ou admin e não cria um ciclo na hierarquia. usuarioID é 0 em novos cadastros.
+func (s *usuarioServiceImpl) validarGestor(ctx context.Context, organizacaoID, usuarioID int64, gestorID *int64) error {
+	if gestorID == nil {
+		return nil
+	}
+	if *gestorID == usuarioID {
+		return &model.BusinessRuleError{Chave: "usuario.gestor_de_si_mesmo"}
 	}
 
-	// 4. Mapeamento Entidade para Response DTO
-	return &model.UsuarioResponse{
-		ID:            usuario.ID,
-		Nome:          usuario.Nome,
-		Email:         usuario.Email,
-		AreaAtuacao:   usuario.AreaAtuacao,
-		NivelCarreira: usuario.NivelCarreira,
-		DataCadastro:  usuario.DataCadastro,
-	}, nil
+	gestor, err := s.dao.FindByID(ctx, organizacaoID, *gestorID)
+	if err != nil {
+		if _, ok := err.(*model.ResourceNotFoundError); ok {
+			return &model.BusinessRuleError{Chave: "usuario.gestor_inexistente", Args: []any{*gestorID}}
+		}
+		return err
+	}
+	if gestor.Papel != model.PapelManager && gestor.Papel != model.PapelAdmin {
+		return &model.BusinessRuleError{Chave: "usuario.gestor_sem_papel", Args: []any{*gestorID, gestor.Papel}}
+	}
+
+	if usuarioID != 0 {
+		ciclo, err := s.dao.CriaCicloGestor(ctx, usuarioID, *gestorID)
+		if err != nil {
+			return err
+		}
+		if ciclo {
+			return &model.BusinessRuleError{Chave: "usuario.ciclo_gestor"}
+		}
+	}
+	return nil
 }
 
-// FindByID busca um usuário pelo ID.
-func (s *usuarioServiceImpl) FindByID(id int64) (*model.UsuarioResponse, error) {
-	usuario, err := s.dao.FindByID(id)
+// FindByID busca um usuário pelo ID. Learners só podem consultar o próprio perfil.
+func (s *usuarioServiceImpl) FindByID(ctx context.Context, ator *model.Usuario, id int64) (*model.UsuarioResponse, error) {
+	if err := autorizarUsuario(ator, id, model.PermGerenciarUsuarios); err != nil {
+		return nil, err
+	}
+
+	usuario, err := s.dao.FindByID(ctx, ator.OrganizacaoID, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.UsuarioResponse{
-		ID:            usuario.ID,
-		Nome:          usuario.Nome,
-		Email:         usuario.Email,
-		AreaAtuacao:   usuario.AreaAtuacao,
-		NivelCarreira: usuario.NivelCarreira,
-		DataCadastro:  usuario.DataCadastro,
-	}, nil
+	return toUsuarioResponse(usuario), nil
 }
 
-// FindAll busca todos os usuários.
-func (s *usuarioServiceImpl) FindAll() ([]model.UsuarioResponse, error) {
-	usuarios, err := s.dao.FindAll()
+// FindAll busca uma página dos usuários da organização, com filtros e ordenação.
+func (s *usuarioServiceImpl) FindAll(ctx context.Context, ator *model.Usuario, params model.ListParams) ([]model.UsuarioResponse, *model.Pagina, error) {
+	if !ator.TemPermissao(model.PermGerenciarUsuarios) {
+		return nil, nil, &model.ForbiddenError{Chave: "acesso.listar_usuarios"}
+	}
+
+	usuarios, pagina, err := s.dao.FindAll(ctx, ator.OrganizacaoID, params)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	responses := make([]model.UsuarioResponse, len(usuarios))
-	for i, u := range usuarios {
-		responses[i] = model.UsuarioResponse{
-			ID:            u.ID,
-			Nome:          u.Nome,
-			Email:         u.Email,
-			AreaAtuacao:   u.AreaAtuacao,
-			NivelCarreira: u.NivelCarreira,
-			DataCadastro:  u.DataCadastro,
-		}
+	for i := range usuarios {
+		responses[i] = *toUsuarioResponse(&usuarios[i])
 	}
-	return responses, nil
+	return responses, pagina, nil
 }
 
-// Update atualiza um usuário existente.
-func (s *usuarioServiceImpl) Update(id int64, req *model.UpdateUsuarioRequest) (*model.UsuarioResponse, error) {
-	// 1. Buscar o usuário existente
-	usuario, err := s.dao.FindByID(id)
+// Update atualiza um usuário existente. Learners só podem alterar o próprio perfil, exceto
+// o nível de carreira, definido pelo gestor direto ou por administradores.
+func (s *usuarioServiceImpl) Update(ctx context.Context, ator *model.Usuario, id int64, req *model.UpdateUsuarioRequest) (*model.UsuarioResponse, error) {
+	// 1. Buscar o usuário existente e autorizar: o próprio usuário e os administradores
+	// atualizam o perfil; o gestor direto só altera o nível de carreira do liderado
+	usuario, err := s.dao.FindByID(ctx, ator.OrganizacaoID, id)
 	if err != nil {
 		return nil, err // Trata ResourceNotFoundError
 	}
+	if err := autorizarUsuarioOuGestor(ator, usuario, model.PermGerenciarUsuarios); err != nil {
+		return nil, err
+	}
+	gestorDireto := usuario.GestorID != nil && *usuario.GestorID == ator.ID
+	if ator.ID != id && !ator.TemPermissao(model.PermGerenciarUsuarios) && !apenasNivelCarreira(req) {
+		return nil, &model.ForbiddenError{Chave: "acesso.dados_de_outro_usuario", Args: []any{ator.ID, id}}
+	}
 
 	// 2. Aplicar as atualizações (apenas campos fornecidos)
 	if req.Nome != "" {
@@ -119,15 +207,126 @@ func (s *usuarioServiceImpl) Update(id int64, req *model.UpdateUsuarioRequest) (
 		usuario.AreaAtuacao = req.AreaAtuacao
 	}
 	if req.NivelCarreira != "" {
-		usuario.NivelCarreira = req.NivelCarreira
+		// O nível de carreira decide a elegibilidade às trilhas (nivel_carreira_minimo): só
+		// o gestor direto e os administradores o alteram
+		if !ator.TemPermissao(model.PermGerenciarUsuarios) && !gestorDireto {
+			return nil, &model.ForbiddenError{Chave: "acesso.alterar_nivel_carreira"}
+		}
+		if err := validarNivelCarreira(req.NivelCarreira); err != nil {
+			return nil, err
+		}
+		usuario.NivelCarreira = model.NormalizarNivelCarreira(req.NivelCarreira)
+	}
+	if req.Senha != "" {
+		// Trocar a própria senha exige a senha atual, para que um access token vazado não
+		// baste para tomar a conta; administradores redefinem a de outros usuários sem ela
+		if ator.ID == id {
+			if err := s.conferirSenhaAtual(ctx, usuario.Email, req.SenhaAtual); err != nil {
+				return nil, err
+			}
+		}
+		if usuario.SenhaHash, err = hashSenha(req.Senha); err != nil {
+			return nil, err
+		}
+	}
+	if req.EquipeID != nil {
+		// A equipe é definida pelo administrador, não pelo próprio usuário
+		if !ator.TemPermissao(model.PermGerenciarUsuarios) {
+			return nil, &model.ForbiddenError{Chave: "acesso.alterar_equipe_usuario"}
+		}
+		if err := s.validarEquipe(ctx, ator.OrganizacaoID, req.EquipeID); err != nil {
+			return nil, err
+		}
+		usuario.EquipeID = req.EquipeID
+	}
+	if req.GestorID != nil {
+		if !ator.TemPermissao(model.PermGerenciarUsuarios) {
+			return nil, &model.ForbiddenError{Chave: "acesso.alterar_gestor_usuario"}
+		}
+		if err := s.validarGestor(ctx, ator.OrganizacaoID, id, req.GestorID); err != nil {
+			return nil, err
+		}
+		usuario.GestorID = req.GestorID
 	}
 
-	// 3. Persistência
-	if err := s.dao.Update(usuario); err != nil {
+	// 3. Persistência e, na troca de senha, encerramento das sessões abertas, na mesma
+	// transação: se a revogação falhar, a senha antiga é mantida
+	err = WithTx(ctx, s.tx, func(ctx context.Context) error {
+		if err := s.dao.Update(ctx, ator.OrganizacaoID, usuario); err != nil {
+			return err
+		}
+		if req.Senha != "" {
+			return s.refreshTokenDAO.RevokeAllByUsuario(ctx, id)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	// 4. Mapeamento Entidade para Response DTO
+	return toUsuarioResponse(usuario), nil
+}
+
+// apenasNivelCarreira indica se a atualização altera somente o nível de carreira.
+func apenasNivelCarreira(req *model.UpdateUsuarioRequest) bool {
+	return req.NivelCarreira != "" && req.Nome == "" && req.AreaAtuacao == "" && req.Senha == "" &&
+		req.EquipeID == nil && req.GestorID == nil
+}
+
+// validarNivelCarreira garante que o nível de carreira (opcional) é um dos reconhecidos.
+func validarNivelCarreira(nivel string) error {
+	if nivel != "" && model.RankNivelCarreira(nivel) < 0 {
+		return &model.BusinessRuleError{Chave: "usuario.nivel_carreira_invalido", Args: []any{nivel, model.NiveisCarreira}}
+	}
+	return nil
+}
+
+// conferirSenhaAtual verifica a senha atual do usuário com o email informado.
+func (s *usuarioServiceImpl) conferirSenhaAtual(ctx context.Context, email, senhaAtual string) error {
+	usuario, err := s.dao.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if usuario == nil || !senhaConfere(usuario.SenhaHash, senhaAtual) {
+		return &model.ForbiddenError{Chave: "acesso.senha_atual_incorreta"}
+	}
+	return nil
+}
+
+// Delete remove um usuário da organização pelo ID.
+func (s *usuarioServiceImpl) Delete(ctx context.Context, ator *model.Usuario, id int64) error {
+	if !ator.TemPermissao(model.PermGerenciarUsuarios) {
+		return &model.ForbiddenError{Chave: "acesso.remover_usuarios"}
+	}
+	return s.dao.Delete(ctx, ator.OrganizacaoID, id)
+}
+
+// SetPapel altera o papel de um usuário. Exige permissão de gerenciar usuários; um
+// administrador não pode alterar o próprio papel (evita ficar sem administradores).
+func (s *usuarioServiceImpl) SetPapel(ctx context.Context, ator *model.Usuario, id int64, papel string) (*model.UsuarioResponse, error) {
+	// 1. Autorização
+	if !ator.TemPermissao(model.PermGerenciarUsuarios) {
+		return nil, &model.ForbiddenError{Chave: "acesso.alterar_papeis"}
+	}
+	if ator.ID == id {
+		return nil, &model.BusinessRuleError{Chave: "usuario.alterar_proprio_papel"}
+	}
+
+	// 2. Persistência
+	if err := s.dao.UpdatePapel(ctx, ator.OrganizacaoID, id, papel); err != nil {
+		return nil, err
+	}
+
+	usuario, err := s.dao.FindByID(ctx, ator.OrganizacaoID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toUsuarioResponse(usuario), nil
+}
+
+// toUsuarioResponse converte a entidade no DTO de resposta.
+func toUsuarioResponse(usuario *model.Usuario) *model.UsuarioResponse {
 	return &model.UsuarioResponse{
 		ID:            usuario.ID,
 		Nome:          usuario.Nome,
@@ -135,10 +334,9 @@ func (s *usuarioServiceImpl) Update(id int64, req *model.UpdateUsuarioRequest) (
 		AreaAtuacao:   usuario.AreaAtuacao,
 		NivelCarreira: usuario.NivelCarreira,
 		DataCadastro:  usuario.DataCadastro,
-	}, nil
-}
-
-// Delete remove um usuário pelo ID.
-func (s *usuarioServiceImpl) Delete(id int64) error {
-	return s.dao.Delete(id)
+		Papel:         usuario.Papel,
+		OrganizacaoID: usuario.OrganizacaoID,
+		EquipeID:      usuario.EquipeID,
+		GestorID:      usuario.GestorID,
+	}
 }
@@ -4,10 +4,10 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"os"
 
+	"upskilling-api/app"
 	"upskilling-api/controller"
-	"upskilling-api/db"
+	"upskilling-api/model"
 
 	_ "upskilling-api/docs"
 
@@ -22,6 +22,10 @@ import (
 // @description API RESTful para uma plataforma de Upskilling/Reskilling voltada ao futuro do trabalho (2030+).
 // @host localhost:8080
 // @BasePath /api/v1
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Access token obtido em /auth/login, no formato "Bearer <token>".
 func main() {
 	// Carrega variáveis de ambiente do arquivo .env
 	err := godotenv.Load()
@@ -29,12 +33,33 @@ func main() {
 		log.Println("Aviso: Não foi possível carregar o arquivo .env. Usando variáveis de ambiente do sistema.")
 	}
 
-	// Inicializa a conexão com o banco de dados
-	db.InitDB()
-	defer db.CloseDB()
+	// Monta a aplicação: configuração → banco de dados → DAOs → services → controllers
+	cfg, err := app.ConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Erro na configuração: %v", err)
+	}
+	a, err := app.New(cfg)
+	if err != nil {
+		log.Fatalf("Erro ao iniciar a aplicação: %v", err)
+	}
+	defer a.Close()
+
+	router := newRouter(a)
+
+	log.Printf("Servidor iniciado na porta: %s", cfg.Porta)
+	if err := router.Run(fmt.Sprintf(":%s", cfg.Porta)); err != nil {
+		log.Fatalf("Erro ao iniciar o servidor: %v", err)
+	}
+}
+
+// newRouter registra as rotas da API sobre os controllers da aplicação. Os middlewares
+// informados são executados antes de todos os demais (ex: instrumentação nos testes).
+func newRouter(a *app.App, middlewares ...gin.HandlerFunc) *gin.Engine {
+	ctl := a.Controllers
 
 	// Configura o Gin
 	router := gin.Default()
+	router.Use(middlewares...)
 
 	// Swagger UI
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -50,41 +75,126 @@ func main() {
 	// Rotas da API
 	v1 := router.Group("/api/v1")
 	{
-		// Rotas de Usuários (CRUD)
-		usuarios := v1.Group("/usuarios")
+		// Rotas públicas: autenticação
+		auth := v1.Group("/auth")
+		{
+			auth.POST("/login", ctl.Auth.Login)
+			auth.POST("/refresh", ctl.Auth.RefreshToken)
+			auth.POST("/logout", ctl.Auth.Logout)
+		}
+
+		// Demais rotas exigem access token (Authorization: Bearer <token>)
+		autenticado := v1.Group("", controller.AuthMiddleware(a.Services.Auth))
+
+		// Permissões por papel (learners acessam apenas os próprios dados; ver services)
+		gerenciarCatalogo := controller.RequirePermissao(model.PermGerenciarCatalogo)
+		gerenciarUsuarios := controller.RequirePermissao(model.PermGerenciarUsuarios)
+
+		// Registro de organização (com seu primeiro admin), pelos admins da plataforma
+		autenticado.POST("/organizacoes", gerenciarUsuarios, ctl.Organizacao.RegistrarOrganizacao)
+
+		// Organização do usuário autenticado e suas equipes
+		organizacao := autenticado.Group("/organizacao")
+		{
+			organizacao.GET("", ctl.Organizacao.GetOrganizacaoAtual)
+			organizacao.GET("/equipes", ctl.Organizacao.GetEquipes)
+			organizacao.POST("/equipes", gerenciarUsuarios, ctl.Organizacao.CreateEquipe)
+			organizacao.PUT("/equipes/:id", gerenciarUsuarios, ctl.Organizacao.UpdateEquipe)
+			organizacao.DELETE("/equipes/:id", gerenciarUsuarios, ctl.Organizacao.DeleteEquipe)
+		}
+
+		// Rotas de Usuários (CRUD), restritas à organização do usuário autenticado
+		usuarios := autenticado.Group("/usuarios")
 		{
-			usuarios.POST("/", controller.CreateUsuario)
-			usuarios.GET("/", controller.GetAllUsuarios)
-			usuarios.GET("/:id", controller.GetUsuarioByID)
-			usuarios.PUT("/:id", controller.UpdateUsuario)
-			usuarios.DELETE("/:id", controller.DeleteUsuario)
+			usuarios.POST("/", gerenciarUsuarios, ctl.Usuario.CreateUsuario)
+			usuarios.GET("/", gerenciarUsuarios, ctl.Usuario.GetAllUsuarios)
+			usuarios.GET("/:id", ctl.Usuario.GetUsuarioByID)
+			usuarios.PUT("/:id", ctl.Usuario.UpdateUsuario)
+			usuarios.DELETE("/:id", gerenciarUsuarios, ctl.Usuario.DeleteUsuario)
+			usuarios.PUT("/:id/papel", gerenciarUsuarios, ctl.Usuario.SetPapelUsuario)
+
+			// Perfil de competências (autoavaliação e validação do gestor)
+			usuarios.GET("/:id/competencias", ctl.UsuarioCompetencia.GetCompetenciasUsuario)
+			usuarios.PUT("/:id/competencias", ctl.UsuarioCompetencia.SetCompetenciasUsuario)
+			usuarios.GET("/:id/gap", ctl.Cargo.GetGapCompetencias)
+			usuarios.GET("/:id/recomendacoes", ctl.Recomendacao.GetRecomendacoes)
 		}
 
 		// Rotas de Trilhas (CRUD)
-		trilhas := v1.Group("/trilhas")
+		trilhas := autenticado.Group("/trilhas")
 		{
-			trilhas.POST("/", controller.CreateTrilha)
-			trilhas.GET("/", controller.GetAllTrilhas)
-			trilhas.GET("/:id", controller.GetTrilhaByID)
-			trilhas.PUT("/:id", controller.UpdateTrilha)
-			trilhas.DELETE("/:id", controller.DeleteTrilha)
+			trilhas.POST("/", gerenciarCatalogo, ctl.Trilha.CreateTrilha)
+			trilhas.GET("/", ctl.Trilha.GetAllTrilhas)
+			trilhas.GET("/:id", ctl.Trilha.GetTrilhaByID)
+			trilhas.PUT("/:id", gerenciarCatalogo, ctl.Trilha.UpdateTrilha)
+			trilhas.DELETE("/:id", gerenciarCatalogo, ctl.Trilha.DeleteTrilha)
+
+			// Associações Trilha ↔ Competência
+			trilhas.GET("/:id/competencias", ctl.Trilha.GetCompetenciasByTrilha)
+			trilhas.PUT("/:id/competencias", gerenciarCatalogo, ctl.Trilha.SetCompetenciasTrilha)
+			trilhas.POST("/:id/competencias/:competenciaId", gerenciarCatalogo, ctl.Trilha.AddCompetenciaTrilha)
+			trilhas.DELETE("/:id/competencias/:competenciaId", gerenciarCatalogo, ctl.Trilha.RemoveCompetenciaTrilha)
+
+			// Requisitos de elegibilidade
+			trilhas.GET("/:id/requisitos", ctl.Trilha.GetRequisitosTrilha)
+			trilhas.PUT("/:id/requisitos", gerenciarCatalogo, ctl.Trilha.SetRequisitosTrilha)
+
+			// Conteúdo estruturado: Módulos → Aulas
+			trilhas.GET("/:id/modulos", ctl.Modulo.GetModulosByTrilha)
+			trilhas.POST("/:id/modulos", gerenciarCatalogo, ctl.Modulo.CreateModulo)
+			trilhas.GET("/:id/modulos/:moduloId", ctl.Modulo.GetModuloByID)
+			trilhas.PUT("/:id/modulos/:moduloId", gerenciarCatalogo, ctl.Modulo.UpdateModulo)
+			trilhas.DELETE("/:id/modulos/:moduloId", gerenciarCatalogo, ctl.Modulo.DeleteModulo)
+			trilhas.POST("/:id/modulos/:moduloId/aulas", gerenciarCatalogo, ctl.Modulo.CreateAula)
+			trilhas.PUT("/:id/modulos/:moduloId/aulas/:aulaId", gerenciarCatalogo, ctl.Modulo.UpdateAula)
+			trilhas.DELETE("/:id/modulos/:moduloId/aulas/:aulaId", gerenciarCatalogo, ctl.Modulo.DeleteAula)
 		}
 
+		// Rotas de Competências (CRUD)
+		competencias := autenticado.Group("/competencias")
+		{
+			competencias.POST("/", gerenciarCatalogo, ctl.Competencia.CreateCompetencia)
+			competencias.GET("/", ctl.Competencia.GetAllCompetencias)
+			competencias.GET("/:id", ctl.Competencia.GetCompetenciaByID)
+			competencias.PUT("/:id", gerenciarCatalogo, ctl.Competencia.UpdateCompetencia)
+			competencias.DELETE("/:id", gerenciarCatalogo, ctl.Competencia.DeleteCompetencia)
+			competencias.GET("/:id/trilhas", ctl.Competencia.GetTrilhasByCompetencia)
+		}
+
+		// Rotas de Cargos-alvo (competências e níveis exigidos)
+		cargos := autenticado.Group("/cargos")
+		{
+			cargos.POST("/", gerenciarCatalogo, ctl.Cargo.CreateCargo)
+			cargos.GET("/", ctl.Cargo.GetAllCargos)
+			cargos.GET("/:id", ctl.Cargo.GetCargoByID)
+			cargos.PUT("/:id", gerenciarCatalogo, ctl.Cargo.UpdateCargo)
+			cargos.DELETE("/:id", gerenciarCatalogo, ctl.Cargo.DeleteCargo)
+			cargos.PUT("/:id/competencias", gerenciarCatalogo, ctl.Cargo.SetCompetenciasCargo)
+		}
+
+		// Rota de Busca
+		autenticado.GET("/search", ctl.Busca.Search)
+
 		// Rotas de Inscrição (Extra)
-		v1.POST("/matriculas", controller.MatricularUsuario)
-		v1.GET("/usuarios/:id/matriculas", controller.GetMatriculasByUsuario)
+		autenticado.POST("/matriculas", ctl.Matricula.MatricularUsuario)
+		autenticado.GET("/matriculas/:id", ctl.Matricula.GetMatriculaByID)
+		autenticado.POST("/matriculas/:id/concluir", ctl.Matricula.ConcluirMatricula)
+		autenticado.POST("/matriculas/:id/cancelar", ctl.Matricula.CancelarMatricula)
+		autenticado.POST("/matriculas/:id/reativar", ctl.Matricula.ReativarMatricula)
+		autenticado.POST("/matriculas/:id/sessoes", ctl.Matricula.RegistrarSessaoEstudo)
+		autenticado.GET("/matriculas/:id/sessoes", ctl.Matricula.GetSessoesEstudo)
+		autenticado.GET("/matriculas/:id/progresso", ctl.Matricula.GetProgressoMatricula)
+		autenticado.POST("/matriculas/:id/aulas/:aulaId/concluir", ctl.Matricula.ConcluirAulaMatricula)
+		autenticado.GET("/usuarios/:id/matriculas", ctl.Matricula.GetMatriculasByUsuario)
+		autenticado.GET("/usuarios/:id/elegibilidade/:trilhaId", ctl.Matricula.GetElegibilidade)
+
+		// Painel do gestor (liderados diretos)
+		autenticado.GET("/usuarios/:id/liderados", ctl.PainelGestor.GetPainelGestor)
+		autenticado.GET("/usuarios/:id/liderados/matriculas", ctl.PainelGestor.GetMatriculasLiderados)
 	}
 
 	// Rota para documentação Swagger (se gerada localmente)
 	// router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
-	}
-
-	log.Printf("Servidor iniciado na porta: %s", port)
-	if err := router.Run(fmt.Sprintf(":%s", port)); err != nil {
-		log.Fatalf("Erro ao iniciar o servidor: %v", err)
-	}
+	return router
 }
@@ -0,0 +1,1069 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"upskilling-api/app"
+	"upskilling-api/controller"
+	"upskilling-api/dao"
+	"upskilling-api/dao/memoria"
+	"upskilling-api/model"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Organizações usadas nos testes: a da plataforma (catálogo público), a principal e uma
+// terceira, usada para verificar o isolamento entre organizações.
+const (
+	orgPlataforma int64 = 1
+	orgAcme       int64 = 2
+	orgOutra      int64 = 3
+)
+
+const senhaTeste = "senha-de-teste"
+
+func init() {
+	gin.SetMode(gin.TestMode)
+	gin.DefaultWriter = io.Discard
+}
+
+// ambiente é a API montada sobre DAOs em memória, com usuários e trilhas pré-cadastrados
+// e os access tokens de cada usuário.
+type ambiente struct {
+	t      *testing.T
+	router *gin.Engine
+	rota   string // rota registrada ("MÉTODO padrão") que atendeu a última requisição
+
+	usuarios map[string]*model.Usuario // por apelido: curador, plataforma, admin, gestor, learner, outro
+	tokens   map[string]string         // access token por apelido
+
+	trilhaPublica *model.Trilha
+	trilhaAcme    *model.Trilha
+	trilhaOutra   *model.Trilha
+	niveis        map[int64]string // nível de carreira mínimo por trilha (requisitosLivres)
+}
+
+// requisitosLivres é um RequisitoDAO sem pré-requisitos nem competências requeridas: as
+// trilhas visíveis só exigem o nível de carreira mínimo registrado em niveis (trilha →
+// nível), quando houver. Os demais métodos não são usados pelos cenários em memória.
+type requisitosLivres struct {
+	dao.RequisitoDAO
+	trilhas dao.TrilhaDAO
+	niveis  map[int64]string
+}
+
+func (r requisitosLivres) FindByTrilhaID(ctx context.Context, organizacaoID, trilhaID int64) (*model.RequisitosTrilha, error) {
+	if _, err := r.trilhas.FindByID(ctx, organizacaoID, trilhaID); err != nil {
+		return nil, err
+	}
+	return &model.RequisitosTrilha{TrilhaID: trilhaID, NivelCarreiraMinimo: r.niveis[trilhaID]}, nil
+}
+
+// Fakes com dados fixos para os DAOs sem implementação em memória. Implementam apenas as
+// consultas usadas pelos casos de sucesso de TestRotas; os demais métodos não são usados.
+
+// competenciaFixa e cargoFixo são pré-cadastrados no catálogo público; gapFixo os compara.
+var (
+	competenciaFixa = model.Competencia{ID: 1, Nome: "Análise de Dados", Categoria: "Tecnologia"}
+	cargoFixo       = model.Cargo{ID: 1, Nome: "Analista de Dados"}
+)
+
+// modulosFixos traz um único módulo, da trilha informada, com uma aula.
+type modulosFixos struct {
+	dao.ModuloDAO
+	modulo model.Modulo
+}
+
+func (d modulosFixos) FindByID(ctx context.Context, id int64) (*model.Modulo, error) {
+	if id != d.modulo.ID {
+		return nil, &model.ResourceNotFoundError{Resource: "Módulo", ID: id}
+	}
+	m := d.modulo
+	return &m, nil
+}
+
+func (d modulosFixos) FindByTrilhaID(ctx context.Context, trilhaID int64) ([]model.Modulo, error) {
+	if trilhaID != d.modulo.TrilhaID {
+		return []model.Modulo{}, nil
+	}
+	return []model.Modulo{d.modulo}, nil
+}
+
+type aulasFixas struct{ dao.AulaDAO }
+
+func (aulasFixas) FindByModuloIDs(ctx context.Context, moduloIDs []int64) (map[int64][]model.Aula, error) {
+	aulas := make(map[int64][]model.Aula, len(moduloIDs))
+	for _, id := range moduloIDs {
+		aulas[id] = []model.Aula{{ID: id, ModuloID: id, Titulo: "Introdução", Tipo: "VIDEO", Ordem: 1, DuracaoMinutos: 30}}
+	}
+	return aulas, nil
+}
+
+// gapFixo compara qualquer perfil com o cargo fixo: a competência fixa é exigida no
+// nível 3 e o usuário não a possui.
+type gapFixo struct{ dao.GapCompetenciasDAO }
+
+func (gapFixo) CompararPerfil(ctx context.Context, cargoID, usuarioID int64) ([]model.LacunaCompetencia, error) {
+	return []model.LacunaCompetencia{{CompetenciaID: competenciaFixa.ID, Nome: competenciaFixa.Nome, NivelRequerido: 3}}, nil
+}
+
+func (gapFixo) FindTrilhasPorCompetencias(ctx context.Context, organizacaoID, usuarioID int64, competenciaIDs []int64) (map[int64][]model.TrilhaLacuna, error) {
+	return map[int64][]model.TrilhaLacuna{}, nil
+}
+
+// recomendacoesFixas recomenda sempre a mesma trilha.
+type recomendacoesFixas struct {
+	dao.RecomendacaoDAO
+	trilha *model.Trilha
+}
+
+func (d recomendacoesFixas) FindRecomendacoes(ctx context.Context, organizacaoID int64, usuario *model.Usuario, nivelIndicado string, niveisCarreira []string, limit, offset int) ([]model.RecomendacaoTrilha, int, error) {
+	return []model.RecomendacaoTrilha{{
+		TrilhaID:     d.trilha.ID,
+		Nome:         d.trilha.Nome,
+		Nivel:        d.trilha.Nivel,
+		CargaHoraria: d.trilha.CargaHoraria,
+		Pontuacao:    1,
+		Sinais:       model.SinaisRecomendacao{Matriculas: 1},
+	}}, 1, nil
+}
+
+// painelFixo resume o liderado informado, ainda sem matrículas, para o gestor dele.
+type painelFixo struct {
+	dao.PainelGestorDAO
+	liderado *model.Usuario
+}
+
+func (d painelFixo) ResumoLiderados(ctx context.Context, organizacaoID, gestorID int64) ([]model.ResumoLiderado, error) {
+	if d.liderado.GestorID == nil || *d.liderado.GestorID != gestorID {
+		return []model.ResumoLiderado{}, nil
+	}
+	return []model.ResumoLiderado{{UsuarioID: d.liderado.ID, Nome: d.liderado.Nome, Email: d.liderado.Email}}, nil
+}
+
+// novoAmbiente monta a aplicação com os DAOs em memória e, para os demais, fakes com dados
+// fixos. Rotas cujas operações não têm implementação são exercitadas apenas até a
+// autenticação, a autorização ou a validação de entrada.
+func novoAmbiente(t *testing.T) *ambiente {
+	t.Helper()
+
+	banco := memoria.NewBanco()
+	for i, o := range []model.Organizacao{
+		{Nome: "Plataforma", Slug: "plataforma", Plataforma: true},
+		{Nome: "Acme", Slug: "acme"},
+		{Nome: "Outra", Slug: "outra"},
+	} {
+		banco.InserirOrganizacao(&o)
+		if o.ID != int64(i+1) {
+			t.Fatalf("organização %s criada com ID %d", o.Slug, o.ID)
+		}
+	}
+	usuarios := memoria.NewUsuarioDAO(banco)
+	trilhas := memoria.NewTrilhaDAO(banco)
+	competencias := memoria.NewCompetenciaDAO(banco)
+	cargos := memoria.NewCargoDAO(banco)
+
+	amb := &ambiente{
+		t:        t,
+		usuarios: make(map[string]*model.Usuario),
+		tokens:   make(map[string]string),
+		niveis:   make(map[int64]string),
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(senhaTeste), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt: %v", err)
+	}
+	ctx := context.Background()
+	for _, u := range []struct {
+		apelido, papel string
+		org            int64
+	}{
+		{"curador", model.PapelCurator, orgPlataforma},
+		{"plataforma", model.PapelAdmin, orgPlataforma},
+		{"admin", model.PapelAdmin, orgAcme},
+		{"gestor", model.PapelManager, orgAcme},
+		{"learner", model.PapelLearner, orgAcme},
+		{"outro", model.PapelAdmin, orgOutra},
+	} {
+		usuario := &model.Usuario{
+			Nome:          "Usuário " + u.apelido,
+			Email:         u.apelido + "@exemplo.com",
+			NivelCarreira: "Pleno",
+			Papel:         u.papel,
+			OrganizacaoID: u.org,
+			SenhaHash:     string(hash),
+		}
+		if err := usuarios.Create(ctx, usuario); err != nil {
+			t.Fatalf("criar usuário %s: %v", u.apelido, err)
+		}
+		amb.usuarios[u.apelido] = usuario
+	}
+	learner := amb.usuarios["learner"]
+	learner.GestorID = &amb.usuarios["gestor"].ID
+	if err := usuarios.Update(ctx, orgAcme, learner); err != nil {
+		t.Fatalf("definir gestor: %v", err)
+	}
+
+	competencia, cargo := competenciaFixa, cargoFixo
+	if err := competencias.Create(ctx, &competencia); err != nil || competencia.ID != competenciaFixa.ID {
+		t.Fatalf("criar competência: %v (ID %d)", err, competencia.ID)
+	}
+	if err := cargos.Create(ctx, &cargo); err != nil || cargo.ID != cargoFixo.ID {
+		t.Fatalf("criar cargo: %v (ID %d)", err, cargo.ID)
+	}
+
+	novaTrilha := func(nome string, org *int64) *model.Trilha {
+		trilha := &model.Trilha{Nome: nome, Nivel: "INICIANTE", CargaHoraria: 10, FocoPrincipal: "Dados", OrganizacaoID: org}
+		if err := trilhas.Create(ctx, trilha); err != nil {
+			t.Fatalf("criar trilha %s: %v", nome, err)
+		}
+		return trilha
+	}
+	acme, outra := orgAcme, orgOutra
+	amb.trilhaPublica = novaTrilha("Trilha pública", nil)
+	amb.trilhaAcme = novaTrilha("Trilha da Acme", &acme)
+	amb.trilhaOutra = novaTrilha("Trilha da outra organização", &outra)
+
+	daos := app.DAOs{
+		Transacionador:  banco,
+		Usuario:         usuarios,
+		Organizacao:     memoria.NewOrganizacaoDAO(banco),
+		RefreshToken:    memoria.NewRefreshTokenDAO(banco),
+		Trilha:          trilhas,
+		Matricula:       memoria.NewMatriculaDAO(banco),
+		Requisito:       requisitosLivres{trilhas: trilhas, niveis: amb.niveis},
+		Competencia:     competencias,
+		Cargo:           cargos,
+		Modulo:          modulosFixos{modulo: model.Modulo{ID: 1, TrilhaID: amb.trilhaAcme.ID, Titulo: "Fundamentos", Ordem: 1}},
+		Aula:            aulasFixas{},
+		GapCompetencias: gapFixo{},
+		Recomendacao:    recomendacoesFixas{trilha: amb.trilhaPublica},
+		PainelGestor:    painelFixo{liderado: learner},
+	}
+	a := app.NewComDAOs(app.Config{JWTSecret: "segredo-de-teste"}, daos)
+	amb.router = newRouter(a, amb.registrarRota)
+
+	for apelido, u := range amb.usuarios {
+		amb.tokens[apelido] = amb.login(u.Email, senhaTeste).AccessToken
+	}
+	return amb
+}
+
+// registrarRota é um middleware que guarda a rota registrada que atende a requisição.
+func (amb *ambiente) registrarRota(c *gin.Context) {
+	if c.FullPath() != "" {
+		amb.rota = c.Request.Method + " " + c.FullPath()
+	}
+	c.Next()
+}
+
+// requisicao envia uma requisição à API. token é o apelido do usuário autenticado ("" =
+// sem Authorization); corpo é serializado em JSON quando não é string.
+func (amb *ambiente) requisicao(metodo, caminho, token string, corpo any) *httptest.ResponseRecorder {
+	amb.t.Helper()
+	return amb.requisicaoIdioma(metodo, caminho, token, corpo, "")
+}
+
+// requisicaoIdioma envia a requisição com o cabeçalho Accept-Language informado.
+func (amb *ambiente) requisicaoIdioma(metodo, caminho, token string, corpo any, idioma string) *httptest.ResponseRecorder {
+	amb.t.Helper()
+
+	var leitor io.Reader
+	switch c := corpo.(type) {
+	case nil:
+	case string:
+		leitor = strings.NewReader(c)
+	default:
+		raw, err := json.Marshal(c)
+		if err != nil {
+			amb.t.Fatalf("serializar corpo: %v", err)
+		}
+		leitor = bytes.NewReader(raw)
+	}
+
+	req := httptest.NewRequest(metodo, caminho, leitor)
+	if leitor != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+amb.tokens[token])
+	}
+	if idioma != "" {
+		req.Header.Set("Accept-Language", idioma)
+	}
+	rec := httptest.NewRecorder()
+	amb.rota = ""
+	amb.router.ServeHTTP(rec, req)
+	return rec
+}
+
+// esperar confere o status da resposta e, se destino não for nil, decodifica o corpo.
+func (amb *ambiente) esperar(rec *httptest.ResponseRecorder, status int, destino any) {
+	amb.t.Helper()
+	if rec.Code != status {
+		amb.t.Fatalf("status = %d, esperado %d; corpo: %s", rec.Code, status, rec.Body.String())
+	}
+	if destino != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), destino); err != nil {
+			amb.t.Fatalf("decodificar resposta: %v; corpo: %s", err, rec.Body.String())
+		}
+	}
+}
+
+// esperarErro confere o status e que o corpo segue o formato Problem Details
+// (model.ErrorResponse servido como application/problem+json).
+func (amb *ambiente) esperarErro(rec *httptest.ResponseRecorder, status int) model.ErrorResponse {
+	amb.t.Helper()
+	var res model.ErrorResponse
+	amb.esperar(rec, status, &res)
+	if tipo := rec.Header().Get("Content-Type"); tipo != "application/problem+json" {
+		amb.t.Fatalf("Content-Type = %q, esperado application/problem+json", tipo)
+	}
+	if res.Status != status || res.Title == "" || res.Code == "" || res.Type != model.TipoErroPrefixo+res.Code || res.Instance == "" {
+		amb.t.Fatalf("resposta de erro incompleta: %s", rec.Body.String())
+	}
+	return res
+}
+
+// esperarCodigo confere o status e o código estável da resposta de erro.
+func (amb *ambiente) esperarCodigo(rec *httptest.ResponseRecorder, status int, codigo string) model.ErrorResponse {
+	amb.t.Helper()
+	res := amb.esperarErro(rec, status)
+	if res.Code != codigo {
+		amb.t.Fatalf("code = %q, esperado %q", res.Code, codigo)
+	}
+	return res
+}
+
+// esperarCampos confere que a resposta é um erro de validação que rejeita exatamente os
+// campos informados.
+func (amb *ambiente) esperarCampos(rec *httptest.ResponseRecorder, campos ...string) {
+	amb.t.Helper()
+	res := amb.esperarCodigo(rec, http.StatusBadRequest, model.CodigoDadosInvalidos)
+	rejeitados := make([]string, len(res.Errors))
+	for i, e := range res.Errors {
+		if e.Motivo == "" {
+			amb.t.Fatalf("campo %q sem motivo", e.Campo)
+		}
+		rejeitados[i] = e.Campo
+	}
+	if !slices.Equal(rejeitados, campos) {
+		amb.t.Fatalf("campos rejeitados = %v, esperado %v", rejeitados, campos)
+	}
+}
+
+func (amb *ambiente) login(email, senha string) model.TokenResponse {
+	amb.t.Helper()
+	var res model.TokenResponse
+	amb.esperar(amb.requisicao(http.MethodPost, "/api/v1/auth/login", "", model.LoginRequest{Email: email, Senha: senha}), http.StatusOK, &res)
+	return res
+}
+
+func caminho(formato string, args ...any) string {
+	return "/api/v1" + formatar(formato, args...)
+}
+
+func formatar(formato string, args ...any) string {
+	for _, arg := range args {
+		raw, _ := json.Marshal(arg)
+		formato = strings.Replace(formato, "{}", string(raw), 1)
+	}
+	return formato
+}
+
+func TestRaizRedirecionaParaSwagger(t *testing.T) {
+	amb := novoAmbiente(t)
+
+	rec := amb.requisicao(http.MethodGet, "/", "", nil)
+	amb.esperar(rec, http.StatusSeeOther, nil)
+	if local := rec.Header().Get("Location"); local != "/swagger/index.html" {
+		t.Fatalf("Location = %q", local)
+	}
+
+	amb.esperar(amb.requisicao(http.MethodGet, "/swagger/index.html", "", nil), http.StatusOK, nil)
+}
+
+func TestAuth(t *testing.T) {
+	amb := novoAmbiente(t)
+	email := amb.usuarios["learner"].Email
+
+	// Credenciais inválidas e corpo inválido
+	amb.esperarErro(amb.requisicao(http.MethodPost, "/api/v1/auth/login", "", model.LoginRequest{Email: email, Senha: "errada"}), http.StatusUnauthorized)
+	amb.esperarErro(amb.requisicao(http.MethodPost, "/api/v1/auth/login", "", model.LoginRequest{Email: "inexistente@exemplo.com", Senha: senhaTeste}), http.StatusUnauthorized)
+	amb.esperarCampos(amb.requisicao(http.MethodPost, "/api/v1/auth/login", "", `{"email": "não é email"}`), "email", "senha")
+	amb.esperarCodigo(amb.requisicao(http.MethodPost, "/api/v1/auth/login", "", `{"email": `), http.StatusBadRequest, model.CodigoDadosInvalidos)
+
+	// Renovação: o refresh token só pode ser usado uma vez
+	tokens := amb.login(email, senhaTeste)
+	if tokens.TokenType != "Bearer" || tokens.AccessToken == "" || tokens.RefreshToken == "" {
+		t.Fatalf("tokens incompletos: %+v", tokens)
+	}
+	var renovados model.TokenResponse
+	amb.esperar(amb.requisicao(http.MethodPost, "/api/v1/auth/refresh", "", model.RefreshTokenRequest{RefreshToken: tokens.RefreshToken}), http.StatusOK, &renovados)
+	amb.esperarErro(amb.requisicao(http.MethodPost, "/api/v1/auth/refresh", "", model.RefreshTokenRequest{RefreshToken: tokens.RefreshToken}), http.StatusUnauthorized)
+
+	// Logout revoga o refresh token
+	amb.esperar(amb.requisicao(http.MethodPost, "/api/v1/auth/logout", "", model.RefreshTokenRequest{RefreshToken: renovados.RefreshToken}), http.StatusNoContent, nil)
+	amb.esperarErro(amb.requisicao(http.MethodPost, "/api/v1/auth/refresh", "", model.RefreshTokenRequest{RefreshToken: renovados.RefreshToken}), http.StatusUnauthorized)
+
+	// Access token ausente ou inválido
+	rec := amb.requisicao(http.MethodGet, "/api/v1/trilhas/", "", nil)
+	amb.esperarErro(rec, http.StatusUnauthorized)
+	if rec.Header().Get("WWW-Authenticate") == "" {
+		t.Fatal("401 sem cabeçalho WWW-Authenticate")
+	}
+	amb.tokens["invalido"] = "token-invalido"
+	amb.esperarErro(amb.requisicao(http.MethodGet, "/api/v1/trilhas/", "invalido", nil), http.StatusUnauthorized)
+}
+
+func TestUsuarios(t *testing.T) {
+	amb := novoAmbiente(t)
+	learner := amb.usuarios["learner"]
+
+	// Cadastro, email duplicado e validação
+	novo := model.CreateUsuarioRequest{Nome: "Nova Pessoa", Email: "nova@exemplo.com", Senha: senhaTeste, NivelCarreira: "Junior"}
+	var criado model.UsuarioResponse
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/usuarios/"), "admin", novo), http.StatusCreated, &criado)
+	if criado.OrganizacaoID != orgAcme || criado.Papel != model.PapelLearner {
+		t.Fatalf("usuário criado = %+v", criado)
+	}
+	amb.esperarCodigo(amb.requisicao(http.MethodPost, caminho("/usuarios/"), "admin", novo), http.StatusConflict, model.CodigoEmailJaCadastrado)
+	// De outra organização, o conflito é genérico e não confirma que o email existe
+	res := amb.esperarCodigo(amb.requisicao(http.MethodPost, caminho("/usuarios/"), "outro", novo), http.StatusConflict, model.CodigoConflito)
+	if strings.Contains(res.Detail, novo.Email) || strings.Contains(res.Title, "cadastrado") {
+		t.Fatalf("title = %q, detail = %q", res.Title, res.Detail)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/usuarios/"), "admin", model.CreateUsuarioRequest{Nome: "X"}), http.StatusBadRequest)
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/usuarios/"), "learner", novo), http.StatusForbidden)
+	gestorInvalido := model.CreateUsuarioRequest{Nome: "Outra Pessoa", Email: "outra@exemplo.com", Senha: senhaTeste, GestorID: &learner.ID}
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/usuarios/"), "admin", gestorInvalido), http.StatusUnprocessableEntity)
+
+	// O novo usuário consegue autenticar
+	amb.login(novo.Email, senhaTeste)
+
+	// Listagem paginada, restrita à organização
+	var pagina []model.UsuarioResponse
+	rec := amb.requisicao(http.MethodGet, caminho("/usuarios/?limit=2&sort=-id"), "admin", nil)
+	amb.esperar(rec, http.StatusOK, &pagina)
+	if total := rec.Header().Get("X-Total-Count"); total != "4" {
+		t.Fatalf("X-Total-Count = %q, esperado 4", total)
+	}
+	if len(pagina) != 2 || pagina[0].ID != criado.ID {
+		t.Fatalf("primeira página = %+v", pagina)
+	}
+	cursor := rec.Header().Get("X-Next-Cursor")
+	if cursor == "" {
+		t.Fatal("primeira página sem X-Next-Cursor")
+	}
+	var resto []model.UsuarioResponse
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/usuarios/?limit=2&sort=-id&cursor="+cursor), "admin", nil), http.StatusOK, &resto)
+	if len(resto) != 2 || resto[1].ID != amb.usuarios["admin"].ID {
+		t.Fatalf("segunda página = %+v", resto)
+	}
+	var filtrados []model.UsuarioResponse
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/usuarios/?papel=MANAGER"), "admin", nil), http.StatusOK, &filtrados)
+	if len(filtrados) != 1 || filtrados[0].ID != amb.usuarios["gestor"].ID {
+		t.Fatalf("filtro por papel = %+v", filtrados)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/usuarios/?sort=senha"), "admin", nil), http.StatusBadRequest)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/usuarios/?cursor=invalido"), "admin", nil), http.StatusBadRequest)
+
+	// Consulta: learners só veem o próprio perfil; outras organizações não existem
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/usuarios/{}", learner.ID), "learner", nil), http.StatusOK, nil)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/usuarios/{}", criado.ID), "learner", nil), http.StatusForbidden)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/usuarios/{}", amb.usuarios["outro"].ID), "admin", nil), http.StatusNotFound)
+
+	// Atualização e hierarquia
+	var atualizado model.UsuarioResponse
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "learner", model.UpdateUsuarioRequest{Nome: "Learner Renomeado"}), http.StatusOK, &atualizado)
+	if atualizado.Nome != "Learner Renomeado" {
+		t.Fatalf("nome = %q", atualizado.Nome)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "learner", model.UpdateUsuarioRequest{GestorID: &amb.usuarios["admin"].ID}), http.StatusForbidden)
+
+	// O nível de carreira (elegibilidade às trilhas) é definido pelo gestor direto ou por admins
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "learner", model.UpdateUsuarioRequest{NivelCarreira: "Senior"}), http.StatusForbidden)
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "gestor", model.UpdateUsuarioRequest{Nome: "Renomeado pelo gestor"}), http.StatusForbidden)
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "gestor", model.UpdateUsuarioRequest{NivelCarreira: "Diretor"}), http.StatusUnprocessableEntity)
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "gestor", model.UpdateUsuarioRequest{NivelCarreira: "senior"}), http.StatusOK, &atualizado)
+	if atualizado.NivelCarreira != "Senior" {
+		t.Fatalf("nível de carreira = %q", atualizado.NivelCarreira)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", amb.usuarios["admin"].ID), "gestor", model.UpdateUsuarioRequest{NivelCarreira: "Junior"}), http.StatusForbidden)
+
+	// Trocar a própria senha exige a senha atual; o administrador redefine a de outros sem ela
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "learner", model.UpdateUsuarioRequest{Senha: "nova-senha-123"}), http.StatusForbidden)
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "learner", model.UpdateUsuarioRequest{Senha: "nova-senha-123", SenhaAtual: "errada"}), http.StatusForbidden)
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "learner", model.UpdateUsuarioRequest{Senha: "nova-senha-123", SenhaAtual: senhaTeste}), http.StatusOK, nil)
+	amb.login(learner.Email, "nova-senha-123")
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", learner.ID), "admin", model.UpdateUsuarioRequest{Senha: senhaTeste}), http.StatusOK, nil)
+	amb.login(learner.Email, senhaTeste)
+	// O learner promovido a manager não pode passar a gerir o próprio gestor
+	ciclo := model.UpdateUsuarioRequest{GestorID: &learner.ID}
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/usuarios/{}/papel", learner.ID), "admin", model.SetPapelRequest{Papel: model.PapelManager}), http.StatusOK, nil)
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}", amb.usuarios["gestor"].ID), "admin", ciclo), http.StatusUnprocessableEntity)
+
+	// Papel
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}/papel", amb.usuarios["admin"].ID), "admin", model.SetPapelRequest{Papel: model.PapelLearner}), http.StatusUnprocessableEntity)
+	amb.esperarCampos(amb.requisicao(http.MethodPut, caminho("/usuarios/{}/papel", criado.ID), "admin", model.SetPapelRequest{Papel: "root"}), "papel")
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/usuarios/{}/papel", amb.usuarios["outro"].ID), "admin", model.SetPapelRequest{Papel: model.PapelCurator}), http.StatusNotFound)
+
+	// Remoção: o gestor removido deixa o liderado sem gestor
+	amb.esperar(amb.requisicao(http.MethodDelete, caminho("/usuarios/{}", amb.usuarios["gestor"].ID), "admin", nil), http.StatusNoContent, nil)
+	amb.esperarErro(amb.requisicao(http.MethodDelete, caminho("/usuarios/{}", amb.usuarios["gestor"].ID), "admin", nil), http.StatusNotFound)
+	var semGestor model.UsuarioResponse
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/usuarios/{}", learner.ID), "admin", nil), http.StatusOK, &semGestor)
+	if semGestor.GestorID != nil {
+		t.Fatalf("gestor_id = %d após remover o gestor", *semGestor.GestorID)
+	}
+
+	// O usuário removido perde o acesso
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/trilhas/"), "gestor", nil), http.StatusUnauthorized)
+}
+
+func TestTrilhas(t *testing.T) {
+	amb := novoAmbiente(t)
+
+	// Cadastro: privada da organização ou pública (apenas curadores da plataforma)
+	nova := model.CreateTrilhaRequest{Nome: "Trilha nova", Nivel: "AVANCADO", CargaHoraria: 40}
+	var criada model.TrilhaResponse
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/trilhas/"), "admin", nova), http.StatusCreated, &criada)
+	publica := nova
+	publica.Publica = true
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/trilhas/"), "admin", publica), http.StatusForbidden)
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/trilhas/"), "curador", publica), http.StatusCreated, nil)
+	amb.esperarCampos(amb.requisicao(http.MethodPost, caminho("/trilhas/"), "admin", model.CreateTrilhaRequest{Nome: "Nível inválido", Nivel: "MESTRE", CargaHoraria: 1}), "nivel")
+	amb.esperarCampos(amb.requisicao(http.MethodPost, caminho("/trilhas/"), "admin", `{"nome": "Carga em texto", "carga_horaria": "dez"}`), "carga_horaria")
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/trilhas/"), "learner", nova), http.StatusForbidden)
+
+	// Listagem: catálogo público e trilhas da própria organização
+	var visiveis []model.TrilhaResponse
+	rec := amb.requisicao(http.MethodGet, caminho("/trilhas/?sort=nome"), "learner", nil)
+	amb.esperar(rec, http.StatusOK, &visiveis)
+	if rec.Header().Get("X-Total-Count") != "4" || len(visiveis) != 4 {
+		t.Fatalf("trilhas visíveis = %+v", visiveis)
+	}
+	for _, trilha := range visiveis {
+		if trilha.ID == amb.trilhaOutra.ID {
+			t.Fatal("trilha de outra organização listada")
+		}
+	}
+	var avancadas []model.TrilhaResponse
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/trilhas/?nivel=avancado"), "learner", nil), http.StatusOK, &avancadas)
+	if len(avancadas) != 2 {
+		t.Fatalf("filtro por nível = %+v", avancadas)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/trilhas/?sort=descricao"), "learner", nil), http.StatusBadRequest)
+
+	// Consulta
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/trilhas/{}", amb.trilhaPublica.ID), "learner", nil), http.StatusOK, nil)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/trilhas/{}", amb.trilhaOutra.ID), "learner", nil), http.StatusNotFound)
+	invalido := amb.esperarCodigo(amb.requisicao(http.MethodGet, caminho("/trilhas/abc"), "learner", nil), http.StatusBadRequest, model.CodigoParametroInvalido)
+	if len(invalido.Errors) != 1 || invalido.Errors[0].Campo != "id" {
+		t.Fatalf("errors = %+v, esperado o parâmetro id", invalido.Errors)
+	}
+
+	// Atualização: trilhas públicas só pelos curadores da plataforma
+	var atualizada model.TrilhaResponse
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/trilhas/{}", criada.ID), "admin", model.UpdateTrilhaRequest{CargaHoraria: 60}), http.StatusOK, &atualizada)
+	if atualizada.CargaHoraria != 60 || atualizada.Nome != nova.Nome {
+		t.Fatalf("trilha atualizada = %+v", atualizada)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/trilhas/{}", amb.trilhaPublica.ID), "admin", model.UpdateTrilhaRequest{CargaHoraria: 1}), http.StatusForbidden)
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/trilhas/{}", amb.trilhaOutra.ID), "admin", model.UpdateTrilhaRequest{CargaHoraria: 1}), http.StatusNotFound)
+
+	// Remoção em cascata das matrículas
+	var matricula model.Matricula
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/matriculas"), "learner", controller.MatricularRequest{UsuarioID: amb.usuarios["learner"].ID, TrilhaID: criada.ID}), http.StatusCreated, &matricula)
+	amb.esperar(amb.requisicao(http.MethodDelete, caminho("/trilhas/{}", criada.ID), "admin", nil), http.StatusNoContent, nil)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/trilhas/{}", criada.ID), "admin", nil), http.StatusNotFound)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/matriculas/{}", matricula.ID), "learner", nil), http.StatusNotFound)
+	amb.esperarErro(amb.requisicao(http.MethodDelete, caminho("/trilhas/{}", amb.trilhaOutra.ID), "admin", nil), http.StatusNotFound)
+}
+
+func TestCompetencias(t *testing.T) {
+	amb := novoAmbiente(t)
+
+	// Cadastro: apenas curadores da plataforma mantêm as competências
+	nova := model.CreateCompetenciaRequest{Nome: "Comunicação", Categoria: "Humana"}
+	var criada model.CompetenciaResponse
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/competencias/"), "curador", nova), http.StatusCreated, &criada)
+	if criada.ID == 0 || criada.Nome != nova.Nome || criada.Categoria != nova.Categoria {
+		t.Fatalf("competência criada = %+v", criada)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/competencias/"), "admin", nova), http.StatusForbidden)
+
+	// Listagem, filtro e consulta
+	var todas []model.CompetenciaResponse
+	rec := amb.requisicao(http.MethodGet, caminho("/competencias/?sort=nome"), "learner", nil)
+	amb.esperar(rec, http.StatusOK, &todas)
+	if rec.Header().Get("X-Total-Count") != "2" || len(todas) != 2 || todas[0].ID != competenciaFixa.ID {
+		t.Fatalf("competências = %+v", todas)
+	}
+	var humanas []model.CompetenciaResponse
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/competencias/?categoria=humana"), "learner", nil), http.StatusOK, &humanas)
+	if len(humanas) != 1 || humanas[0].ID != criada.ID {
+		t.Fatalf("filtro por categoria = %+v", humanas)
+	}
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/competencias/{}", criada.ID), "outro", nil), http.StatusOK, nil)
+
+	// Atualização parcial
+	var atualizada model.CompetenciaResponse
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/competencias/{}", criada.ID), "curador", model.UpdateCompetenciaRequest{Descricao: "Oral e escrita"}), http.StatusOK, &atualizada)
+	if atualizada.Nome != nova.Nome || atualizada.Descricao != "Oral e escrita" {
+		t.Fatalf("competência atualizada = %+v", atualizada)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/competencias/{}", 999), "curador", model.UpdateCompetenciaRequest{Descricao: "x"}), http.StatusNotFound)
+
+	// Remoção
+	amb.esperar(amb.requisicao(http.MethodDelete, caminho("/competencias/{}", criada.ID), "curador", nil), http.StatusNoContent, nil)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/competencias/{}", criada.ID), "learner", nil), http.StatusNotFound)
+	amb.esperarErro(amb.requisicao(http.MethodDelete, caminho("/competencias/{}", criada.ID), "curador", nil), http.StatusNotFound)
+}
+
+func TestCargos(t *testing.T) {
+	amb := novoAmbiente(t)
+	exigida := []model.NivelCompetenciaRequest{{CompetenciaID: competenciaFixa.ID, Nivel: 3}}
+
+	// Cadastro: privado da organização ou público (apenas curadores da plataforma)
+	novo := model.CreateCargoRequest{Nome: "Cientista de Dados", Competencias: exigida}
+	var criado model.CargoResponse
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/cargos/"), "admin", novo), http.StatusCreated, &criado)
+	if criado.Publica || criado.OrganizacaoID == nil || *criado.OrganizacaoID != orgAcme ||
+		len(criado.Competencias) != 1 || criado.Competencias[0].Nome != competenciaFixa.Nome || criado.Competencias[0].NivelMinimo != 3 {
+		t.Fatalf("cargo criado = %+v", criado)
+	}
+	publico := model.CreateCargoRequest{Nome: "Engenheiro de Dados", Publica: true}
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/cargos/"), "admin", publico), http.StatusForbidden)
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/cargos/"), "curador", publico), http.StatusCreated, nil)
+	inexistente := model.CreateCargoRequest{Nome: "Cargo inválido", Competencias: []model.NivelCompetenciaRequest{{CompetenciaID: 999, Nivel: 1}}}
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/cargos/"), "admin", inexistente), http.StatusUnprocessableEntity)
+
+	// Listagem: catálogo público e cargos da própria organização
+	var visiveis []model.CargoResponse
+	rec := amb.requisicao(http.MethodGet, caminho("/cargos/"), "learner", nil)
+	amb.esperar(rec, http.StatusOK, &visiveis)
+	if rec.Header().Get("X-Total-Count") != "3" || len(visiveis) != 3 {
+		t.Fatalf("cargos visíveis = %+v", visiveis)
+	}
+	var daOutra []model.CargoResponse
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/cargos/"), "outro", nil), http.StatusOK, &daOutra)
+	if len(daOutra) != 2 {
+		t.Fatalf("cargos visíveis para outra organização = %+v", daOutra)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/cargos/{}", criado.ID), "outro", nil), http.StatusNotFound)
+
+	// Atualização e competências exigidas
+	var atualizado model.CargoResponse
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/cargos/{}", criado.ID), "admin", model.UpdateCargoRequest{Descricao: "Modelagem e análise"}), http.StatusOK, &atualizado)
+	if atualizado.Nome != novo.Nome || atualizado.Descricao != "Modelagem e análise" {
+		t.Fatalf("cargo atualizado = %+v", atualizado)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/cargos/{}", cargoFixo.ID), "admin", model.UpdateCargoRequest{Descricao: "x"}), http.StatusForbidden)
+	exigida[0].Nivel = 5
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/cargos/{}/competencias", criado.ID), "admin", model.SetCargoCompetenciasRequest{Competencias: exigida}), http.StatusOK, &atualizado)
+	if len(atualizado.Competencias) != 1 || atualizado.Competencias[0].NivelMinimo != 5 {
+		t.Fatalf("competências do cargo = %+v", atualizado.Competencias)
+	}
+	var semCompetencias model.CargoResponse
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/cargos/{}/competencias", criado.ID), "admin", model.SetCargoCompetenciasRequest{Competencias: []model.NivelCompetenciaRequest{}}), http.StatusOK, &semCompetencias)
+	if len(semCompetencias.Competencias) != 0 {
+		t.Fatalf("competências do cargo = %+v", semCompetencias.Competencias)
+	}
+
+	// Remoção
+	amb.esperarErro(amb.requisicao(http.MethodDelete, caminho("/cargos/{}", criado.ID), "outro", nil), http.StatusNotFound)
+	amb.esperar(amb.requisicao(http.MethodDelete, caminho("/cargos/{}", criado.ID), "admin", nil), http.StatusNoContent, nil)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/cargos/{}", criado.ID), "admin", nil), http.StatusNotFound)
+}
+
+func TestOrganizacoes(t *testing.T) {
+	amb := novoAmbiente(t)
+
+	// Organização do usuário autenticado
+	var atual model.Organizacao
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/organizacao"), "learner", nil), http.StatusOK, &atual)
+	if atual.ID != orgAcme || atual.Plataforma {
+		t.Fatalf("organização atual = %+v", atual)
+	}
+
+	// Registro: apenas administradores da plataforma
+	nova := model.CreateOrganizacaoRequest{
+		Nome: "Nova Organização", Slug: " Nova ",
+		Admin: model.CreateUsuarioRequest{Nome: "Administradora", Email: "adm@nova.com", Senha: senhaTeste},
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/organizacoes"), "admin", nova), http.StatusForbidden)
+	var registro model.RegistroOrganizacaoResponse
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/organizacoes"), "plataforma", nova), http.StatusCreated, &registro)
+	if registro.Organizacao.Slug != "nova" || registro.Admin.Papel != model.PapelAdmin || registro.Admin.OrganizacaoID != registro.Organizacao.ID {
+		t.Fatalf("registro = %+v", registro)
+	}
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/organizacoes"), "plataforma", nova), http.StatusConflict, nil)
+	outroEmail := nova
+	outroEmail.Slug = "outra-nova"
+	outroEmail.Admin.Email = amb.usuarios["learner"].Email
+	amb.esperarCodigo(amb.requisicao(http.MethodPost, caminho("/organizacoes"), "plataforma", outroEmail), http.StatusConflict, model.CodigoConflito)
+
+	// O administrador registrado autentica na nova organização
+	amb.tokens["nova"] = amb.login(nova.Admin.Email, senhaTeste).AccessToken
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/organizacao"), "nova", nil), http.StatusOK, &atual)
+	if atual.ID != registro.Organizacao.ID {
+		t.Fatalf("organização do novo administrador = %+v", atual)
+	}
+}
+
+func TestEquipes(t *testing.T) {
+	amb := novoAmbiente(t)
+
+	// Cadastro, nome duplicado e listagem restrita à organização
+	var dados, produto model.Equipe
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/organizacao/equipes"), "admin", model.EquipeRequest{Nome: "Dados"}), http.StatusCreated, &dados)
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/organizacao/equipes"), "admin", model.EquipeRequest{Nome: "Produto"}), http.StatusCreated, &produto)
+	if dados.OrganizacaoID != orgAcme {
+		t.Fatalf("equipe criada = %+v", dados)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/organizacao/equipes"), "admin", model.EquipeRequest{Nome: "Dados"}), http.StatusConflict)
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/organizacao/equipes"), "outro", model.EquipeRequest{Nome: "Dados"}), http.StatusCreated, nil)
+	var equipes []model.Equipe
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/organizacao/equipes"), "learner", nil), http.StatusOK, &equipes)
+	if len(equipes) != 2 || equipes[0].ID != dados.ID || equipes[1].ID != produto.ID {
+		t.Fatalf("equipes = %+v", equipes)
+	}
+
+	// Renomear
+	var renomeada model.Equipe
+	amb.esperar(amb.requisicao(http.MethodPut, caminho("/organizacao/equipes/{}", dados.ID), "admin", model.EquipeRequest{Nome: "Dados e IA"}), http.StatusOK, &renomeada)
+	if renomeada.ID != dados.ID || renomeada.Nome != "Dados e IA" {
+		t.Fatalf("equipe renomeada = %+v", renomeada)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/organizacao/equipes/{}", dados.ID), "admin", model.EquipeRequest{Nome: "Produto"}), http.StatusConflict)
+	amb.esperarErro(amb.requisicao(http.MethodPut, caminho("/organizacao/equipes/{}", dados.ID), "outro", model.EquipeRequest{Nome: "Outra"}), http.StatusNotFound)
+
+	// Remoção: os membros ficam sem equipe
+	membro := model.CreateUsuarioRequest{Nome: "Membro", Email: "membro@exemplo.com", Senha: senhaTeste, EquipeID: &dados.ID}
+	var criado model.UsuarioResponse
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/usuarios/"), "admin", membro), http.StatusCreated, &criado)
+	amb.esperar(amb.requisicao(http.MethodDelete, caminho("/organizacao/equipes/{}", dados.ID), "admin", nil), http.StatusNoContent, nil)
+	amb.esperarErro(amb.requisicao(http.MethodDelete, caminho("/organizacao/equipes/{}", dados.ID), "admin", nil), http.StatusNotFound)
+	var semEquipe model.UsuarioResponse
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/usuarios/{}", criado.ID), "admin", nil), http.StatusOK, &semEquipe)
+	if semEquipe.EquipeID != nil {
+		t.Fatalf("membro da equipe removida = %+v", semEquipe)
+	}
+}
+
+func TestMatriculas(t *testing.T) {
+	amb := novoAmbiente(t)
+	learner := amb.usuarios["learner"]
+
+	// Inscrição, duplicidade e autorização
+	req := controller.MatricularRequest{UsuarioID: learner.ID, TrilhaID: amb.trilhaAcme.ID}
+	var matricula model.Matricula
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/matriculas"), "learner", req), http.StatusCreated, &matricula)
+	if matricula.Status != model.StatusMatriculaAtiva {
+		t.Fatalf("status = %q", matricula.Status)
+	}
+	amb.esperarCodigo(amb.requisicao(http.MethodPost, caminho("/matriculas"), "learner", req), http.StatusConflict, model.CodigoMatriculaAtivaDuplicada)
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/matriculas"), "learner", controller.MatricularRequest{UsuarioID: amb.usuarios["admin"].ID, TrilhaID: amb.trilhaAcme.ID}), http.StatusForbidden)
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/matriculas"), "learner", controller.MatricularRequest{UsuarioID: learner.ID, TrilhaID: amb.trilhaOutra.ID}), http.StatusUnprocessableEntity)
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/matriculas"), "admin", controller.MatricularRequest{UsuarioID: amb.usuarios["outro"].ID, TrilhaID: amb.trilhaAcme.ID}), http.StatusUnprocessableEntity)
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/matriculas"), "learner", `{"usuario_id": 0}`), http.StatusBadRequest)
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/matriculas"), "admin", controller.MatricularRequest{UsuarioID: learner.ID, TrilhaID: amb.trilhaPublica.ID}), http.StatusCreated, nil)
+
+	// Elegibilidade
+	var elegibilidade model.ElegibilidadeResponse
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/usuarios/{}/elegibilidade/{}", learner.ID, amb.trilhaPublica.ID), "learner", nil), http.StatusOK, &elegibilidade)
+	if !elegibilidade.Elegivel {
+		t.Fatalf("elegibilidade = %+v", elegibilidade)
+	}
+
+	// Consulta e listagem
+	amb.esperar(amb.requisicao(http.MethodGet, caminho("/matriculas/{}", matricula.ID), "learner", nil), http.StatusOK, nil)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/matriculas/{}", matricula.ID), "gestor", nil), http.StatusForbidden)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/matriculas/{}", matricula.ID), "outro", nil), http.StatusNotFound)
+	var matriculas []model.Matricula
+	rec := amb.requisicao(http.MethodGet, caminho("/usuarios/{}/matriculas?trilha_id={}", learner.ID, amb.trilhaAcme.ID), "learner", nil)
+	amb.esperar(rec, http.StatusOK, &matriculas)
+	if rec.Header().Get("X-Total-Count") != "1" || len(matriculas) != 1 || matriculas[0].ID != matricula.ID {
+		t.Fatalf("matrículas = %+v", matriculas)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/usuarios/{}/matriculas", amb.usuarios["outro"].ID), "admin", nil), http.StatusUnprocessableEntity)
+
+	// Ciclo de vida: cancelar, reativar e concluir
+	var cancelada model.Matricula
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/matriculas/{}/cancelar", matricula.ID), "learner", nil), http.StatusOK, &cancelada)
+	if cancelada.Status != model.StatusMatriculaCancelada || cancelada.DataCancelamento == nil {
+		t.Fatalf("matrícula cancelada = %+v", cancelada)
+	}
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/matriculas/{}/concluir", matricula.ID), "gestor", nil), http.StatusUnprocessableEntity)
+
+	// Com uma nova matrícula ativa, a cancelada não pode ser reativada
+	var nova model.Matricula
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/matriculas"), "learner", req), http.StatusCreated, &nova)
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/matriculas/{}/reativar", matricula.ID), "learner", nil), http.StatusConflict)
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/matriculas/{}/cancelar", nova.ID), "learner", nil), http.StatusOK, nil)
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/matriculas/{}/reativar", matricula.ID), "learner", nil), http.StatusOK, nil)
+
+	// A conclusão manual (que concede competências verificadas) não parte do próprio usuário
+	amb.esperarErro(amb.requisicao(http.MethodPost, caminho("/matriculas/{}/concluir", matricula.ID), "learner", nil), http.StatusForbidden)
+	var concluida model.Matricula
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/matriculas/{}/concluir", matricula.ID), "gestor", nil), http.StatusOK, &concluida)
+	if concluida.Status != model.StatusMatriculaConcluida || concluida.DataConclusao == nil {
+		t.Fatalf("matrícula concluída = %+v", concluida)
+	}
+
+	// A remoção do usuário remove suas matrículas
+	amb.esperar(amb.requisicao(http.MethodDelete, caminho("/usuarios/{}", learner.ID), "admin", nil), http.StatusNoContent, nil)
+	amb.esperarErro(amb.requisicao(http.MethodGet, caminho("/matriculas/{}", matricula.ID), "admin", nil), http.StatusNotFound)
+}
+
+func TestErroInterno(t *testing.T) {
+	amb := novoAmbiente(t)
+
+	// Erros não mapeados não expõem a mensagem original
+	interno := gin.New()
+	interno.Use(controller.ErrorHandlerMiddleware())
+	interno.GET("/falha", func(c *gin.Context) { c.Error(errors.New("pq: conexão recusada")) })
+	rec := httptest.NewRecorder()
+	interno.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/falha", nil))
+	res := amb.esperarErro(rec, http.StatusInternalServerError)
+	if res.Code != model.CodigoErroInterno || strings.Contains(res.Detail, "pq:") || !strings.HasPrefix(res.Detail, "Não foi possível concluir a requisição.") {
+		t.Fatalf("code = %q, detail = %q", res.Code, res.Detail)
+	}
+}
+
+func TestIdiomas(t *testing.T) {
+	amb := novoAmbiente(t)
+	nivelInvalido := model.CreateTrilhaRequest{Nome: "Nível inválido", Nivel: "MESTRE", CargaHoraria: 1}
+	inexistente := caminho("/trilhas/{}", 999999)
+
+	casos := []struct {
+		acceptLanguage, idioma  string
+		titulo, motivo, detalhe string
+	}{
+		{"", "pt-BR", "Dados de entrada inválidos.", "deve ser um dos valores: INICIANTE, INTERMEDIARIO, AVANCADO", "Trilha não encontrado(a) com ID: 999999"},
+		{"fr-FR", "pt-BR", "Dados de entrada inválidos.", "deve ser um dos valores: INICIANTE, INTERMEDIARIO, AVANCADO", "Trilha não encontrado(a) com ID: 999999"},
+		{"en-GB,en;q=0.9,pt;q=0.5", "en-US", "Invalid input data.", "must be one of: INICIANTE, INTERMEDIARIO, AVANCADO", "Learning path with ID 999999 not found."},
+		{"pt-BR,en;q=0.5", "pt-BR", "Dados de entrada inválidos.", "deve ser um dos valores: INICIANTE, INTERMEDIARIO, AVANCADO", "Trilha não encontrado(a) com ID: 999999"},
+	}
+	for _, c := range casos {
+		rec := amb.requisicaoIdioma(http.MethodPost, caminho("/trilhas/"), "admin", nivelInvalido, c.acceptLanguage)
+		res := amb.esperarErro(rec, http.StatusBadRequest)
+		if idioma := rec.Header().Get("Content-Language"); idioma != c.idioma {
+			t.Fatalf("Accept-Language %q: Content-Language = %q, esperado %q", c.acceptLanguage, idioma, c.idioma)
+		}
+		if res.Title != c.titulo || len(res.Errors) != 1 || res.Errors[0].Motivo != c.motivo {
+			t.Fatalf("Accept-Language %q: title = %q, errors = %+v", c.acceptLanguage, res.Title, res.Errors)
+		}
+
+		res = amb.esperarErro(amb.requisicaoIdioma(http.MethodGet, inexistente, "admin", nil, c.acceptLanguage), http.StatusNotFound)
+		if res.Detail != c.detalhe {
+			t.Fatalf("Accept-Language %q: detail = %q, esperado %q", c.acceptLanguage, res.Detail, c.detalhe)
+		}
+	}
+
+	// Plural das unidades e catálogo de regras de negócio
+	curto := model.CreateUsuarioRequest{Nome: "Curto", Email: "curto@exemplo.com", Senha: "123"}
+	res := amb.esperarErro(amb.requisicaoIdioma(http.MethodPost, caminho("/usuarios/"), "admin", curto, "en-US"), http.StatusBadRequest)
+	if len(res.Errors) != 1 || res.Errors[0].Motivo != "must have at least 8 characters" {
+		t.Fatalf("errors = %+v", res.Errors)
+	}
+	papel := model.SetPapelRequest{Papel: model.PapelLearner}
+	res = amb.esperarErro(amb.requisicaoIdioma(http.MethodPut, caminho("/usuarios/{}/papel", amb.usuarios["admin"].ID), "admin", papel, "en-US"), http.StatusUnprocessableEntity)
+	if res.Title != "Business rule not satisfied." || res.Detail != "An administrator cannot change their own role." {
+		t.Fatalf("title = %q, detail = %q", res.Title, res.Detail)
+	}
+
+	// Autenticação, autorização e parâmetros
+	res = amb.esperarErro(amb.requisicaoIdioma(http.MethodGet, caminho("/trilhas/"), "", nil, "en-US"), http.StatusUnauthorized)
+	if res.Detail != "Send the 'Authorization: Bearer <token>' header." {
+		t.Fatalf("detail = %q", res.Detail)
+	}
+	res = amb.esperarErro(amb.requisicaoIdioma(http.MethodGet, caminho("/usuarios/"), "learner", nil, "en-US"), http.StatusForbidden)
+	if res.Title != "Access denied." || strings.Contains(res.Detail, "permissão") {
+		t.Fatalf("title = %q, detail = %q", res.Title, res.Detail)
+	}
+	res = amb.esperarErro(amb.requisicaoIdioma(http.MethodGet, caminho("/trilhas/?limit=-1"), "learner", nil, "en-US"), http.StatusBadRequest)
+	if len(res.Errors) != 1 || res.Errors[0].Motivo != "must be a non-negative integer" || res.Detail != "invalid parameter 'limit': must be a non-negative integer" {
+		t.Fatalf("detail = %q, errors = %+v", res.Detail, res.Errors)
+	}
+	res = amb.esperarErro(amb.requisicaoIdioma(http.MethodGet, caminho("/trilhas/?sort=descricao"), "learner", nil, "en-US"), http.StatusBadRequest)
+	if len(res.Errors) != 1 || !strings.HasPrefix(res.Errors[0].Motivo, "accepted values: ") {
+		t.Fatalf("errors = %+v", res.Errors)
+	}
+
+	// Requisitos não atendidos da elegibilidade
+	var senior model.Trilha
+	amb.esperar(amb.requisicao(http.MethodPost, caminho("/trilhas/"), "admin", model.CreateTrilhaRequest{Nome: "Trilha sênior", Nivel: "AVANCADO", CargaHoraria: 1}), http.StatusCreated, &senior)
+	amb.niveis[senior.ID] = "Senior"
+	elegibilidade := caminho("/usuarios/{}/elegibilidade/{}", amb.usuarios["learner"].ID, senior.ID)
+	for idioma, esperado := range map[string]string{
+		"pt-BR": "Nível de carreira mínimo 'Senior' (atual: Pleno).",
+		"en-US": "Minimum career level 'Senior' (current: Pleno).",
+	} {
+		var res model.ElegibilidadeResponse
+		amb.esperar(amb.requisicaoIdioma(http.MethodGet, elegibilidade, "learner", nil, idioma), http.StatusOK, &res)
+		if res.Elegivel || len(res.RequisitosNaoAtendidos) != 1 || res.RequisitosNaoAtendidos[0] != esperado {
+			t.Fatalf("Accept-Language %q: elegibilidade = %+v", idioma, res)
+		}
+	}
+
+	// Motivos da recomendação
+	var recomendacoes []model.RecomendacaoTrilha
+	amb.esperar(amb.requisicaoIdioma(http.MethodGet, caminho("/usuarios/{}/recomendacoes", amb.usuarios["learner"].ID), "learner", nil, "en-US"), http.StatusOK, &recomendacoes)
+	if len(recomendacoes) != 1 || len(recomendacoes[0].Motivos) != 1 || recomendacoes[0].Motivos[0] != "Popular in the organization: 1 enrollment(s)." {
+		t.Fatalf("recomendações = %+v", recomendacoes)
+	}
+}
+
+// caso é uma requisição a uma rota registrada em newRouter e a resposta esperada.
+type caso struct {
+	rota    string // método e padrão da rota, como em gin.RouteInfo
+	caminho string
+	token   string // apelido do usuário autenticado ("" = sem token)
+	corpo   any
+	status  int
+}
+
+// TestRotas exercita todas as rotas registradas em newRouter, incluindo o mapeamento de
+// erros feito por ErrorHandlerMiddleware. Cada grupo de recursos tem ao menos um caso de
+// sucesso; rotas cujas operações não têm implementação em memória nem fake são
+// exercitadas até a autenticação, a autorização ou a validação de entrada.
+func TestRotas(t *testing.T) {
+	amb := novoAmbiente(t)
+	learner := amb.usuarios["learner"].ID
+	gestor := amb.usuarios["gestor"].ID
+	trilha := amb.trilhaAcme.ID
+
+	casos := []caso{
+		{"GET /", "/", "", nil, http.StatusSeeOther},
+		{"GET /swagger/*any", "/swagger/doc.json", "", nil, http.StatusOK},
+
+		{"POST /api/v1/auth/login", "/api/v1/auth/login", "", `{}`, http.StatusBadRequest},
+		{"POST /api/v1/auth/refresh", "/api/v1/auth/refresh", "", model.RefreshTokenRequest{RefreshToken: "inexistente"}, http.StatusUnauthorized},
+		{"POST /api/v1/auth/logout", "/api/v1/auth/logout", "", `{}`, http.StatusBadRequest},
+		{"POST /api/v1/organizacoes", "/api/v1/organizacoes", "", `{"nome": "Ab"}`, http.StatusUnauthorized},
+		{"POST /api/v1/organizacoes", "/api/v1/organizacoes", "learner", `{}`, http.StatusForbidden},
+		{"POST /api/v1/organizacoes", "/api/v1/organizacoes", "admin", model.CreateOrganizacaoRequest{
+			Nome: "Nova Organização", Slug: "nova",
+			Admin: model.CreateUsuarioRequest{Nome: "Administradora", Email: "adm@nova.com", Senha: "senha-segura"},
+		}, http.StatusForbidden},
+
+		{"GET /api/v1/organizacao", "/api/v1/organizacao", "", nil, http.StatusUnauthorized},
+		{"GET /api/v1/organizacao/equipes", "/api/v1/organizacao/equipes", "", nil, http.StatusUnauthorized},
+		{"POST /api/v1/organizacao/equipes", "/api/v1/organizacao/equipes", "learner", `{}`, http.StatusForbidden},
+		{"PUT /api/v1/organizacao/equipes/:id", "/api/v1/organizacao/equipes/1", "learner", `{}`, http.StatusForbidden},
+		{"DELETE /api/v1/organizacao/equipes/:id", "/api/v1/organizacao/equipes/1", "learner", nil, http.StatusForbidden},
+
+		{"POST /api/v1/usuarios/", "/api/v1/usuarios/", "learner", `{}`, http.StatusForbidden},
+		{"GET /api/v1/usuarios/", "/api/v1/usuarios/?limit=-1", "admin", nil, http.StatusBadRequest},
+		{"GET /api/v1/usuarios/:id", caminho("/usuarios/{}", learner), "learner", nil, http.StatusOK},
+		{"PUT /api/v1/usuarios/:id", "/api/v1/usuarios/999", "admin", `{}`, http.StatusNotFound},
+		{"DELETE /api/v1/usuarios/:id", "/api/v1/usuarios/abc", "admin", nil, http.StatusBadRequest},
+		{"PUT /api/v1/usuarios/:id/papel", "/api/v1/usuarios/999/papel", "learner", `{"papel": "admin"}`, http.StatusForbidden},
+		{"GET /api/v1/usuarios/:id/competencias", "/api/v1/usuarios/abc/competencias", "learner", nil, http.StatusBadRequest},
+		{"PUT /api/v1/usuarios/:id/competencias", "/api/v1/usuarios/abc/competencias", "learner", `{}`, http.StatusBadRequest},
+		{"GET /api/v1/usuarios/:id/gap", "/api/v1/usuarios/abc/gap", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/usuarios/:id/gap", caminho("/usuarios/{}/gap?cargo={}", learner, cargoFixo.ID), "learner", nil, http.StatusOK},
+		{"GET /api/v1/usuarios/:id/recomendacoes", "/api/v1/usuarios/abc/recomendacoes", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/usuarios/:id/recomendacoes", caminho("/usuarios/{}/recomendacoes", learner), "learner", nil, http.StatusOK},
+		{"GET /api/v1/usuarios/:id/matriculas", caminho("/usuarios/{}/matriculas", learner), "learner", nil, http.StatusOK},
+		{"GET /api/v1/usuarios/:id/elegibilidade/:trilhaId", caminho("/usuarios/{}/elegibilidade/{}", learner, amb.trilhaOutra.ID), "learner", nil, http.StatusUnprocessableEntity},
+		{"GET /api/v1/usuarios/:id/liderados", "/api/v1/usuarios/abc/liderados", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/usuarios/:id/liderados", caminho("/usuarios/{}/liderados", gestor), "gestor", nil, http.StatusOK},
+		{"GET /api/v1/usuarios/:id/liderados/matriculas", "/api/v1/usuarios/abc/liderados/matriculas", "learner", nil, http.StatusBadRequest},
+
+		{"POST /api/v1/trilhas/", "/api/v1/trilhas/", "admin", `{"nome": "Trilha"`, http.StatusBadRequest},
+		{"GET /api/v1/trilhas/", "/api/v1/trilhas/", "learner", nil, http.StatusOK},
+		{"GET /api/v1/trilhas/:id", "/api/v1/trilhas/999", "learner", nil, http.StatusNotFound},
+		{"PUT /api/v1/trilhas/:id", "/api/v1/trilhas/1", "learner", `{}`, http.StatusForbidden},
+		{"DELETE /api/v1/trilhas/:id", "/api/v1/trilhas/999", "admin", nil, http.StatusNotFound},
+		{"GET /api/v1/trilhas/:id/competencias", "/api/v1/trilhas/999/competencias", "learner", nil, http.StatusNotFound},
+		{"PUT /api/v1/trilhas/:id/competencias", "/api/v1/trilhas/1/competencias", "learner", `{}`, http.StatusForbidden},
+		{"POST /api/v1/trilhas/:id/competencias/:competenciaId", "/api/v1/trilhas/1/competencias/1", "learner", nil, http.StatusForbidden},
+		{"DELETE /api/v1/trilhas/:id/competencias/:competenciaId", "/api/v1/trilhas/1/competencias/1", "learner", nil, http.StatusForbidden},
+		{"GET /api/v1/trilhas/:id/requisitos", caminho("/trilhas/{}/requisitos", trilha), "learner", nil, http.StatusOK},
+		{"PUT /api/v1/trilhas/:id/requisitos", "/api/v1/trilhas/1/requisitos", "learner", `{}`, http.StatusForbidden},
+		{"GET /api/v1/trilhas/:id/modulos", "/api/v1/trilhas/abc/modulos", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/trilhas/:id/modulos", caminho("/trilhas/{}/modulos", trilha), "learner", nil, http.StatusOK},
+		{"POST /api/v1/trilhas/:id/modulos", "/api/v1/trilhas/1/modulos", "learner", `{}`, http.StatusForbidden},
+		{"GET /api/v1/trilhas/:id/modulos/:moduloId", "/api/v1/trilhas/1/modulos/abc", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/trilhas/:id/modulos/:moduloId", caminho("/trilhas/{}/modulos/1", trilha), "learner", nil, http.StatusOK},
+		{"PUT /api/v1/trilhas/:id/modulos/:moduloId", "/api/v1/trilhas/1/modulos/1", "learner", `{}`, http.StatusForbidden},
+		{"DELETE /api/v1/trilhas/:id/modulos/:moduloId", "/api/v1/trilhas/1/modulos/1", "learner", nil, http.StatusForbidden},
+		{"POST /api/v1/trilhas/:id/modulos/:moduloId/aulas", "/api/v1/trilhas/1/modulos/1/aulas", "learner", `{}`, http.StatusForbidden},
+		{"PUT /api/v1/trilhas/:id/modulos/:moduloId/aulas/:aulaId", "/api/v1/trilhas/1/modulos/1/aulas/1", "learner", `{}`, http.StatusForbidden},
+		{"DELETE /api/v1/trilhas/:id/modulos/:moduloId/aulas/:aulaId", "/api/v1/trilhas/1/modulos/1/aulas/1", "learner", nil, http.StatusForbidden},
+
+		{"POST /api/v1/competencias/", "/api/v1/competencias/", "learner", `{}`, http.StatusForbidden},
+		{"GET /api/v1/competencias/", "/api/v1/competencias/?offset=x", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/competencias/:id", "/api/v1/competencias/abc", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/competencias/:id", caminho("/competencias/{}", competenciaFixa.ID), "learner", nil, http.StatusOK},
+		{"PUT /api/v1/competencias/:id", "/api/v1/competencias/1", "learner", `{}`, http.StatusForbidden},
+		{"DELETE /api/v1/competencias/:id", "/api/v1/competencias/1", "learner", nil, http.StatusForbidden},
+		{"GET /api/v1/competencias/:id/trilhas", "/api/v1/competencias/abc/trilhas", "learner", nil, http.StatusBadRequest},
+
+		{"POST /api/v1/cargos/", "/api/v1/cargos/", "learner", `{}`, http.StatusForbidden},
+		{"GET /api/v1/cargos/", "/api/v1/cargos/?limit=x", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/cargos/:id", "/api/v1/cargos/abc", "learner", nil, http.StatusBadRequest},
+		{"GET /api/v1/cargos/:id", caminho("/cargos/{}", cargoFixo.ID), "learner", nil, http.StatusOK},
+		{"PUT /api/v1/cargos/:id", "/api/v1/cargos/1", "learner", `{}`, http.StatusForbidden},
+		{"DELETE /api/v1/cargos/:id", "/api/v1/cargos/1", "learner", nil, http.StatusForbidden},
+		{"PUT /api/v1/cargos/:id/competencias", "/api/v1/cargos/1/competencias", "learner", `{}`, http.StatusForbidden},
+
+		{"GET /api/v1/search", "/api/v1/search?q=dados&limit=x", "learner", nil, http.StatusBadRequest},
+
+		{"POST /api/v1/matriculas", "/api/v1/matriculas", "learner", controller.MatricularRequest{UsuarioID: learner, TrilhaID: 999}, http.StatusUnprocessableEntity},
+		{"GET /api/v1/matriculas/:id", "/api/v1/matriculas/999", "learner", nil, http.StatusNotFound},
+		{"POST /api/v1/matriculas/:id/concluir", "/api/v1/matriculas/999/concluir", "learner", nil, http.StatusNotFound},
+		{"POST /api/v1/matriculas/:id/cancelar", "/api/v1/matriculas/abc/cancelar", "learner", nil, http.StatusBadRequest},
+		{"POST /api/v1/matriculas/:id/reativar", "/api/v1/matriculas/999/reativar", "learner", nil, http.StatusNotFound},
+		{"POST /api/v1/matriculas/:id/sessoes", "/api/v1/matriculas/999/sessoes", "learner", model.RegistrarSessaoRequest{Horas: 1}, http.StatusNotFound},
+		{"GET /api/v1/matriculas/:id/sessoes", "/api/v1/matriculas/999/sessoes", "learner", nil, http.StatusNotFound},
+		{"GET /api/v1/matriculas/:id/progresso", "/api/v1/matriculas/999/progresso", "learner", nil, http.StatusNotFound},
+		{"POST /api/v1/matriculas/:id/aulas/:aulaId/concluir", "/api/v1/matriculas/999/aulas/1/concluir", "learner", nil, http.StatusNotFound},
+	}
+
+	// Cada caso precisa ser atendido pela rota indicada, e todas as rotas registradas
+	// precisam de ao menos um caso
+	cobertas := make(map[string]bool)
+	for _, c := range casos {
+		t.Run(c.rota, func(t *testing.T) {
+			amb.t = t
+			metodo, _, _ := strings.Cut(c.rota, " ")
+			rec := amb.requisicao(metodo, c.caminho, c.token, c.corpo)
+			if amb.rota != c.rota {
+				t.Fatalf("o caminho %s foi atendido pela rota %q", c.caminho, amb.rota)
+			}
+			cobertas[c.rota] = true
+			if rec.Code >= http.StatusBadRequest {
+				amb.esperarErro(rec, c.status)
+			} else {
+				amb.esperar(rec, c.status, nil)
+			}
+		})
+	}
+
+	faltando := make([]string, 0)
+	for _, r := range amb.router.Routes() {
+		if rota := r.Method + " " + r.Path; !cobertas[rota] {
+			faltando = append(faltando, rota)
+		}
+	}
+	sort.Strings(faltando)
+	for _, rota := range faltando {
+		t.Errorf("rota sem caso de teste: %s", rota)
+	}
+}